@@ -14,6 +14,7 @@ import (
 	"github.com/caarlos0/env/v11"
 	"github.com/nakatanakatana/feed-reader/frontend"
 	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
 	"github.com/nakatanakatana/feed-reader/internal/readonly"
 	"github.com/nakatanakatana/feed-reader/internal/readonlydb"
 	"github.com/nakatanakatana/feed-reader/store"
@@ -39,6 +40,12 @@ type config struct {
 	CacheSizeBytes     int           `env:"LITESTREAM_CACHE_SIZE_BYTES" envDefault:"10485760"`
 	MaxOpenConnections int           `env:"LITESTREAM_MAX_OPEN_CONNECTIONS" envDefault:"4"`
 	CORSAllowedOrigins []string      `env:"CORS_ALLOWED_ORIGINS" envSeparator:","`
+	// Media proxy settings, with the same key as the primary server.
+	MediaProxyKey          string        `env:"MEDIA_PROXY_KEY"`
+	MediaProxyCacheDir     string        `env:"MEDIA_PROXY_CACHE_DIR"`
+	MediaProxyMaxSize      int64         `env:"MEDIA_PROXY_MAX_SIZE"`
+	MediaProxyCacheMaxSize int64         `env:"MEDIA_PROXY_CACHE_MAX_SIZE"`
+	MediaProxyCacheMaxAge  time.Duration `env:"MEDIA_PROXY_CACHE_MAX_AGE"`
 }
 
 func main() {
@@ -71,7 +78,28 @@ func main() {
 		osExit(1)
 	}
 
-	handler := newMux(db, frontend.Assets, cfg.CORSAllowedOrigins)
+	var mediaProxy *mediaproxy.Proxy
+	if cfg.MediaProxyKey != "" {
+		key, err := mediaproxy.ParseKey(cfg.MediaProxyKey)
+		if err == nil {
+			mediaProxy, err = mediaproxy.New(mediaproxy.Config{
+				Key:           key,
+				CacheDir:      cfg.MediaProxyCacheDir,
+				CacheMaxBytes: cfg.MediaProxyCacheMaxSize,
+				CacheMaxAge:   cfg.MediaProxyCacheMaxAge,
+				MaxSize:       cfg.MediaProxyMaxSize,
+				Logger:        logger,
+			})
+		}
+		if err != nil {
+			_ = db.Close()
+			unregister()
+			logger.ErrorContext(ctx, "failed to initialize media proxy", "error", err)
+			osExit(1)
+		}
+	}
+
+	handler := newMux(db, frontend.Assets, cfg.CORSAllowedOrigins, mediaProxy)
 
 	var protocols http.Protocols
 	protocols.SetHTTP1(true)
@@ -121,15 +149,17 @@ func main() {
 	}
 }
 
-// newMux builds the readonly HTTP surface from a DB and assets only.
-// It must not accept or invoke migrations, schedulers, fetchers, or write queues.
-func newMux(db *sql.DB, assets fs.FS, allowedOrigins []string) http.Handler {
+// newMux builds the readonly HTTP surface from a DB and assets, plus the
+// optional media proxy serving item images. It must not accept or invoke
+// migrations, schedulers, feed fetchers, or write queues.
+func newMux(db *sql.DB, assets fs.FS, allowedOrigins []string, media *mediaproxy.Proxy) http.Handler {
 	s := store.NewStore(db)
 	api := httpapi.NewMux(httpapi.Dependencies{
 		Store:          s,
 		Assets:         assets,
 		AllowedOrigins: allowedOrigins,
 		AllowedMethods: readonlyCORSMethods,
+		MediaProxy:     media,
 	})
 
 	mux := http.NewServeMux()
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/caarlos0/env/v11"
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
 	"github.com/nakatanakatana/feed-reader/internal/primarydb"
 	"github.com/nakatanakatana/feed-reader/internal/readonly"
 	"github.com/nakatanakatana/feed-reader/internal/readonlydb"
@@ -101,7 +102,7 @@ func TestNewMux(t *testing.T) {
 	}
 
 	// Constructor may only wire Store, Assets, AllowedOrigins, and AllowedMethods.
-	handler := newMux(db, assets, nil)
+	handler := newMux(db, assets, nil, nil)
 
 	t.Run("GET /api/v2/feeds delegates to OpenAPI handler", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/api/v2/feeds", nil)
@@ -149,14 +150,15 @@ func TestNewMux(t *testing.T) {
 
 func TestNewMux_ConstructorLimitedToDBAndAssets(t *testing.T) {
 	// Compile-time / API-level proof: newMux accepts only *sql.DB, assets, and origins.
-	// It must not take scheduler, fetcher, write-queue, or migration dependencies.
+	// It must not take scheduler, feed fetcher, write-queue, or migration
+	// dependencies; the media proxy only fetches images for readers.
 	assertNewMuxSignature(newMux)
 	db := setupQueryableDB(t)
-	handler := newMux(db, fstest.MapFS{"index.html": &fstest.MapFile{Data: []byte("ok")}}, nil)
+	handler := newMux(db, fstest.MapFS{"index.html": &fstest.MapFile{Data: []byte("ok")}}, nil, nil)
 	assert.Assert(t, handler != nil)
 }
 
-func assertNewMuxSignature(_ func(*sql.DB, fs.FS, []string) http.Handler) {}
+func assertNewMuxSignature(_ func(*sql.DB, fs.FS, []string, *mediaproxy.Proxy) http.Handler) {}
 
 func setupQueryableDB(t *testing.T) *sql.DB {
 	t.Helper()
@@ -177,7 +179,7 @@ func TestNewMux_CORSPreflightOnReadonlyStack(t *testing.T) {
 		"index.html": &fstest.MapFile{Data: []byte("ok")},
 	}
 	const allowedOrigin = "http://localhost:3000"
-	handler := newMux(db, assets, []string{allowedOrigin})
+	handler := newMux(db, assets, []string{allowedOrigin}, nil)
 
 	ts := httptest.NewServer(handler)
 	t.Cleanup(ts.Close)
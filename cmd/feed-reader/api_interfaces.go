@@ -4,3 +4,4 @@ import "github.com/nakatanakatana/feed-reader/internal/httpapi"
 
 type ItemFetcher = httpapi.ItemFetcher
 type FeedFetchResult = httpapi.FeedFetchResult
+type FetchQueueHost = httpapi.FetchQueueHost
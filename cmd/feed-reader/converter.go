@@ -1,13 +1,138 @@
 package main
 
 import (
-	md "github.com/JohannesKaufmann/html-to-markdown/v2"
+	"net/url"
+	"strings"
+
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/base"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/commonmark"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// markdownConverter is shared by all conversions; it is safe for concurrent use.
+var markdownConverter = converter.NewConverter(
+	converter.WithPlugins(
+		base.NewBasePlugin(),
+		commonmark.NewCommonmarkPlugin(),
+	),
 )
 
-// ConvertHTMLToMarkdown converts an HTML string to Markdown.
-func ConvertHTMLToMarkdown(html string) (string, error) {
-	if html == "" {
-		return "", nil
+// ConvertHTMLToMarkdown converts an HTML string to Markdown. Tracking pixels
+// are dropped, the content is sanitized with the configured policy, and
+// relative links and images are resolved against baseURL, the URL of the
+// item or of its feed. An empty baseURL leaves them relative.
+func ConvertHTMLToMarkdown(content, baseURL string) (string, error) {
+	markdown, _, err := ConvertHTML(content, baseURL)
+	return markdown, err
+}
+
+// ConvertHTML is like ConvertHTMLToMarkdown, and also returns the sanitized
+// HTML the Markdown was converted from.
+func ConvertHTML(content, baseURL string) (markdown, sanitized string, err error) {
+	if content == "" {
+		return "", "", nil
+	}
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", "", err
+	}
+	var base *url.URL
+	if baseURL != "" {
+		if u, err := url.Parse(baseURL); err == nil && u.IsAbs() {
+			base = u
+		}
+	}
+	removeTrackingPixels(doc)
+	contentPolicy.sanitize(doc, base)
+
+	var b strings.Builder
+	for n := range doc.Descendants() {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Body {
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				if err := html.Render(&b, c); err != nil {
+					return "", "", err
+				}
+			}
+			break
+		}
 	}
-	return md.ConvertString(html)
+	converted, err := markdownConverter.ConvertNode(doc)
+	if err != nil {
+		return "", "", err
+	}
+	return string(converted), b.String(), nil
+}
+
+// trackerPrefixes are host and path prefixes of known tracking images.
+var trackerPrefixes = []string{
+	"feeds.feedburner.com/~r/",
+	"feeds.feedblitz.com/~/i/",
+	"pixel.wp.com/",
+	"stats.wordpress.com/",
+	"www.google-analytics.com/",
+	"google-analytics.com/",
+	"pixel.quantserve.com/",
+	"pi.feedsportal.com/",
+	"feedpress.me/pixel/",
+}
+
+// removeTrackingPixels removes images that are 1x1 or smaller, or that are
+// served by a known tracker.
+func removeTrackingPixels(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if c.Type == html.ElementNode && c.DataAtom == atom.Img && isTrackingPixel(c) {
+			n.RemoveChild(c)
+		} else {
+			removeTrackingPixels(c)
+		}
+		c = next
+	}
+}
+
+func isTrackingPixel(img *html.Node) bool {
+	var src, width, height string
+	for _, attr := range img.Attr {
+		switch strings.ToLower(attr.Key) {
+		case "src":
+			src = strings.TrimSpace(attr.Val)
+		case "width":
+			width = attr.Val
+		case "height":
+			height = attr.Val
+		case "style":
+			for _, declaration := range strings.Split(attr.Val, ";") {
+				property, value, _ := strings.Cut(declaration, ":")
+				value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "!important"))
+				switch strings.ToLower(strings.TrimSpace(property)) {
+				case "width":
+					width = value
+				case "height":
+					height = value
+				}
+			}
+		}
+	}
+	if isPixelSize(width) && isPixelSize(height) {
+		return true
+	}
+	u, err := url.Parse(src)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	target := strings.ToLower(u.Host) + u.EscapedPath()
+	for _, prefix := range trackerPrefixes {
+		if strings.HasPrefix(target, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPixelSize reports whether an image dimension is at most one pixel.
+func isPixelSize(value string) bool {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "px")
+	return value == "0" || value == "1"
 }
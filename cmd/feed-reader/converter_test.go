@@ -37,10 +37,44 @@ func TestConvertHTMLToMarkdown(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ConvertHTMLToMarkdown(tt.input)
+			result, err := ConvertHTMLToMarkdown(tt.input, "")
 			assert.NilError(t, err)
 			// Trim space to handle potential trailing newlines from the library
 			golden.Assert(t, strings.TrimSpace(result), tt.name+".golden")
 		})
 	}
 }
+
+func TestConvertHTMLToMarkdown_RemovesTrackingPixels(t *testing.T) {
+	tests := []struct {
+		name string
+		img  string
+		kept bool
+	}{
+		{name: "1x1 attributes", img: `<img src="https://example.com/p.gif" width="1" height="1">`},
+		{name: "1x1 style", img: `<img src="https://example.com/p.gif" style="width: 1px; height:1px !important">`},
+		{name: "feedburner", img: `<img src="http://feeds.feedburner.com/~r/example/~4/abc" height="1" width="0">`},
+		{name: "tracker host", img: `<img src="https://pixel.wp.com/b.gif?blog=1">`},
+		{name: "regular image", img: `<img src="https://example.com/photo.jpg" width="1" height="300" alt="photo">`, kept: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ConvertHTMLToMarkdown("<p>Text "+tt.img+"</p>", "")
+			assert.NilError(t, err)
+			assert.Equal(t, strings.Contains(result, "!["), tt.kept, result)
+			assert.Assert(t, strings.Contains(result, "Text"))
+		})
+	}
+}
+
+func TestConvertHTML(t *testing.T) {
+	markdown, sanitized, err := ConvertHTML(`<p onclick="x()">See <a href="/post" class="link">post</a><img src="https://t.example/p.gif" width="1" height="1"></p><script>alert(1)</script>`, "https://example.com/")
+	assert.NilError(t, err)
+	assert.Equal(t, markdown, "See [post](https://example.com/post)")
+	assert.Equal(t, sanitized, `<p>See <a href="https://example.com/post">post</a></p>`)
+
+	markdown, sanitized, err = ConvertHTML("", "https://example.com/")
+	assert.NilError(t, err)
+	assert.Equal(t, markdown, "")
+	assert.Equal(t, sanitized, "")
+}
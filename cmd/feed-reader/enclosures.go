@@ -0,0 +1,180 @@
+package main
+
+import (
+	"mime"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	ext "github.com/mmcdole/gofeed/extensions"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// itemEnclosures collects the media files of an item from its enclosures, its
+// iTunes extension and its Media RSS content. Media RSS entries describing an
+// enclosure fill in what the enclosure itself lacks.
+func itemEnclosures(item *gofeed.Item) []store.FetchedEnclosure {
+	var enclosures []store.FetchedEnclosure
+	index := make(map[string]int)
+	add := func(e store.FetchedEnclosure) {
+		if e.URL == "" {
+			return
+		}
+		i, ok := index[e.URL]
+		if !ok {
+			index[e.URL] = len(enclosures)
+			enclosures = append(enclosures, e)
+			return
+		}
+		existing := &enclosures[i]
+		if existing.MimeType == nil {
+			existing.MimeType = e.MimeType
+		}
+		if existing.Length == nil {
+			existing.Length = e.Length
+		}
+		if existing.DurationSeconds == nil {
+			existing.DurationSeconds = e.DurationSeconds
+		}
+		if existing.ThumbnailURL == nil {
+			existing.ThumbnailURL = e.ThumbnailURL
+		}
+	}
+
+	for _, enclosure := range item.Enclosures {
+		if enclosure == nil {
+			continue
+		}
+		add(store.FetchedEnclosure{
+			URL:      strings.TrimSpace(enclosure.URL),
+			MimeType: mediaType(enclosure.Type),
+			Length:   positiveInt(enclosure.Length),
+		})
+	}
+
+	media := item.Extensions["media"]
+	itemThumbnail := mediaThumbnail(media)
+	for _, group := range media["group"] {
+		groupThumbnail := mediaThumbnail(group.Children)
+		if groupThumbnail == nil {
+			groupThumbnail = itemThumbnail
+		}
+		for _, content := range group.Children["content"] {
+			add(mediaContentEnclosure(content, groupThumbnail))
+		}
+	}
+	for _, content := range media["content"] {
+		add(mediaContentEnclosure(content, itemThumbnail))
+	}
+
+	// The iTunes extension describes the episode, which is the single
+	// enclosure of a podcast item.
+	if item.ITunesExt != nil && len(enclosures) > 0 {
+		first := &enclosures[0]
+		if first.DurationSeconds == nil {
+			first.DurationSeconds = parseDuration(item.ITunesExt.Duration)
+		}
+		if first.ThumbnailURL == nil && item.ITunesExt.Image != "" {
+			image := item.ITunesExt.Image
+			first.ThumbnailURL = &image
+		}
+	}
+	if itemThumbnail != nil {
+		for i := range enclosures {
+			if enclosures[i].ThumbnailURL == nil {
+				enclosures[i].ThumbnailURL = itemThumbnail
+			}
+		}
+	}
+
+	for i := range enclosures {
+		if enclosures[i].MimeType == nil {
+			enclosures[i].MimeType = mediaTypeFromURL(enclosures[i].URL)
+		}
+	}
+	return enclosures
+}
+
+func mediaContentEnclosure(content ext.Extension, thumbnail *string) store.FetchedEnclosure {
+	e := store.FetchedEnclosure{
+		URL:             strings.TrimSpace(content.Attrs["url"]),
+		MimeType:        mediaType(content.Attrs["type"]),
+		Length:          positiveInt(content.Attrs["fileSize"]),
+		DurationSeconds: parseDuration(content.Attrs["duration"]),
+		ThumbnailURL:    mediaThumbnail(content.Children),
+	}
+	if e.ThumbnailURL == nil {
+		e.ThumbnailURL = thumbnail
+	}
+	return e
+}
+
+func mediaThumbnail(extensions map[string][]ext.Extension) *string {
+	for _, thumbnail := range extensions["thumbnail"] {
+		if u := strings.TrimSpace(thumbnail.Attrs["url"]); u != "" {
+			return &u
+		}
+	}
+	return nil
+}
+
+// mediaType normalizes a MIME type, dropping its parameters.
+func mediaType(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	if parsed, _, err := mime.ParseMediaType(value); err == nil {
+		value = parsed
+	}
+	value = strings.ToLower(value)
+	return &value
+}
+
+func mediaTypeFromURL(rawURL string) *string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil
+	}
+	extension := strings.ToLower(path.Ext(u.Path))
+	if extension == "" {
+		return nil
+	}
+	return mediaType(mime.TypeByExtension(extension))
+}
+
+func positiveInt(value string) *int64 {
+	n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || n <= 0 {
+		return nil
+	}
+	return &n
+}
+
+// parseDuration parses a duration in seconds, or as [[HH:]MM:]SS as used by
+// iTunes. Fractions of a second are dropped.
+func parseDuration(value string) *int64 {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	var seconds int64
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return nil
+	}
+	for _, part := range parts {
+		whole, _, _ := strings.Cut(part, ".")
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || n < 0 {
+			return nil
+		}
+		seconds = seconds*60 + n
+	}
+	if seconds == 0 {
+		return nil
+	}
+	return &seconds
+}
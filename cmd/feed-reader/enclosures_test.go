@@ -0,0 +1,106 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func parseTestFeedItem(t *testing.T, feed string) *gofeed.Item {
+	t.Helper()
+	parsed, err := gofeed.NewParser().ParseString(feed)
+	assert.NilError(t, err)
+	assert.Equal(t, len(parsed.Items), 1)
+	return parsed.Items[0]
+}
+
+func TestItemEnclosures_Podcast(t *testing.T) {
+	item := parseTestFeedItem(t, `<?xml version="1.0"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+<channel><title>Show</title>
+<item>
+  <title>Episode 1</title>
+  <link>https://example.com/ep1</link>
+  <enclosure url="https://cdn.example.com/ep1.mp3" length="1234567" type="audio/mpeg; charset=binary"/>
+  <itunes:duration>1:02:03</itunes:duration>
+  <itunes:image href="https://cdn.example.com/ep1.jpg"/>
+</item>
+</channel></rss>`)
+
+	enclosures := itemEnclosures(item)
+	assert.Equal(t, len(enclosures), 1)
+	e := enclosures[0]
+	assert.Equal(t, e.URL, "https://cdn.example.com/ep1.mp3")
+	assert.Equal(t, *e.MimeType, "audio/mpeg")
+	assert.Equal(t, *e.Length, int64(1234567))
+	assert.Equal(t, *e.DurationSeconds, int64(3723))
+	assert.Equal(t, *e.ThumbnailURL, "https://cdn.example.com/ep1.jpg")
+}
+
+func TestItemEnclosures_MediaRSS(t *testing.T) {
+	item := parseTestFeedItem(t, `<?xml version="1.0"?>
+<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+<channel><title>Videos</title>
+<item>
+  <title>Clip</title>
+  <link>https://example.com/clip</link>
+  <enclosure url="https://cdn.example.com/clip.mp4" length="0" type=""/>
+  <media:group>
+    <media:content url="https://cdn.example.com/clip.mp4" type="video/mp4" fileSize="2048" duration="95"/>
+    <media:content url="https://cdn.example.com/clip.webm" duration="95.5"/>
+    <media:thumbnail url="https://cdn.example.com/clip.jpg"/>
+  </media:group>
+</item>
+</channel></rss>`)
+
+	enclosures := itemEnclosures(item)
+	assert.Equal(t, len(enclosures), 2)
+
+	mp4 := enclosures[0]
+	assert.Equal(t, mp4.URL, "https://cdn.example.com/clip.mp4")
+	assert.Equal(t, *mp4.MimeType, "video/mp4")
+	assert.Equal(t, *mp4.Length, int64(2048))
+	assert.Equal(t, *mp4.DurationSeconds, int64(95))
+	assert.Equal(t, *mp4.ThumbnailURL, "https://cdn.example.com/clip.jpg")
+
+	webm := enclosures[1]
+	assert.Equal(t, webm.URL, "https://cdn.example.com/clip.webm")
+	assert.Equal(t, *webm.MimeType, "video/webm")
+	assert.Assert(t, webm.Length == nil)
+	assert.Equal(t, *webm.DurationSeconds, int64(95))
+}
+
+func TestItemEnclosures_None(t *testing.T) {
+	item := parseTestFeedItem(t, `<?xml version="1.0"?>
+<rss version="2.0"><channel><title>Blog</title>
+<item><title>Post</title><link>https://example.com/post</link></item>
+</channel></rss>`)
+
+	assert.DeepEqual(t, itemEnclosures(item), []store.FetchedEnclosure(nil))
+}
+
+func TestParseDuration(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  int64
+	}{
+		{"3600", 3600},
+		{"45:10", 2710},
+		{"01:00:05", 3605},
+		{"12.7", 12},
+		{"", 0},
+		{"0", 0},
+		{"abc", 0},
+		{"1:2:3:4", 0},
+	} {
+		got := parseDuration(tc.value)
+		if tc.want == 0 {
+			assert.Assert(t, got == nil, tc.value)
+			continue
+		}
+		assert.Assert(t, got != nil, tc.value)
+		assert.Equal(t, *got, tc.want, tc.value)
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+type FeedCandidate = httpapi.FeedCandidate
+type FeedDiscoveryError = httpapi.FeedDiscoveryError
+
+// feedLinkTypes are the <link rel="alternate"> types treated as feeds.
+var feedLinkTypes = map[string]struct{}{
+	"application/rss+xml":   {},
+	"application/atom+xml":  {},
+	"application/feed+json": {},
+}
+
+// commonFeedPaths are probed, relative to the site root, when a page does
+// not advertise any feed.
+var commonFeedPaths = []string{"/feed", "/rss.xml", "/atom.xml"}
+
+// maxProbeBodySize bounds how much of a probed response is read to detect its feed type.
+const maxProbeBodySize = 1 << 20
+
+func isHTMLResponse(contentType string, body []byte) bool {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		switch mediaType {
+		case "text/html", "application/xhtml+xml":
+			return true
+		}
+	}
+	return strings.HasPrefix(http.DetectContentType(body), "text/html")
+}
+
+// discoverFeeds builds a FeedDiscoveryError for an HTML page. Feeds advertised
+// by the page win; common feed paths are only probed when there are none.
+func (f *GofeedFetcher) discoverFeeds(ctx context.Context, pageURL *url.URL, body []byte) error {
+	candidates := parseFeedLinks(body, pageURL)
+	if len(candidates) == 0 {
+		candidates = f.probeCommonFeedPaths(ctx, pageURL)
+	}
+	return &FeedDiscoveryError{
+		PageURL:    pageURL.String(),
+		Candidates: candidates,
+	}
+}
+
+// parseFeedLinks returns the feed <link rel="alternate"> elements of an HTML
+// document in document order, resolved against the page URL or <base href>.
+func parseFeedLinks(body []byte, pageURL *url.URL) []FeedCandidate {
+	base := pageURL
+	var candidates []FeedCandidate
+	seen := make(map[string]struct{})
+
+	z := html.NewTokenizer(bytes.NewReader(body))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return candidates
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			switch token.DataAtom {
+			case atom.Body:
+				// Feed links belong in <head>; stop before scanning the whole page.
+				return candidates
+			case atom.Base:
+				if href := tokenAttr(token, "href"); href != "" {
+					if resolved, err := pageURL.Parse(href); err == nil {
+						base = resolved
+					}
+				}
+			case atom.Link:
+				if !hasRelAlternate(tokenAttr(token, "rel")) {
+					continue
+				}
+				linkType := strings.ToLower(strings.TrimSpace(tokenAttr(token, "type")))
+				if _, ok := feedLinkTypes[linkType]; !ok {
+					continue
+				}
+				href := strings.TrimSpace(tokenAttr(token, "href"))
+				if href == "" {
+					continue
+				}
+				resolved, err := base.Parse(href)
+				if err != nil || (resolved.Scheme != "http" && resolved.Scheme != "https") {
+					continue
+				}
+				feedURL := resolved.String()
+				if _, ok := seen[feedURL]; ok {
+					continue
+				}
+				seen[feedURL] = struct{}{}
+				candidates = append(candidates, FeedCandidate{
+					URL:   feedURL,
+					Title: strings.TrimSpace(tokenAttr(token, "title")),
+					Type:  linkType,
+				})
+			}
+		}
+	}
+}
+
+func tokenAttr(token html.Token, key string) string {
+	for _, attr := range token.Attr {
+		if strings.EqualFold(attr.Key, key) {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func hasRelAlternate(rel string) bool {
+	for _, value := range strings.Fields(rel) {
+		if strings.EqualFold(value, "alternate") {
+			return true
+		}
+	}
+	return false
+}
+
+// probeCommonFeedPaths requests well-known feed locations on the page's host
+// and returns those that respond with a parseable feed.
+func (f *GofeedFetcher) probeCommonFeedPaths(ctx context.Context, pageURL *url.URL) []FeedCandidate {
+	var candidates []FeedCandidate
+	for _, path := range commonFeedPaths {
+		probeURL := pageURL.ResolveReference(&url.URL{Path: path})
+		feedType, ok := f.probeFeed(ctx, probeURL.String())
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, FeedCandidate{
+			URL:  probeURL.String(),
+			Type: feedTypeMediaType(feedType),
+		})
+	}
+	return candidates
+}
+
+func (f *GofeedFetcher) probeFeed(ctx context.Context, probeURL string) (gofeed.FeedType, bool) {
+	req, err := http.NewRequestWithContext(ctx, "GET", probeURL, nil)
+	if err != nil {
+		return gofeed.FeedTypeUnknown, false
+	}
+	resp, err := f.defaultClient().Do(req)
+	if err != nil {
+		return gofeed.FeedTypeUnknown, false
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return gofeed.FeedTypeUnknown, false
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxProbeBodySize))
+	if err != nil {
+		return gofeed.FeedTypeUnknown, false
+	}
+	feedType := gofeed.DetectFeedType(bytes.NewReader(body))
+	return feedType, feedType != gofeed.FeedTypeUnknown
+}
+
+func feedTypeMediaType(feedType gofeed.FeedType) string {
+	switch feedType {
+	case gofeed.FeedTypeAtom:
+		return "application/atom+xml"
+	case gofeed.FeedTypeJSON:
+		return "application/feed+json"
+	default:
+		return "application/rss+xml"
+	}
+}
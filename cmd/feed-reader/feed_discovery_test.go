@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestParseFeedLinks(t *testing.T) {
+	pageURL, err := url.Parse("https://example.com/blog/post-1")
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name string
+		html string
+		want []FeedCandidate
+	}{
+		{
+			name: "resolves relative links in document order",
+			html: `<html><head>
+<link rel="stylesheet" href="/style.css">
+<link rel="alternate" type="application/atom+xml" title="Atom" href="/atom.xml">
+<link rel="alternate" type="application/rss+xml" title="RSS" href="feed.rss">
+<link rel="alternate" type="application/feed+json" href="https://cdn.example.com/feed.json">
+</head><body></body></html>`,
+			want: []FeedCandidate{
+				{URL: "https://example.com/atom.xml", Title: "Atom", Type: "application/atom+xml"},
+				{URL: "https://example.com/blog/feed.rss", Title: "RSS", Type: "application/rss+xml"},
+				{URL: "https://cdn.example.com/feed.json", Type: "application/feed+json"},
+			},
+		},
+		{
+			name: "honours base href and deduplicates",
+			html: `<html><head><base href="https://example.org/sub/">
+<link rel="ALTERNATE home" type="Application/RSS+XML" href="rss">
+<link rel="alternate" type="application/rss+xml" href="https://example.org/sub/rss">
+</head></html>`,
+			want: []FeedCandidate{
+				{URL: "https://example.org/sub/rss", Type: "application/rss+xml"},
+			},
+		},
+		{
+			name: "ignores non-feed alternates and links in body",
+			html: `<html><head>
+<link rel="alternate" hreflang="ja" href="/ja/">
+<link rel="alternate" type="application/rss+xml" href="javascript:alert(1)">
+</head><body><link rel="alternate" type="application/rss+xml" href="/late.xml"></body></html>`,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseFeedLinks([]byte(tt.html), pageURL)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestGofeedFetcher_Fetch_Discovery(t *testing.T) {
+	_, db := setupTestDB(t)
+	s := store.NewStore(db)
+	f := NewGofeedFetcher(s)
+
+	t.Run("returns advertised candidates for HTML pages", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = fmt.Fprint(w, `<!DOCTYPE html><html><head><title>Blog</title>
+<link rel="alternate" type="application/rss+xml" title="Blog RSS" href="/index.xml">
+</head><body><p>hello</p></body></html>`)
+		}))
+		defer server.Close()
+
+		_, err := f.Fetch(context.Background(), "", server.URL+"/posts/hello")
+		var discoveryErr *FeedDiscoveryError
+		assert.Assert(t, errors.As(err, &discoveryErr), "expected FeedDiscoveryError, got %v", err)
+		assert.Equal(t, discoveryErr.PageURL, server.URL+"/posts/hello")
+		assert.DeepEqual(t, discoveryErr.Candidates, []FeedCandidate{
+			{URL: server.URL + "/index.xml", Title: "Blog RSS", Type: "application/rss+xml"},
+		})
+	})
+
+	t.Run("probes common paths when the page advertises no feed", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = fmt.Fprint(w, `<html><head><title>No feeds here</title></head><body></body></html>`)
+		})
+		mux.HandleFunc("/atom.xml", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/atom+xml")
+			_, _ = fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><feed xmlns="http://www.w3.org/2005/Atom"><title>A</title></feed>`)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		_, err := f.Fetch(context.Background(), "", server.URL+"/")
+		var discoveryErr *FeedDiscoveryError
+		assert.Assert(t, errors.As(err, &discoveryErr), "expected FeedDiscoveryError, got %v", err)
+		assert.DeepEqual(t, discoveryErr.Candidates, []FeedCandidate{
+			{URL: server.URL + "/atom.xml", Type: "application/atom+xml"},
+		})
+	})
+
+	t.Run("returns an empty candidate list when nothing is found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = fmt.Fprint(w, `<html><body>plain page</body></html>`)
+		}))
+		defer server.Close()
+
+		_, err := f.Fetch(context.Background(), "", server.URL+"/")
+		var discoveryErr *FeedDiscoveryError
+		assert.Assert(t, errors.As(err, &discoveryErr), "expected FeedDiscoveryError, got %v", err)
+		assert.Equal(t, len(discoveryErr.Candidates), 0)
+	})
+}
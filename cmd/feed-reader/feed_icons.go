@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// maxIconSize bounds the size of a downloaded icon.
+const maxIconSize = 1 << 20
+
+// iconRefreshInterval is how long a feed's icon is kept before it is looked
+// up again.
+const iconRefreshInterval = 7 * 24 * time.Hour
+
+// errNoIcon is returned when a site has no usable icon.
+var errNoIcon = errors.New("no icon found")
+
+// IconFetcher downloads the pages and images used to find a feed's icon.
+type IconFetcher interface {
+	PageFetcher
+	FetchImage(ctx context.Context, feedID, imageURL string) ([]byte, string, error)
+}
+
+// FetchImage downloads an image with the feed's transport profile. The feed's
+// credentials are not sent, as the image may live on another host. It returns
+// the image and its media type. SVG images are rejected, as they can carry
+// scripts once served from our origin.
+func (f *GofeedFetcher) FetchImage(ctx context.Context, feedID, imageURL string) ([]byte, string, error) {
+	if f.limits.TotalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.limits.TotalTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", imageURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := f.clientFor(ctx, feedID)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", classifyTimeout(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, "", newHTTPStatusError(resp)
+	}
+	maxSize := int64(maxIconSize)
+	if f.limits.MaxBodySize > 0 {
+		maxSize = min(maxSize, f.limits.MaxBodySize)
+	}
+	body, err := readBody(resp.Body, resp.ContentLength, maxSize)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(body) == 0 {
+		return nil, "", errors.New("empty image")
+	}
+	mediaType := imageMediaType(resp.Header.Get("Content-Type"), body)
+	if mediaType == "" {
+		return nil, "", fmt.Errorf("not an image: %q", resp.Header.Get("Content-Type"))
+	}
+	return body, mediaType, nil
+}
+
+// imageMediaType returns the media type of an image response, sniffing the
+// body when the server sends a generic type. It returns "" for anything that
+// is not a raster image.
+func imageMediaType(contentType string, body []byte) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType == "application/octet-stream" || mediaType == "text/plain" {
+		mediaType, _, _ = mime.ParseMediaType(http.DetectContentType(body))
+	}
+	if mediaType == "image/vnd.microsoft.icon" {
+		mediaType = "image/x-icon"
+	}
+	if !strings.HasPrefix(mediaType, "image/") || mediaType == "image/svg+xml" {
+		return ""
+	}
+	return mediaType
+}
+
+// parseIconLinks returns the icons advertised by an HTML document, resolved
+// against the page URL or <base href>. Plain icons come before Apple touch
+// icons.
+func parseIconLinks(body []byte, pageURL *url.URL) []string {
+	base := pageURL
+	var icons, touchIcons []string
+	seen := make(map[string]struct{})
+
+	z := html.NewTokenizer(bytes.NewReader(body))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return append(icons, touchIcons...)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			switch token.DataAtom {
+			case atom.Body:
+				return append(icons, touchIcons...)
+			case atom.Base:
+				if href := tokenAttr(token, "href"); href != "" {
+					if resolved, err := pageURL.Parse(href); err == nil {
+						base = resolved
+					}
+				}
+			case atom.Link:
+				isIcon, isTouchIcon := false, false
+				for _, rel := range strings.Fields(strings.ToLower(tokenAttr(token, "rel"))) {
+					switch rel {
+					case "icon":
+						isIcon = true
+					case "apple-touch-icon", "apple-touch-icon-precomposed":
+						isTouchIcon = true
+					}
+				}
+				if !isIcon && !isTouchIcon {
+					continue
+				}
+				if strings.EqualFold(strings.TrimSpace(tokenAttr(token, "type")), "image/svg+xml") {
+					continue
+				}
+				href := strings.TrimSpace(tokenAttr(token, "href"))
+				if href == "" {
+					continue
+				}
+				resolved, err := base.Parse(href)
+				if err != nil || (resolved.Scheme != "http" && resolved.Scheme != "https") {
+					continue
+				}
+				iconURL := resolved.String()
+				if _, ok := seen[iconURL]; ok {
+					continue
+				}
+				seen[iconURL] = struct{}{}
+				if isIcon {
+					icons = append(icons, iconURL)
+				} else {
+					touchIcons = append(touchIcons, iconURL)
+				}
+			}
+		}
+	}
+}
+
+// discoverIcon finds and downloads the icon of a feed's site. It tries the
+// icons advertised by the site's page, then /favicon.ico at the site root,
+// then the feed's image. siteURL is the feed's link, or its URL when the feed
+// has no link.
+func discoverIcon(ctx context.Context, fetcher IconFetcher, feedID, siteURL, imageURL string) (store.UpsertFeedIconParams, error) {
+	params := store.UpsertFeedIconParams{FeedID: feedID}
+
+	var candidates []string
+	site, err := url.Parse(siteURL)
+	if err == nil && (site.Scheme == "http" || site.Scheme == "https") && site.Host != "" {
+		if body, pageURL, err := fetcher.FetchPage(ctx, feedID, siteURL); err == nil {
+			candidates = append(candidates, parseIconLinks(body, pageURL)...)
+		}
+		candidates = append(candidates, (&url.URL{Scheme: site.Scheme, Host: site.Host, Path: "/favicon.ico"}).String())
+	}
+	if imageURL != "" {
+		candidates = append(candidates, imageURL)
+	}
+
+	var lastErr error
+	for _, candidate := range candidates {
+		data, mediaType, err := fetcher.FetchImage(ctx, feedID, candidate)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		params.SourceUrl = &candidate
+		params.MimeType = &mediaType
+		params.Data = data
+		return params, nil
+	}
+	if lastErr == nil {
+		return params, errNoIcon
+	}
+	return params, fmt.Errorf("%w: %w", errNoIcon, lastErr)
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+// testPNG is the signature of a PNG image, enough to be sniffed as one.
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestParseIconLinks(t *testing.T) {
+	pageURL, err := url.Parse("https://example.com/blog/")
+	assert.NilError(t, err)
+
+	icons := parseIconLinks([]byte(`<html><head>
+<link rel="apple-touch-icon" href="/touch.png">
+<link rel="shortcut icon" href="favicon.png">
+<link rel="icon" type="image/svg+xml" href="/icon.svg">
+<link rel="icon" href="favicon.png">
+<link rel="stylesheet" href="/style.css">
+<link rel="icon" href="javascript:alert(1)">
+</head><body><link rel="icon" href="/late.png"></body></html>`), pageURL)
+
+	assert.DeepEqual(t, icons, []string{
+		"https://example.com/blog/favicon.png",
+		"https://example.com/touch.png",
+	})
+}
+
+func TestImageMediaType(t *testing.T) {
+	assert.Equal(t, imageMediaType("image/png", testPNG), "image/png")
+	assert.Equal(t, imageMediaType("application/octet-stream", testPNG), "image/png")
+	assert.Equal(t, imageMediaType("", []byte("\x00\x00\x01\x00\x01\x00")), "image/x-icon")
+	assert.Equal(t, imageMediaType("image/vnd.microsoft.icon", nil), "image/x-icon")
+	assert.Equal(t, imageMediaType("image/svg+xml", []byte("<svg/>")), "")
+	assert.Equal(t, imageMediaType("text/html", []byte("<html></html>")), "")
+}
+
+func TestDiscoverIcon(t *testing.T) {
+	ctx := context.Background()
+	var page string
+	images := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte(page))
+		case images[r.URL.Path]:
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(testPNG)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	f := NewGofeedFetcher(nil)
+
+	t.Run("advertised icon", func(t *testing.T) {
+		page = `<html><head><link rel="icon" href="/missing.png"><link rel="icon" href="/icon.png"></head></html>`
+		images = map[string]bool{"/icon.png": true, "/favicon.ico": true}
+		icon, err := discoverIcon(ctx, f, "", server.URL+"/", "")
+		assert.NilError(t, err)
+		assert.Equal(t, *icon.SourceUrl, server.URL+"/icon.png")
+		assert.Equal(t, *icon.MimeType, "image/png")
+		assert.DeepEqual(t, icon.Data, testPNG)
+	})
+
+	t.Run("favicon.ico at the site root", func(t *testing.T) {
+		page = `<html><head></head></html>`
+		images = map[string]bool{"/favicon.ico": true}
+		icon, err := discoverIcon(ctx, f, "", server.URL+"/blog/post", "")
+		assert.NilError(t, err)
+		assert.Equal(t, *icon.SourceUrl, server.URL+"/favicon.ico")
+	})
+
+	t.Run("feed image", func(t *testing.T) {
+		images = map[string]bool{"/logo.png": true}
+		icon, err := discoverIcon(ctx, f, "", server.URL+"/", server.URL+"/logo.png")
+		assert.NilError(t, err)
+		assert.Equal(t, *icon.SourceUrl, server.URL+"/logo.png")
+	})
+
+	t.Run("no icon", func(t *testing.T) {
+		images = map[string]bool{}
+		icon, err := discoverIcon(ctx, f, "", server.URL+"/", "")
+		assert.Assert(t, errors.Is(err, errNoIcon))
+		assert.Assert(t, icon.Data == nil)
+	})
+}
+
+type mockIconFetcher struct {
+	mockPageFetcher
+	images map[string][]byte
+}
+
+func (m *mockIconFetcher) FetchImage(ctx context.Context, feedID, imageURL string) ([]byte, string, error) {
+	data, ok := m.images[imageURL]
+	if !ok {
+		return nil, "", &HTTPStatusError{StatusCode: http.StatusNotFound}
+	}
+	return data, "image/png", nil
+}
+
+func TestFetcherService_RefreshMetadata(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+
+	fetcher := &mockFetcher{feed: &gofeed.Feed{
+		Title:       "New title",
+		Description: "New description",
+		Link:        "https://example.com/",
+		Image:       &gofeed.Image{URL: "https://example.com/logo.png"},
+	}}
+	icons := &mockIconFetcher{images: map[string][]byte{"https://example.com/favicon.ico": testPNG}}
+	service := NewFetcherService(s, fetcher, nil, wq, logger, 30*time.Minute)
+	service.SetIcons(icons)
+
+	oldTitle, oldDescription := "Old title", "Old description"
+	_, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml", Title: &oldTitle, Description: &oldDescription})
+	assert.NilError(t, err)
+	customTitle := "My title"
+	_, err = queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-2", Url: "https://example.com/other.xml", Title: &customTitle, TitleOverridden: 1})
+	assert.NilError(t, err)
+
+	fetch := func(t *testing.T, feedID string) store.FullFeed {
+		t.Helper()
+		feed, err := s.GetFeed(ctx, feedID)
+		assert.NilError(t, err)
+		assert.NilError(t, service.FetchAndSave(ctx, feed))
+		time.Sleep(100 * time.Millisecond)
+		updated, err := s.GetFeed(ctx, feedID)
+		assert.NilError(t, err)
+		return updated
+	}
+
+	t.Run("updates changed metadata", func(t *testing.T) {
+		feed := fetch(t, "feed-1")
+		assert.Equal(t, *feed.Title, "New title")
+		assert.Equal(t, *feed.Description, "New description")
+		assert.Equal(t, *feed.Link, "https://example.com/")
+		assert.Equal(t, *feed.ImageUrl, "https://example.com/logo.png")
+
+		icon, err := s.GetFeedIcon(ctx, "feed-1")
+		assert.NilError(t, err)
+		assert.Equal(t, *icon.SourceUrl, "https://example.com/favicon.ico")
+		assert.DeepEqual(t, icon.Data, testPNG)
+		assert.Assert(t, icon.Error == nil)
+	})
+
+	t.Run("keeps a title set by the user", func(t *testing.T) {
+		feed := fetch(t, "feed-2")
+		assert.Equal(t, *feed.Title, "My title")
+		assert.Equal(t, *feed.Description, "New description")
+	})
+
+	t.Run("keeps a fresh icon", func(t *testing.T) {
+		icons.images = nil
+		fetch(t, "feed-1")
+		icon, err := s.GetFeedIcon(ctx, "feed-1")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, icon.Data, testPNG)
+		assert.Assert(t, icon.Error == nil)
+	})
+
+	t.Run("keeps a stale icon when it cannot be found again", func(t *testing.T) {
+		assert.NilError(t, queries.UpsertFeedIcon(ctx, store.UpsertFeedIconParams{
+			FeedID:    "feed-1",
+			FetchedAt: time.Now().Add(-iconRefreshInterval - time.Hour).UTC().Format(time.RFC3339),
+		}))
+		fetch(t, "feed-1")
+		icon, err := s.GetFeedIcon(ctx, "feed-1")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, icon.Data, testPNG)
+		assert.Assert(t, icon.Error != nil)
+	})
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+)
+
+// FetchLimits bound the resources a single feed fetch may use.
+type FetchLimits struct {
+	// MaxBodySize is the largest response body read, in bytes. Zero means no limit.
+	MaxBodySize int64
+	// RequestTimeout bounds each HTTP attempt, including reading the body.
+	RequestTimeout time.Duration
+	// TotalTimeout bounds a whole fetch including retries.
+	TotalTimeout time.Duration
+}
+
+// DefaultFetchLimits are used until SetLimits is called.
+var DefaultFetchLimits = FetchLimits{
+	MaxBodySize:    10 << 20,
+	RequestTimeout: 30 * time.Second,
+	TotalTimeout:   2 * time.Minute,
+}
+
+// FetchErrorKind classifies why a fetch failed. It is stored with the fetch
+// health of a feed.
+type FetchErrorKind string
+
+const (
+	FetchErrorTooLarge   FetchErrorKind = "too_large"
+	FetchErrorTimeout    FetchErrorKind = "timeout"
+	FetchErrorNotAFeed   FetchErrorKind = "not_a_feed"
+	FetchErrorHTTPStatus FetchErrorKind = "http_status"
+)
+
+// FetchError is returned by GofeedFetcher.Fetch for failures with a known kind.
+type FetchError struct {
+	Kind FetchErrorKind
+	Err  error
+}
+
+func (e *FetchError) Error() string {
+	switch e.Kind {
+	case FetchErrorTooLarge:
+		return fmt.Sprintf("response too large: %v", e.Err)
+	case FetchErrorTimeout:
+		return fmt.Sprintf("fetch timed out: %v", e.Err)
+	case FetchErrorNotAFeed:
+		return fmt.Sprintf("not a feed: %v", e.Err)
+	}
+	return e.Err.Error()
+}
+
+func (e *FetchError) Unwrap() error {
+	return e.Err
+}
+
+// fetchErrorKind returns the kind of a fetch error, or "" when it has none.
+func fetchErrorKind(err error) FetchErrorKind {
+	var fetchErr *FetchError
+	if errors.As(err, &fetchErr) {
+		return fetchErr.Kind
+	}
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return FetchErrorHTTPStatus
+	}
+	return ""
+}
+
+// classifyTimeout wraps err as a timeout when a deadline expired, whether the
+// request's, the client's or the total fetch deadline.
+func classifyTimeout(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &FetchError{Kind: FetchErrorTimeout, Err: err}
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return &FetchError{Kind: FetchErrorTimeout, Err: err}
+	}
+	return err
+}
+
+// readBody reads at most maxSize bytes of body. Zero disables the limit.
+func readBody(body io.Reader, contentLength, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		data, err := io.ReadAll(body)
+		return data, classifyTimeout(err)
+	}
+	if contentLength > maxSize {
+		return nil, &FetchError{Kind: FetchErrorTooLarge, Err: fmt.Errorf("content length %d exceeds %d bytes", contentLength, maxSize)}
+	}
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, classifyTimeout(err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, &FetchError{Kind: FetchErrorTooLarge, Err: fmt.Errorf("body exceeds %d bytes", maxSize)}
+	}
+	return data, nil
+}
+
+// nonFeedMediaTypes are Content-Type prefixes that can never hold a feed, so
+// the body is not even read.
+var nonFeedMediaTypes = []string{"image/", "audio/", "video/", "font/", "application/pdf", "application/zip", "application/gzip"}
+
+// checkContentType rejects responses whose Content-Type rules out a feed.
+func checkContentType(contentType string) error {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil
+	}
+	for _, prefix := range nonFeedMediaTypes {
+		if strings.HasPrefix(mediaType, prefix) {
+			return &FetchError{Kind: FetchErrorNotAFeed, Err: fmt.Errorf("content type %q", mediaType)}
+		}
+	}
+	return nil
+}
+
+var xmlDeclEncoding = regexp.MustCompile(`^(\s*<\?xml[^>]*?encoding\s*=\s*)["']([^"']*)["']`)
+
+// decodeBody converts body to UTF-8. A charset in the Content-Type header wins
+// over the XML declaration, which is rewritten to match, and a UTF-16 byte
+// order mark wins over both. Otherwise a body that is not valid UTF-8 is
+// decoded from the sniffed charset.
+func decodeBody(body []byte, contentType string) ([]byte, error) {
+	label := ""
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		label = params["charset"]
+	}
+	if label == "" && hasUTF16BOM(body) {
+		// UTF-16 text without NUL bytes in it is still valid UTF-8.
+		_, label, _ = charset.DetermineEncoding(body, contentType)
+	}
+	if label == "" {
+		if xmlDeclEncoding.Match(body) {
+			// The XML parser decodes declared encodings itself.
+			return body, nil
+		}
+		if utf8.Valid(body) {
+			return body, nil
+		}
+		_, label, _ = charset.DetermineEncoding(body, contentType)
+	}
+
+	encoding, name := charset.Lookup(label)
+	if encoding == nil {
+		return nil, &FetchError{Kind: FetchErrorNotAFeed, Err: fmt.Errorf("unsupported charset %q", label)}
+	}
+	if name == "utf-8" {
+		return body, nil
+	}
+	decoded, err := io.ReadAll(encoding.NewDecoder().Reader(bytes.NewReader(body)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s body: %w", name, err)
+	}
+	// A byte order mark left by the decoder hides the feed from detection.
+	decoded = bytes.TrimPrefix(decoded, []byte("\ufeff"))
+	return xmlDeclEncoding.ReplaceAll(decoded, []byte(`${1}"UTF-8"`)), nil
+}
+
+func hasUTF16BOM(body []byte) bool {
+	return bytes.HasPrefix(body, []byte{0xfe, 0xff}) || bytes.HasPrefix(body, []byte{0xff, 0xfe})
+}
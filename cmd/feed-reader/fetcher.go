@@ -1,13 +1,22 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"github.com/nakatanakatana/feed-reader/internal/feedtransport"
 	"github.com/nakatanakatana/feed-reader/internal/httpapi"
 	"github.com/nakatanakatana/feed-reader/store"
 )
@@ -22,28 +31,116 @@ type FeedFetcher = httpapi.FeedFetcher
 type GofeedFetcher struct {
 	client *http.Client
 	store  *store.Store
+	cipher *feedauth.Cipher
+	limits FetchLimits
+
+	// profileClients caches one client per transport profile.
+	mu             sync.Mutex
+	profileClients map[string]profileClient
 }
 
 // NewGofeedFetcher creates a new GofeedFetcher.
 func NewGofeedFetcher(s *store.Store) *GofeedFetcher {
+	f := &GofeedFetcher{
+		store:          s,
+		limits:         DefaultFetchLimits,
+		profileClients: make(map[string]profileClient),
+	}
+	// The default profile has no settings that can fail.
+	f.client, _ = f.newClient(nil)
+	return f
+}
+
+// newClient builds an HTTP client for feeds using the given transport profile,
+// or the default client when p is nil.
+func (f *GofeedFetcher) newClient(p *feedtransport.Profile) (*http.Client, error) {
 	retryClient := retryablehttp.NewClient()
 	retryClient.RetryMax = 3
 	retryClient.RetryWaitMin = 1 * time.Second
 	retryClient.RetryWaitMax = 5 * time.Second
 	retryClient.Logger = nil // Disable verbose logging by default
+	retryClient.HTTPClient.CheckRedirect = checkRedirect
+	// Bounds each attempt; the body is read before the client timer stops.
+	retryClient.HTTPClient.Timeout = f.limits.RequestTimeout
+	retryClient.CheckRetry = checkRetry
+	// Return the last response instead of a generic error so that its status
+	// code and Retry-After reach the fetcher service.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	if p != nil {
+		transport, ok := retryClient.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unexpected transport type %T", retryClient.HTTPClient.Transport)
+		}
+		if err := p.Configure(transport); err != nil {
+			return nil, err
+		}
+		if p.RetryMax != nil {
+			retryClient.RetryMax = *p.RetryMax
+		}
+	}
 
+	// Credentials wrap the innermost transport, as redirects are followed by
+	// retryClient.HTTPClient and each hop has to be checked on its own.
+	retryClient.HTTPClient.Transport = &credentialTransport{
+		base:        retryClient.HTTPClient.Transport,
+		credentials: f.feedCredentials,
+	}
 	client := retryClient.StandardClient()
 	client.Transport = &userAgentTransport{
 		base: client.Transport,
 		ua:   UserAgent,
 	}
+	if p != nil && p.Timeout > 0 {
+		client.Timeout = p.Timeout
+	}
+	return client, nil
+}
 
-	return &GofeedFetcher{
-		client: client,
-		store:  s,
+// SetLimits changes the size and time limits of fetches. Clients built for
+// transport profiles are rebuilt on their next use.
+func (f *GofeedFetcher) SetLimits(l FetchLimits) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limits = l
+	f.client, _ = f.newClient(nil)
+	for id, cached := range f.profileClients {
+		cached.client.CloseIdleConnections()
+		delete(f.profileClients, id)
 	}
 }
 
+// SetCredentialCipher enables per-feed credentials, decrypted with c.
+func (f *GofeedFetcher) SetCredentialCipher(c *feedauth.Cipher) {
+	f.cipher = c
+}
+
+// feedCredentials loads and decrypts the credentials stored for a feed, along
+// with the host of the feed URL they are meant for. It returns nil when the
+// feed has none.
+func (f *GofeedFetcher) feedCredentials(ctx context.Context, feedID string) (*feedauth.Credentials, string, error) {
+	row, err := f.store.GetFeedCredential(ctx, feedID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	feed, err := f.store.GetFeed(ctx, feedID)
+	if err != nil {
+		return nil, "", err
+	}
+	feedURL, err := url.Parse(feed.Url)
+	if err != nil {
+		return nil, "", err
+	}
+	if f.cipher == nil {
+		return nil, "", feedauth.ErrNoKey
+	}
+	creds, err := f.cipher.Decrypt(row.EncryptedData)
+	return creds, feedURL.Host, err
+}
+
 type userAgentTransport struct {
 	base http.RoundTripper
 	ua   string
@@ -60,9 +157,134 @@ func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error
 	return t.base.RoundTrip(req2)
 }
 
+// maxRedirects matches the default redirect limit of net/http.
+const maxRedirects = 10
+
+// permanentRedirectThreshold is the number of consecutive fetches that must be
+// permanently redirected to the same URL before the feed URL is rewritten.
+const permanentRedirectThreshold = 3
+
+type redirectTraceKey struct{}
+
+// redirectTrace records where a request ended up through permanent redirects only.
+type redirectTrace struct {
+	permanentURL string
+	temporary    bool
+}
+
+// checkRedirect records the redirect chain into the request's redirectTrace.
+// The permanent target is the last URL reached by an unbroken run of 301/308
+// responses from the original URL.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	trace, ok := req.Context().Value(redirectTraceKey{}).(*redirectTrace)
+	if !ok || req.Response == nil {
+		return nil
+	}
+	if len(via) == 1 {
+		// First hop of a (possibly retried) request.
+		*trace = redirectTrace{}
+	}
+	if trace.temporary {
+		return nil
+	}
+	switch req.Response.StatusCode {
+	case http.StatusMovedPermanently, http.StatusPermanentRedirect:
+		trace.permanentURL = req.URL.String()
+	default:
+		trace.temporary = true
+	}
+	return nil
+}
+
+type feedIDKey struct{}
+
+// credentialTransport applies the credentials of the feed being fetched,
+// identified by the feed ID in the request context. They are only sent to the
+// host of the feed URL, not to hosts the feed redirects to.
+type credentialTransport struct {
+	base        http.RoundTripper
+	credentials func(ctx context.Context, feedID string) (*feedauth.Credentials, string, error)
+}
+
+func (t *credentialTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	feedID, ok := req.Context().Value(feedIDKey{}).(string)
+	if !ok || feedID == "" {
+		return t.base.RoundTrip(req)
+	}
+	creds, host, err := t.credentials(req.Context(), feedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feed credentials: %w", err)
+	}
+	if creds == nil || !strings.EqualFold(req.URL.Host, host) {
+		return t.base.RoundTrip(req)
+	}
+	req2 := req.Clone(req.Context())
+	creds.Apply(req2)
+	return t.base.RoundTrip(req2)
+}
+
 // ErrNotModified is returned when the feed has not been modified.
 var ErrNotModified = fmt.Errorf("feed not modified")
 
+// HTTPStatusError is returned when a feed responds with a non-2xx status code.
+type HTTPStatusError struct {
+	StatusCode int
+	// RetryAfter is the delay requested by a 429 or 503 response, if any.
+	RetryAfter time.Duration
+}
+
+func (e *HTTPStatusError) Error() string {
+	if e.StatusCode >= 500 {
+		return fmt.Sprintf("server error: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
+func newHTTPStatusError(resp *http.Response) *HTTPStatusError {
+	err := &HTTPStatusError{StatusCode: resp.StatusCode}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		err.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+	return err
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP
+// date. It returns zero when the header is missing, invalid or in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// checkRetry leaves rate limiting to the fetcher service: a 429, or a 503 with
+// Retry-After, is returned at once so the host's next fetch can be pushed back
+// instead of hammering it from the same worker.
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if resp != nil {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return false, nil
+		}
+		if resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != "" {
+			return false, nil
+		}
+	}
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
+
 // Fetch fetches the feed from the given URL.
 func (f *GofeedFetcher) Fetch(ctx context.Context, feedID string, url string) (*gofeed.Feed, error) {
 	var etag, lastModified string
@@ -78,7 +300,18 @@ func (f *GofeedFetcher) Fetch(ctx context.Context, feedID string, url string) (*
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if f.limits.TotalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.limits.TotalTimeout)
+		defer cancel()
+	}
+
+	trace := &redirectTrace{}
+	reqCtx := context.WithValue(ctx, redirectTraceKey{}, trace)
+	if feedID != "" {
+		reqCtx = context.WithValue(reqCtx, feedIDKey{}, feedID)
+	}
+	req, err := http.NewRequestWithContext(reqCtx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -90,32 +323,47 @@ func (f *GofeedFetcher) Fetch(ctx context.Context, feedID string, url string) (*
 		req.Header.Set("If-Modified-Since", lastModified)
 	}
 
-	resp, err := f.client.Do(req)
+	client, err := f.clientFor(ctx, feedID)
 	if err != nil {
-		if feedID != "" {
-			_ = f.store.DeleteFeedFetcher(ctx, feedID)
-		}
 		return nil, err
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode == http.StatusNotModified {
-		return nil, ErrNotModified
+	// Validators are kept on network errors and 5xx responses, which are
+	// usually transient.
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, classifyTimeout(err)
 	}
+	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode >= 500 {
+	if resp.StatusCode == http.StatusNotModified {
 		if feedID != "" {
-			_ = f.store.DeleteFeedFetcher(ctx, feedID)
+			f.recordRedirect(ctx, feedID, url, trace.permanentURL)
+			_ = f.store.RecordFeedCacheHint(ctx, store.RecordFeedCacheHintParams{
+				FeedID:     feedID,
+				HintMaxAge: durationSeconds(parseCacheMaxAge(resp.Header, time.Now())),
+			})
 		}
-		return nil, fmt.Errorf("server error: %d", resp.StatusCode)
+		return nil, ErrNotModified
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, newHTTPStatusError(resp)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if err := checkContentType(contentType); err != nil {
+		return nil, err
+	}
+	body, err := readBody(resp.Body, resp.ContentLength, f.limits.MaxBodySize)
+	if err != nil {
+		return nil, err
 	}
 
 	// Update cache info
 	if feedID != "" {
+		f.recordRedirect(ctx, feedID, url, trace.permanentURL)
+
 		newEtag := resp.Header.Get("ETag")
 		newLastModified := resp.Header.Get("Last-Modified")
 		if newEtag != "" || newLastModified != "" {
@@ -132,26 +380,150 @@ func (f *GofeedFetcher) Fetch(ctx context.Context, feedID string, url string) (*
 		}
 	}
 
+	// Decode first, as feeds in charsets such as UTF-16 cannot be detected
+	// from their raw bytes.
+	body, err = decodeBody(body, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if gofeed.DetectFeedType(bytes.NewReader(body)) == gofeed.FeedTypeUnknown {
+		if isHTMLResponse(contentType, body) {
+			return nil, f.discoverFeeds(ctx, responseURL(req, resp), body)
+		}
+		return nil, &FetchError{Kind: FetchErrorNotAFeed, Err: gofeed.ErrFeedTypeNotDetected}
+	}
+
 	fp := gofeed.NewParser()
-	feed, err := fp.Parse(resp.Body)
+	rssTranslator := &rssHintTranslator{}
+	fp.RSSTranslator = rssTranslator
+	feed, err := fp.Parse(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
-	for _, item := range feed.Items {
+	if feedID != "" {
+		hints := feedScheduleHints(feed, rssTranslator.feed)
+		hints.MaxAge = parseCacheMaxAge(resp.Header, time.Now())
+		_ = f.store.RecordFeedScheduleHints(ctx, hints.recordParams(feedID))
+	}
+
+	if hub, self := discoverWebSub(resp.Header, body, responseURL(req, resp)); hub != "" {
+		if feed.Custom == nil {
+			feed.Custom = make(map[string]string)
+		}
+		feed.Custom[websubHubKey] = hub
+		if self != "" {
+			feed.Custom[websubSelfKey] = self
+		}
+	}
+
+	convertItemsToMarkdown(feed.Items, responseURL(req, resp).String())
+
+	return feed, nil
+}
+
+// recordRedirect tracks permanent redirects of a feed and rewrites its URL once
+// the same target has been seen permanentRedirectThreshold times in a row. The
+// rewrite is skipped while another feed is already subscribed to the target.
+func (f *GofeedFetcher) recordRedirect(ctx context.Context, feedID, feedURL, permanentURL string) {
+	if permanentURL == "" || permanentURL == feedURL {
+		_ = f.store.ClearFeedRedirect(ctx, feedID)
+		return
+	}
+
+	count, err := f.store.RecordFeedRedirect(ctx, store.RecordFeedRedirectParams{
+		FeedID:      feedID,
+		RedirectUrl: &permanentURL,
+	})
+	if err != nil || count < permanentRedirectThreshold {
+		return
+	}
+
+	existing, err := f.store.GetFeedByURL(ctx, permanentURL)
+	if err == nil && existing.ID != feedID {
+		// The redirect stays pending so the conflict is visible through the API.
+		return
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+
+	if err := f.store.UpdateFeedURL(ctx, store.UpdateFeedURLParams{
+		ID:  feedID,
+		Url: permanentURL,
+	}); err != nil {
+		return
+	}
+	_ = f.store.ClearFeedRedirect(ctx, feedID)
+}
+
+// Keys under which convertItemsToMarkdown keeps the sanitized HTML of an
+// item, and the hash of its text before conversion, in gofeed.Item.Custom.
+const (
+	descriptionHTMLKey = "description_html"
+	contentHTMLKey     = "content_html"
+	sourceHashKey      = "source_hash"
+)
+
+// convertItemsToMarkdown converts item descriptions and contents from HTML to
+// Markdown in place, keeping the sanitized HTML and the source hash in the
+// item's Custom map.
+// Relative URLs are resolved against the item's link, or against feedURL,
+// the URL the feed was fetched from, for items without one.
+func convertItemsToMarkdown(items []*gofeed.Item, feedURL string) {
+	for _, item := range items {
+		base := itemBaseURL(item, feedURL)
+		setItemCustom(item, sourceHashKey, store.ItemContentHash(&item.Title, &item.Description, &item.Content))
 		if item.Description != "" {
-			desc, err := ConvertHTMLToMarkdown(item.Description)
+			desc, sanitized, err := ConvertHTML(item.Description, base)
 			if err == nil {
 				item.Description = desc
+				setItemCustom(item, descriptionHTMLKey, sanitized)
 			}
 		}
 		if item.Content != "" {
-			content, err := ConvertHTMLToMarkdown(item.Content)
+			content, sanitized, err := ConvertHTML(item.Content, base)
 			if err == nil {
 				item.Content = content
+				setItemCustom(item, contentHTMLKey, sanitized)
 			}
 		}
 	}
+}
 
-	return feed, nil
+func setItemCustom(item *gofeed.Item, key, value string) {
+	if item.Custom == nil {
+		item.Custom = make(map[string]string)
+	}
+	item.Custom[key] = value
+}
+
+// itemBaseURL returns the URL the content of an item is relative to: its
+// link, resolved against the feed's URL.
+func itemBaseURL(item *gofeed.Item, feedURL string) string {
+	feed, err := url.Parse(feedURL)
+	if err != nil {
+		feed = nil
+	}
+	if item.Link != "" {
+		link, err := url.Parse(strings.TrimSpace(item.Link))
+		if err == nil {
+			if feed != nil {
+				link = feed.ResolveReference(link)
+			}
+			if link.IsAbs() {
+				return link.String()
+			}
+		}
+	}
+	return feedURL
+}
+
+// responseURL returns the final URL of resp after redirects.
+func responseURL(req *http.Request, resp *http.Response) *url.URL {
+	if resp.Request != nil && resp.Request.URL != nil {
+		return resp.Request.URL
+	}
+	return req.URL
 }
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,6 +28,9 @@ type FetcherService struct {
 	fetchInterval time.Duration
 	fetching      sync.Map // feedID -> struct{}{}
 	tracer        trace.Tracer
+	websub        *WebSubService
+	fullContent   *FullContentService
+	icons         IconFetcher
 }
 
 // NewFetcherService creates a new FetcherService.
@@ -40,6 +46,21 @@ func NewFetcherService(s *store.Store, f FeedFetcher, p *WorkerPool, wq *WriteQu
 	}
 }
 
+// SetWebSub enables WebSub subscriptions for feeds that advertise a hub.
+func (s *FetcherService) SetWebSub(w *WebSubService) {
+	s.websub = w
+}
+
+// SetFullContent enables full content extraction for feeds that opt in.
+func (s *FetcherService) SetFullContent(fc *FullContentService) {
+	s.fullContent = fc
+}
+
+// SetIcons enables looking up the icons of fetched feeds.
+func (s *FetcherService) SetIcons(f IconFetcher) {
+	s.icons = f
+}
+
 // FetchFeedsByIDsSync initiates the fetching process for specified feeds and waits for completion.
 func (s *FetcherService) FetchFeedsByIDsSync(ctx context.Context, ids []string) ([]FeedFetchResult, error) {
 	ctx, span := s.tracer.Start(ctx, "FetcherService.FetchFeedsByIDsSync",
@@ -100,16 +121,21 @@ func (s *FetcherService) fetchAndSaveSync(ctx context.Context, f store.FullFeed)
 		if errors.Is(err, ErrNotModified) {
 			s.logger.InfoContext(ctx, "feed not modified, skipping sync", "url", f.Url, "id", f.ID)
 			// Still update last_fetched_at and next_fetch to avoid immediate re-fetch
-			s.markFetched(ctx, f.ID, nil)
+			s.markFetched(ctx, f.ID, http.StatusNotModified, nil)
 			return &FeedFetchResult{
 				FeedID:  f.ID,
 				Success: true,
 			}, nil
 		}
 		s.logger.ErrorContext(ctx, "failed to fetch feed sync", "url", f.Url, "error", err)
+		// A manual refresh records the failure but keeps the existing schedule.
+		s.markFetchFailed(ctx, f.ID, f.Url, err, false)
 		return nil, err
 	}
 
+	s.subscribeWebSub(ctx, f, parsedFeed)
+	s.refreshMetadata(ctx, f, parsedFeed)
+
 	result := &FeedFetchResult{
 		FeedID:  f.ID,
 		Success: true,
@@ -117,13 +143,8 @@ func (s *FetcherService) fetchAndSaveSync(ctx context.Context, f store.FullFeed)
 
 	if len(parsedFeed.Items) > 0 {
 		resChan := make(chan SaveItemsResult, 1)
-		job := &SaveItemsJob{
-			Items:      make([]store.SaveFetchedItemParams, 0, len(parsedFeed.Items)),
-			ResultChan: resChan,
-		}
-		for _, item := range parsedFeed.Items {
-			job.Items = append(job.Items, s.normalizeItem(f.ID, item))
-		}
+		job := s.newSaveItemsJob(f.ID, f.FetchFullContent != 0, parsedFeed.Items)
+		job.ResultChan = resChan
 		s.writeQueue.Submit(job)
 
 		select {
@@ -138,7 +159,7 @@ func (s *FetcherService) fetchAndSaveSync(ctx context.Context, f store.FullFeed)
 	}
 
 	// Update last_fetched_at and next_fetch
-	s.markFetched(ctx, f.ID, nil)
+	s.markFetched(ctx, f.ID, http.StatusOK, nil)
 
 	return result, nil
 }
@@ -168,10 +189,12 @@ func (s *FetcherService) FetchAllFeeds(ctx context.Context) error {
 			if adjusted.After(now) {
 				s.logger.InfoContext(ctx, "feed is in ignore window, skipping fetch", "feed_id", row.ID, "next_fetch", adjusted)
 				nextFetchStr := adjusted.Format(time.RFC3339)
+				reason := scheduleReasonIgnoreWindow
 				s.writeQueue.Submit(&MarkFetchedJob{
 					Params: store.MarkFeedFetchedParams{
-						FeedID:    row.ID,
-						NextFetch: &nextFetchStr,
+						FeedID:         row.ID,
+						NextFetch:      &nextFetchStr,
+						ScheduleReason: &reason,
 					},
 				})
 				continue
@@ -179,23 +202,26 @@ func (s *FetcherService) FetchAllFeeds(ctx context.Context) error {
 		}
 
 		feed := store.FullFeed{
-			ID:            row.ID,
-			Url:           row.Url,
-			Link:          row.Link,
-			Title:         row.Title,
-			Description:   row.Description,
-			Lang:          row.Lang,
-			ImageUrl:      row.ImageUrl,
-			Copyright:     row.Copyright,
-			FeedType:      row.FeedType,
-			FeedVersion:   row.FeedVersion,
-			CreatedAt:     row.CreatedAt,
-			UpdatedAt:     row.UpdatedAt,
-			LastFetchedAt: row.LastFetchedAt,
-			NextFetch:     row.NextFetch,
+			ID:               row.ID,
+			Url:              row.Url,
+			Link:             row.Link,
+			Title:            row.Title,
+			Description:      row.Description,
+			Lang:             row.Lang,
+			ImageUrl:         row.ImageUrl,
+			Copyright:        row.Copyright,
+			FeedType:         row.FeedType,
+			FeedVersion:      row.FeedVersion,
+			FetchFullContent: row.FetchFullContent,
+			UnreadOnRevision: row.UnreadOnRevision,
+			TitleOverridden:  row.TitleOverridden,
+			CreatedAt:        row.CreatedAt,
+			UpdatedAt:        row.UpdatedAt,
+			LastFetchedAt:    row.LastFetchedAt,
+			NextFetch:        row.NextFetch,
 		}
 		f := feed // capture loop variable
-		s.pool.AddTask(func(ctx context.Context) error {
+		s.pool.AddHostTask(feedHost(f.Url), func(ctx context.Context) error {
 			return s.FetchAndSave(ctx, f)
 		})
 	}
@@ -221,7 +247,7 @@ func (s *FetcherService) FetchFeedsByIDs(ctx context.Context, ids []string) erro
 	for _, row := range feeds {
 		feed := store.FullFeed(row)
 		f := feed // capture loop variable
-		s.pool.AddTask(func(ctx context.Context) error {
+		s.pool.AddHostTask(feedHost(f.Url), func(ctx context.Context) error {
 			return s.FetchAndSave(ctx, f)
 		})
 	}
@@ -251,30 +277,118 @@ func (s *FetcherService) FetchAndSave(ctx context.Context, f store.FullFeed) err
 		if errors.Is(err, ErrNotModified) {
 			s.logger.InfoContext(ctx, "feed not modified, skipping", "url", f.Url, "id", f.ID)
 			// Still update last_fetched_at and next_fetch to avoid immediate re-fetch
-			s.markFetched(ctx, f.ID, nil)
+			s.markFetched(ctx, f.ID, http.StatusNotModified, nil)
 			return nil
 		}
 		s.logger.ErrorContext(ctx, "failed to fetch feed", "url", f.Url, "error", err)
+		s.markFetchFailed(ctx, f.ID, f.Url, err, true)
 		return err
 	}
 
+	s.subscribeWebSub(ctx, f, parsedFeed)
+	s.refreshMetadata(ctx, f, parsedFeed)
+
 	if len(parsedFeed.Items) > 0 {
-		job := &SaveItemsJob{
-			Items: make([]store.SaveFetchedItemParams, 0, len(parsedFeed.Items)),
-		}
-		for _, item := range parsedFeed.Items {
-			job.Items = append(job.Items, s.normalizeItem(f.ID, item))
-		}
-		s.writeQueue.Submit(job)
+		s.writeQueue.Submit(s.newSaveItemsJob(f.ID, f.FetchFullContent != 0, parsedFeed.Items))
 	}
 
 	// Update last_fetched_at and next_fetch asynchronously
-	s.markFetched(ctx, f.ID, parsedFeed.Items)
+	s.markFetched(ctx, f.ID, http.StatusOK, parsedFeed.Items)
 
 	s.logger.DebugContext(ctx, "enqueued updates for feed", "url", f.Url, "items", len(parsedFeed.Items))
 	return nil
 }
 
+// subscribeWebSub subscribes to the hub advertised by a fetched feed, if any.
+func (s *FetcherService) subscribeWebSub(ctx context.Context, f store.FullFeed, parsedFeed *gofeed.Feed) {
+	if s.websub == nil || parsedFeed.Custom == nil {
+		return
+	}
+	hub := parsedFeed.Custom[websubHubKey]
+	if hub == "" {
+		return
+	}
+	topic := parsedFeed.Custom[websubSelfKey]
+	if topic == "" {
+		topic = f.Url
+	}
+	if err := s.websub.EnsureSubscribed(ctx, f.ID, hub, topic); err != nil {
+		s.logger.WarnContext(ctx, "failed to subscribe to websub hub", "feed_id", f.ID, "hub", hub, "error", err)
+	}
+}
+
+// refreshMetadata updates the stored title, description, link and image of
+// a feed when the fetched feed changed them, and looks up its icon when it
+// is missing or stale. A title set by the user is kept.
+func (s *FetcherService) refreshMetadata(ctx context.Context, f store.FullFeed, parsedFeed *gofeed.Feed) {
+	params := store.UpdateFeedParams{ID: f.ID}
+	changed := false
+	update := func(field **string, stored *string, fetched string) {
+		if fetched == "" || (stored != nil && *stored == fetched) {
+			return
+		}
+		*field = &fetched
+		changed = true
+	}
+	if f.TitleOverridden == 0 {
+		update(&params.Title, f.Title, parsedFeed.Title)
+	}
+	update(&params.Description, f.Description, parsedFeed.Description)
+	update(&params.Link, f.Link, parsedFeed.Link)
+	update(&params.Lang, f.Lang, parsedFeed.Language)
+	update(&params.Copyright, f.Copyright, parsedFeed.Copyright)
+	if parsedFeed.Image != nil {
+		update(&params.ImageUrl, f.ImageUrl, parsedFeed.Image.URL)
+	}
+	if changed {
+		s.writeQueue.Submit(&UpdateFeedJob{Params: params})
+	}
+
+	if s.icons == nil {
+		return
+	}
+	if icon, err := s.store.GetFeedIcon(ctx, f.ID); err == nil {
+		fetchedAt, err := time.Parse(time.RFC3339, icon.FetchedAt)
+		if err == nil && time.Since(fetchedAt) < iconRefreshInterval {
+			return
+		}
+	}
+	siteURL := parsedFeed.Link
+	if siteURL == "" {
+		siteURL = f.Url
+	}
+	var imageURL string
+	if parsedFeed.Image != nil {
+		imageURL = parsedFeed.Image.URL
+	}
+	icon, err := discoverIcon(ctx, s.icons, f.ID, siteURL, imageURL)
+	if err != nil {
+		// The previous icon, if any, is kept.
+		s.logger.WarnContext(ctx, "failed to find feed icon", "feed_id", f.ID, "error", err)
+		message := err.Error()
+		icon.Error = &message
+	}
+	icon.FetchedAt = time.Now().UTC().Format(time.RFC3339)
+	s.writeQueue.Submit(&SaveFeedIconJob{Params: icon})
+}
+
+// newSaveItemsJob builds the job saving the fetched items of a feed. With
+// fullContent set, new items are queued for full content extraction.
+func (s *FetcherService) newSaveItemsJob(feedID string, fullContent bool, items []*gofeed.Item) *SaveItemsJob {
+	job := &SaveItemsJob{
+		Items: make([]store.SaveFetchedItemParams, 0, len(items)),
+	}
+	for _, item := range items {
+		job.Items = append(job.Items, s.normalizeItem(feedID, item))
+	}
+	if fullContent && s.fullContent != nil {
+		job.OnNewItems = func(items []store.Item) {
+			s.fullContent.Enqueue(feedID, items)
+		}
+	}
+	return job
+}
+
 func (s *FetcherService) normalizeItem(feedID string, item *gofeed.Item) store.SaveFetchedItemParams {
 	params := store.SaveFetchedItemParams{
 		FeedID:      feedID,
@@ -308,6 +422,16 @@ func (s *FetcherService) normalizeItem(feedID string, item *gofeed.Item) store.S
 		}
 	}
 
+	if html, ok := item.Custom[descriptionHTMLKey]; ok {
+		params.DescriptionHtml = &html
+	}
+	if html, ok := item.Custom[contentHTMLKey]; ok {
+		params.ContentHtml = &html
+	}
+	params.SourceHash = item.Custom[sourceHashKey]
+
+	params.Enclosures = itemEnclosures(item)
+
 	return params
 }
 
@@ -344,25 +468,150 @@ func (s *FetcherService) getNextFetchInterval(ctx context.Context, feedID string
 	return AdjustIntervalForPeak(distribution, baseInterval, minInterval, nextFetchTime)
 }
 
-func (s *FetcherService) markFetched(ctx context.Context, feedID string, items []*gofeed.Item) {
+func (s *FetcherService) markFetched(ctx context.Context, feedID string, statusCode int, items []*gofeed.Item) {
 	now := time.Now().UTC()
 	lastFetched := now.Format(time.RFC3339)
 	interval := s.getNextFetchInterval(ctx, feedID, items)
+	reason := scheduleReasonAdaptive
+
+	// Publisher hints are lower bounds of the adaptive interval.
+	var hints scheduleHints
+	if cache, err := s.store.GetFeedFetcher(ctx, feedID); err == nil {
+		hints = storedScheduleHints(cache)
+	}
+	if hinted, hintReason := hints.applyIntervalHints(interval); hintReason != "" {
+		interval, reason = hinted, hintReason
+	}
+
+	// Push-enabled feeds only need an occasional poll as a safety net.
+	if s.websub != nil && interval < s.websub.config.PollInterval && s.websub.IsPushActive(ctx, feedID) {
+		interval = s.websub.config.PollInterval
+		reason = scheduleReasonWebSub
+	}
 	nextFetchTime := now.Add(interval)
+	if skipped, skipReason := hints.applySkipWindows(nextFetchTime); skipReason != "" {
+		nextFetchTime, reason = skipped, skipReason
+	}
 
 	windows, err := s.store.ListActiveIgnoreWindowsForFeed(ctx, feedID)
 	if err != nil {
 		s.logger.WarnContext(ctx, "failed to list active ignore windows for feed", "feed_id", feedID, "error", err)
 	} else if len(windows) > 0 {
-		nextFetchTime = AdjustNextFetchForIgnoreWindows(nextFetchTime, windows)
+		if adjusted := AdjustNextFetchForIgnoreWindows(nextFetchTime, windows); !adjusted.Equal(nextFetchTime) {
+			nextFetchTime, reason = adjusted, scheduleReasonIgnoreWindow
+		}
 	}
 
 	nextFetch := nextFetchTime.Format(time.RFC3339)
+	status := int64(statusCode)
 	s.writeQueue.Submit(&MarkFetchedJob{
 		Params: store.MarkFeedFetchedParams{
-			LastFetchedAt: &lastFetched,
-			NextFetch:     &nextFetch,
-			FeedID:        feedID,
+			LastFetchedAt:  &lastFetched,
+			NextFetch:      &nextFetch,
+			ScheduleReason: &reason,
+			FeedID:         feedID,
+		},
+		Success: &store.RecordFeedFetchSuccessParams{
+			FeedID:         feedID,
+			LastStatusCode: &status,
 		},
 	})
 }
+
+// goneDisabledReason is recorded when a feed is disabled after a 410 Gone.
+const goneDisabledReason = "feed returned 410 Gone"
+
+// markFetchFailed records a failed fetch. When reschedule is set, next_fetch is
+// pushed back exponentially with the number of consecutive failures. A 410 Gone
+// disables the feed until a later fetch succeeds, and a Retry-After on 429 or
+// 503 defers every feed of the same host. The error kind, if known, is stored
+// alongside the message.
+func (s *FetcherService) markFetchFailed(ctx context.Context, feedID, feedURL string, fetchErr error, reschedule bool) {
+	errorMessage := fetchErr.Error()
+	params := store.RecordFeedFetchFailureParams{
+		FeedID:    feedID,
+		LastError: &errorMessage,
+	}
+	if kind := fetchErrorKind(fetchErr); kind != "" {
+		errorKind := string(kind)
+		params.LastErrorKind = &errorKind
+	}
+	var retryAt time.Time
+	var statusErr *HTTPStatusError
+	if errors.As(fetchErr, &statusErr) {
+		status := int64(statusErr.StatusCode)
+		params.LastStatusCode = &status
+
+		if statusErr.RetryAfter > 0 {
+			retryAt = time.Now().UTC().Add(statusErr.RetryAfter)
+			s.deferHost(ctx, feedURL, retryAt)
+		}
+
+		if statusErr.StatusCode == http.StatusGone {
+			s.logger.WarnContext(ctx, "feed is gone, disabling", "feed_id", feedID)
+			reason := goneDisabledReason
+			s.writeQueue.Submit(&DisableFeedJob{
+				Params: store.DisableFeedParams{
+					FeedID:         feedID,
+					DisabledReason: &reason,
+				},
+			})
+		}
+	}
+
+	if reschedule {
+		var failures int64
+		if cache, err := s.store.GetFeedFetcher(ctx, feedID); err == nil {
+			failures = cache.ConsecutiveFailures
+		}
+		nextFetchTime := time.Now().UTC().Add(CalculateFailureBackoff(s.fetchInterval, failures+1, maxFailureBackoff))
+		reason := scheduleReasonBackoff
+		if retryAt.After(nextFetchTime) {
+			nextFetchTime = retryAt
+			reason = scheduleReasonRetryAfter
+		}
+
+		windows, err := s.store.ListActiveIgnoreWindowsForFeed(ctx, feedID)
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to list active ignore windows for feed", "feed_id", feedID, "error", err)
+		} else if len(windows) > 0 {
+			if adjusted := AdjustNextFetchForIgnoreWindows(nextFetchTime, windows); !adjusted.Equal(nextFetchTime) {
+				nextFetchTime, reason = adjusted, scheduleReasonIgnoreWindow
+			}
+		}
+		nextFetch := nextFetchTime.Format(time.RFC3339)
+		params.NextFetch = &nextFetch
+		params.ScheduleReason = &reason
+	}
+
+	s.writeQueue.Submit(&RecordFetchFailureJob{Params: params})
+}
+
+// deferHost pushes back every fetch of the feed's host until retryAt, both in
+// the worker pool queue and in the stored schedule.
+func (s *FetcherService) deferHost(ctx context.Context, feedURL string, retryAt time.Time) {
+	host := feedHost(feedURL)
+	if host == "" {
+		return
+	}
+	s.logger.WarnContext(ctx, "host asked to retry later, deferring its fetches", "host", host, "retry_at", retryAt)
+	if s.pool != nil {
+		s.pool.DeferHost(host, retryAt)
+	}
+	s.writeQueue.Submit(&PostponeHostFetchesJob{
+		Params: store.PostponeHostFetchesParams{
+			Host:      host,
+			NextFetch: retryAt.Format(time.RFC3339),
+		},
+	})
+}
+
+// feedHost returns the lower-cased host, including any port, that a feed URL
+// is fetched from.
+func feedHost(feedURL string) string {
+	u, err := url.Parse(feedURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Host)
+}
@@ -145,6 +145,10 @@ func TestFetcherService_FetchFeedsByIDsSync_Errors(t *testing.T) {
 			} else {
 				assert.Assert(t, *updatedFeed.NextFetch == *initialNextFetch, "next_fetch should NOT have been updated on error")
 			}
+
+			// Verify the failure itself is recorded
+			assert.DeepEqual(t, updatedFeed.ConsecutiveFailures, new(int64(1)))
+			assert.DeepEqual(t, updatedFeed.LastError, new(fetchErr.Error()))
 		})
 	}
 
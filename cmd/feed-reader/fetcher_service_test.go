@@ -3,8 +3,10 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"sync"
 	"testing"
 	"time"
@@ -144,6 +146,179 @@ func TestFetcherService_FetchAndSave(t *testing.T) {
 	assert.Assert(t, found2, "item 2 not found in DB")
 }
 
+func TestFetcherService_FetchAndSave_FailureBackoff(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+
+	feed, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-failing", Url: "http://failing"})
+	assert.NilError(t, err)
+
+	fetcher := &mockFetcher{err: &HTTPStatusError{StatusCode: http.StatusServiceUnavailable}}
+	service := NewFetcherService(s, fetcher, nil, wq, logger, 30*time.Minute)
+
+	for i, want := range []time.Duration{30 * time.Minute, time.Hour, 2 * time.Hour} {
+		start := time.Now().UTC()
+		err := service.FetchAndSave(ctx, store.FullFeed{ID: feed.ID, Url: feed.Url})
+		assert.ErrorContains(t, err, "server error: 503")
+		time.Sleep(100 * time.Millisecond)
+
+		updated, err := queries.GetFeed(ctx, feed.ID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, updated.ConsecutiveFailures, new(int64(i+1)))
+		assert.DeepEqual(t, updated.LastStatusCode, new(int64(http.StatusServiceUnavailable)))
+		assert.DeepEqual(t, updated.LastError, new("server error: 503"))
+		assert.Assert(t, updated.LastFetchedAt == nil)
+		assert.Assert(t, updated.LastSuccessAt == nil)
+		assert.Assert(t, updated.NextFetch != nil)
+
+		nextFetch, err := time.Parse(time.RFC3339, *updated.NextFetch)
+		assert.NilError(t, err)
+		delay := nextFetch.Sub(start)
+		assert.Assert(t, delay > want-time.Minute && delay < want+time.Minute, "failure %d: expected ~%v backoff, got %v", i+1, want, delay)
+	}
+
+	// A successful fetch resets the failure state.
+	fetcher.err = nil
+	fetcher.feed = &gofeed.Feed{}
+	err = service.FetchAndSave(ctx, store.FullFeed{ID: feed.ID, Url: feed.Url})
+	assert.NilError(t, err)
+	time.Sleep(100 * time.Millisecond)
+
+	updated, err := queries.GetFeed(ctx, feed.ID)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, updated.ConsecutiveFailures, new(int64(0)))
+	assert.DeepEqual(t, updated.LastStatusCode, new(int64(http.StatusOK)))
+	assert.Assert(t, updated.LastError == nil)
+	assert.Assert(t, updated.LastSuccessAt != nil)
+}
+
+func TestFetcherService_FetchAndSave_GoneDisablesFeed(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+
+	feed, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-gone", Url: "http://gone"})
+	assert.NilError(t, err)
+
+	fetcher := &mockFetcher{err: &HTTPStatusError{StatusCode: http.StatusGone}}
+	service := NewFetcherService(s, fetcher, nil, wq, logger, 30*time.Minute)
+
+	err = service.FetchAndSave(ctx, store.FullFeed{ID: feed.ID, Url: feed.Url})
+	assert.ErrorContains(t, err, "unexpected status code: 410")
+	time.Sleep(100 * time.Millisecond)
+
+	updated, err := queries.GetFeed(ctx, feed.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, updated.DisabledAt != nil)
+	assert.DeepEqual(t, updated.DisabledReason, new(goneDisabledReason))
+
+	// Disabled feeds are never due, even once next_fetch has passed.
+	past := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+	err = queries.MarkFeedFetched(ctx, store.MarkFeedFetchedParams{FeedID: feed.ID, NextFetch: &past})
+	assert.NilError(t, err)
+	due, err := queries.ListFeedsToFetch(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(due), 0)
+
+	// A successful manual refresh re-enables the feed.
+	fetcher.err = nil
+	fetcher.feed = &gofeed.Feed{}
+	results, err := service.FetchFeedsByIDsSync(ctx, []string{feed.ID})
+	assert.NilError(t, err)
+	assert.Assert(t, results[0].Success)
+	time.Sleep(100 * time.Millisecond)
+
+	updated, err = queries.GetFeed(ctx, feed.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, updated.DisabledAt == nil)
+	assert.Assert(t, updated.DisabledReason == nil)
+}
+
+func TestFetcherService_FetchAndSave_RetryAfterDefersHost(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+	pool := NewWorkerPool(1)
+	pool.Start(ctx)
+
+	limited, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-limited", Url: "https://news.example/world.xml"})
+	assert.NilError(t, err)
+	sibling, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-sibling", Url: "https://NEWS.example/sports.xml"})
+	assert.NilError(t, err)
+	other, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-other", Url: "https://blog.example/feed.xml"})
+	assert.NilError(t, err)
+
+	fetcher := &mockFetcher{err: &HTTPStatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: 2 * time.Hour}}
+	service := NewFetcherService(s, fetcher, pool, wq, logger, 30*time.Minute)
+
+	start := time.Now().UTC()
+	err = service.FetchAndSave(ctx, store.FullFeed{ID: limited.ID, Url: limited.Url})
+	assert.ErrorContains(t, err, "unexpected status code: 429")
+	time.Sleep(100 * time.Millisecond)
+
+	for _, id := range []string{limited.ID, sibling.ID} {
+		updated, err := queries.GetFeed(ctx, id)
+		assert.NilError(t, err)
+		assert.Assert(t, updated.NextFetch != nil, "feed %s", id)
+		nextFetch, err := time.Parse(time.RFC3339, *updated.NextFetch)
+		assert.NilError(t, err)
+		delay := nextFetch.Sub(start)
+		assert.Assert(t, delay > 2*time.Hour-time.Minute && delay < 2*time.Hour+time.Minute, "feed %s: expected ~2h delay, got %v", id, delay)
+	}
+
+	untouched, err := queries.GetFeed(ctx, other.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, untouched.NextFetch == nil)
+
+	stats := pool.HostStats()
+	assert.Equal(t, len(stats), 1)
+	assert.Equal(t, stats[0].Host, "news.example")
+	assert.Assert(t, stats[0].DeferredUntil != nil)
+}
+
+func TestFetcherService_FetchAndSave_RecordsErrorKind(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+
+	feed, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-large", Url: "https://large.example/feed.xml"})
+	assert.NilError(t, err)
+
+	fetcher := &mockFetcher{err: &FetchError{Kind: FetchErrorTooLarge, Err: errors.New("body exceeds 1024 bytes")}}
+	service := NewFetcherService(s, fetcher, nil, wq, logger, 30*time.Minute)
+
+	err = service.FetchAndSave(ctx, store.FullFeed{ID: feed.ID, Url: feed.Url})
+	assert.ErrorContains(t, err, "response too large")
+	time.Sleep(100 * time.Millisecond)
+
+	updated, err := queries.GetFeed(ctx, feed.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, updated.LastErrorKind != nil)
+	assert.Equal(t, *updated.LastErrorKind, string(FetchErrorTooLarge))
+
+	fetcher.err = nil
+	fetcher.feed = &gofeed.Feed{Title: "Recovered"}
+	assert.NilError(t, service.FetchAndSave(ctx, store.FullFeed{ID: feed.ID, Url: feed.Url}))
+	time.Sleep(100 * time.Millisecond)
+
+	updated, err = queries.GetFeed(ctx, feed.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, updated.LastErrorKind == nil)
+}
+
 func TestFetcherService_FetchAllFeeds_Interval(t *testing.T) {
 	ctx := context.Background()
 	queries, db := setupTestDB(t)
@@ -459,7 +634,7 @@ func TestFetcherService_MarkFetched_IgnoreWindows(t *testing.T) {
 		})
 		assert.NilError(t, err)
 
-		service.markFetched(ctx, feed.ID, nil)
+		service.markFetched(ctx, feed.ID, http.StatusOK, nil)
 		time.Sleep(100 * time.Millisecond)
 
 		updated, err := queries.GetFeed(ctx, feed.ID)
@@ -514,7 +689,7 @@ func TestFetcherService_MarkFetched_IgnoreWindows(t *testing.T) {
 		})
 		assert.NilError(t, err)
 
-		service.markFetched(ctx, feed.ID, nil)
+		service.markFetched(ctx, feed.ID, http.StatusOK, nil)
 		time.Sleep(100 * time.Millisecond)
 
 		updated, err := queries.GetFeed(ctx, feed.ID)
@@ -2,14 +2,20 @@ package main
 
 import (
 	"context"
-	"database/sql"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+	"unicode/utf16"
 
 	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
 	"github.com/nakatanakatana/feed-reader/internal/primarydb"
 	schema "github.com/nakatanakatana/feed-reader/sql"
 	"github.com/nakatanakatana/feed-reader/store"
@@ -164,9 +170,55 @@ func TestGofeedFetcher_ConditionalFetch(t *testing.T) {
 		assert.Equal(t, *cache.LastModified, newLastMod)
 	})
 
-	t.Run("Deletes cache on 5xx", func(t *testing.T) {
+	t.Run("Records schedule hints on 200 OK", func(t *testing.T) {
+		feedContent := `<?xml version="1.0" encoding="UTF-8" ?>
+<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/"><channel><title>T</title>
+<ttl>90</ttl>
+<skipHours><hour>1</hour><hour>24</hour></skipHours>
+<skipDays><day>Sunday</day></skipDays>
+<sy:updatePeriod>daily</sy:updatePeriod>
+<sy:updateFrequency>2</sy:updateFrequency>
+</channel></rss>`
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cache-Control", "public, max-age=1800")
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = fmt.Fprint(w, feedContent)
+		}))
+		defer server.Close()
+
+		_, err := f.Fetch(context.Background(), feedID, server.URL)
+		assert.NilError(t, err)
+
+		cache, err := s.GetFeedFetcher(context.Background(), feedID)
+		assert.NilError(t, err)
+		assert.Equal(t, *cache.HintMaxAge, int64(1800))
+		assert.Equal(t, *cache.HintTtl, int64(90*60))
+		assert.Equal(t, *cache.HintUpdatePeriod, int64(12*60*60))
+		assert.Equal(t, *cache.HintSkipHours, "0,1")
+		assert.Equal(t, *cache.HintSkipDays, "Sunday")
+	})
+
+	t.Run("Refreshes max-age on 304", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cache-Control", "max-age=600")
+			w.WriteHeader(http.StatusNotModified)
+		}))
+		defer server.Close()
+
+		_, err := f.Fetch(context.Background(), feedID, server.URL)
+		assert.ErrorIs(t, err, ErrNotModified)
+
+		cache, err := s.GetFeedFetcher(context.Background(), feedID)
+		assert.NilError(t, err)
+		assert.Equal(t, *cache.HintMaxAge, int64(600))
+		// Body hints are kept, since a 304 carries no feed.
+		assert.Equal(t, *cache.HintTtl, int64(90*60))
+	})
+
+	t.Run("Keeps cache on 5xx", func(t *testing.T) {
 		// Pre-create cache
-		etag := "to-be-deleted"
+		etag := "to-be-kept"
 		_, err := s.UpsertFeedFetcher(context.Background(), store.UpsertFeedFetcherParams{
 			FeedID: feedID,
 			Etag:   &etag,
@@ -181,9 +233,11 @@ func TestGofeedFetcher_ConditionalFetch(t *testing.T) {
 		_, err = f.Fetch(context.Background(), feedID, server.URL)
 		assert.Assert(t, err != nil)
 
+		// A transient error says nothing about the cached copy, so the
+		// validators survive for the next conditional request.
 		cache, err := s.GetFeedFetcher(context.Background(), feedID)
-		assert.Assert(t, err != nil, "expected error, but got cache: %+v", cache)
-		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NilError(t, err)
+		assert.Equal(t, *cache.Etag, etag)
 	})
 }
 
@@ -216,3 +270,506 @@ func TestGofeedFetcher_UserAgent(t *testing.T) {
 	}
 	assert.Equal(t, receivedUA, UserAgent)
 }
+
+func TestGofeedFetcher_PermanentRedirect(t *testing.T) {
+	ctx := context.Background()
+	db, err := primarydb.OpenDB(":memory:")
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(schema.Schema)
+	assert.NilError(t, err)
+	s := store.NewStore(db)
+
+	mux := http.NewServeMux()
+	mux.Handle("/moved", http.RedirectHandler("/new", http.StatusMovedPermanently))
+	mux.Handle("/moved-collision", http.RedirectHandler("/new", http.StatusMovedPermanently))
+	mux.Handle("/permanent-then-temporary", http.RedirectHandler("/temporary", http.StatusPermanentRedirect))
+	mux.Handle("/temporary", http.RedirectHandler("/new", http.StatusFound))
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = fmt.Fprint(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Moved</title></channel></rss>`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	f := NewGofeedFetcher(s)
+
+	createFeed := func(t *testing.T, id, url string) {
+		t.Helper()
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: url})
+		assert.NilError(t, err)
+	}
+
+	t.Run("rewrites the URL after consistent permanent redirects", func(t *testing.T) {
+		oldURL := server.URL + "/moved"
+		createFeed(t, "moved", oldURL)
+
+		for i := 1; i < permanentRedirectThreshold; i++ {
+			_, err := f.Fetch(ctx, "moved", oldURL)
+			assert.NilError(t, err)
+
+			feed, err := s.GetFeed(ctx, "moved")
+			assert.NilError(t, err)
+			assert.Equal(t, feed.Url, oldURL)
+			assert.DeepEqual(t, feed.RedirectUrl, new(server.URL+"/new"))
+		}
+
+		_, err := f.Fetch(ctx, "moved", oldURL)
+		assert.NilError(t, err)
+
+		feed, err := s.GetFeed(ctx, "moved")
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Url, server.URL+"/new")
+		assert.Assert(t, feed.RedirectUrl == nil)
+	})
+
+	t.Run("keeps the redirect pending when another feed owns the target", func(t *testing.T) {
+		// The previous subtest moved the "moved" feed to /new.
+		oldURL := server.URL + "/moved-collision"
+		createFeed(t, "collision", oldURL)
+
+		for range permanentRedirectThreshold + 1 {
+			_, err := f.Fetch(ctx, "collision", oldURL)
+			assert.NilError(t, err)
+		}
+
+		feed, err := s.GetFeed(ctx, "collision")
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Url, oldURL)
+		assert.DeepEqual(t, feed.RedirectUrl, new(server.URL+"/new"))
+	})
+
+	t.Run("only follows the permanent part of a redirect chain", func(t *testing.T) {
+		oldURL := server.URL + "/permanent-then-temporary"
+		createFeed(t, "mixed", oldURL)
+
+		_, err := f.Fetch(ctx, "mixed", oldURL)
+		assert.NilError(t, err)
+
+		feed, err := s.GetFeed(ctx, "mixed")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, feed.RedirectUrl, new(server.URL+"/temporary"))
+	})
+
+	t.Run("clears a pending redirect once the feed stops redirecting", func(t *testing.T) {
+		createFeed(t, "direct", server.URL+"/new?direct")
+		_, err := s.RecordFeedRedirect(ctx, store.RecordFeedRedirectParams{
+			FeedID:      "direct",
+			RedirectUrl: new(server.URL + "/elsewhere"),
+		})
+		assert.NilError(t, err)
+
+		_, err = f.Fetch(ctx, "direct", server.URL+"/new?direct")
+		assert.NilError(t, err)
+
+		feed, err := s.GetFeed(ctx, "direct")
+		assert.NilError(t, err)
+		assert.Assert(t, feed.RedirectUrl == nil)
+	})
+}
+
+func TestGofeedFetcher_Credentials(t *testing.T) {
+	ctx := context.Background()
+	db, err := primarydb.OpenDB(":memory:")
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(schema.Schema)
+	assert.NilError(t, err)
+	s := store.NewStore(db)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "alice" || password != "s3cret" || r.Header.Get("Cookie") != "session=abc" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = fmt.Fprint(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Private</title></channel></rss>`)
+	}))
+	defer server.Close()
+
+	feedID := "private"
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: feedID, Url: server.URL})
+	assert.NilError(t, err)
+
+	c, err := feedauth.NewCipher([]byte(strings.Repeat("k", feedauth.KeySize)))
+	assert.NilError(t, err)
+	encrypted, err := c.Encrypt(feedauth.Credentials{
+		AuthType: feedauth.AuthTypeBasic,
+		Username: "alice",
+		Password: "s3cret",
+		Headers:  map[string]string{"Cookie": "session=abc"},
+	})
+	assert.NilError(t, err)
+	err = s.UpsertFeedCredential(ctx, store.UpsertFeedCredentialParams{
+		FeedID:        feedID,
+		AuthType:      feedauth.AuthTypeBasic,
+		EncryptedData: encrypted,
+	})
+	assert.NilError(t, err)
+
+	t.Run("fails without a key", func(t *testing.T) {
+		f := NewGofeedFetcher(s)
+		_, err := f.Fetch(ctx, feedID, server.URL)
+		assert.ErrorContains(t, err, feedauth.ErrNoKey.Error())
+	})
+
+	t.Run("applies stored credentials", func(t *testing.T) {
+		f := NewGofeedFetcher(s)
+		f.SetCredentialCipher(c)
+		feed, err := f.Fetch(ctx, feedID, server.URL)
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Title, "Private")
+	})
+
+	t.Run("does not apply credentials without a feed ID", func(t *testing.T) {
+		f := NewGofeedFetcher(s)
+		f.SetCredentialCipher(c)
+		_, err := f.Fetch(ctx, "", server.URL)
+		assert.ErrorContains(t, err, "unexpected status code: 401")
+	})
+
+	t.Run("does not send credentials to another host on redirect", func(t *testing.T) {
+		var leaked []string
+		other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, header := range []string{"Authorization", "Cookie"} {
+				if r.Header.Get(header) != "" {
+					leaked = append(leaked, header)
+				}
+			}
+			w.Header().Set("Content-Type", "application/rss+xml")
+			_, _ = fmt.Fprint(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Elsewhere</title></channel></rss>`)
+		}))
+		defer other.Close()
+		redirecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, _, ok := r.BasicAuth(); !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			http.Redirect(w, r, other.URL+"/feed.xml", http.StatusFound)
+		}))
+		defer redirecting.Close()
+
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "redirecting", Url: redirecting.URL})
+		assert.NilError(t, err)
+		err = s.UpsertFeedCredential(ctx, store.UpsertFeedCredentialParams{
+			FeedID:        "redirecting",
+			AuthType:      feedauth.AuthTypeBasic,
+			EncryptedData: encrypted,
+		})
+		assert.NilError(t, err)
+
+		f := NewGofeedFetcher(s)
+		f.SetCredentialCipher(c)
+		feed, err := f.Fetch(ctx, "redirecting", redirecting.URL)
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Title, "Elsewhere")
+		assert.Assert(t, len(leaked) == 0, "leaked %v", leaked)
+	})
+}
+
+func TestGofeedFetcher_TransportProfile(t *testing.T) {
+	ctx := context.Background()
+	db, err := primarydb.OpenDB(":memory:")
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(schema.Schema)
+	assert.NilError(t, err)
+	s := store.NewStore(db)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = fmt.Fprint(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Internal</title></channel></rss>`)
+	}))
+	defer server.Close()
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	feedID := "internal"
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: feedID, Url: server.URL})
+	assert.NilError(t, err)
+	_, err = s.CreateTag(ctx, store.CreateTagParams{ID: "tag-1", Name: "intranet"})
+	assert.NilError(t, err)
+	err = s.CreateFeedTag(ctx, store.CreateFeedTagParams{FeedID: feedID, TagID: "tag-1"})
+	assert.NilError(t, err)
+
+	retryMax := int64(0)
+	_, err = s.CreateTransportProfile(ctx, store.CreateTransportProfileParams{
+		ID:       "profile-1",
+		Name:     "intranet",
+		CaBundle: &caBundle,
+		RetryMax: &retryMax,
+	})
+	assert.NilError(t, err)
+
+	f := NewGofeedFetcher(s)
+
+	t.Run("default client rejects the unknown CA", func(t *testing.T) {
+		_, err := f.Fetch(ctx, feedID, server.URL)
+		assert.ErrorContains(t, err, "certificate")
+	})
+
+	t.Run("tag profile trusts the CA bundle", func(t *testing.T) {
+		err := s.SetTagTransportProfile(ctx, store.SetTagTransportProfileParams{TagID: "tag-1", TransportProfileID: "profile-1"})
+		assert.NilError(t, err)
+		feed, err := f.Fetch(ctx, feedID, server.URL)
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Title, "Internal")
+	})
+
+	t.Run("feed profile wins over the tag profile", func(t *testing.T) {
+		_, err := s.CreateTransportProfile(ctx, store.CreateTransportProfileParams{
+			ID:       "profile-2",
+			Name:     "public",
+			RetryMax: &retryMax,
+		})
+		assert.NilError(t, err)
+		err = s.SetFeedTransportProfile(ctx, store.SetFeedTransportProfileParams{FeedID: feedID, TransportProfileID: "profile-2"})
+		assert.NilError(t, err)
+		_, err = f.Fetch(ctx, feedID, server.URL)
+		assert.ErrorContains(t, err, "certificate")
+	})
+
+	t.Run("client is rebuilt when the profile changes", func(t *testing.T) {
+		_, err := s.UpdateTransportProfile(ctx, store.UpdateTransportProfileParams{
+			ID:       "profile-2",
+			Name:     "public",
+			CaBundle: &caBundle,
+			RetryMax: &retryMax,
+		})
+		assert.NilError(t, err)
+		feed, err := f.Fetch(ctx, feedID, server.URL)
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Title, "Internal")
+	})
+}
+
+func TestGofeedFetcher_TransportProfileProxy(t *testing.T) {
+	ctx := context.Background()
+	db, err := primarydb.OpenDB(":memory:")
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(schema.Schema)
+	assert.NilError(t, err)
+	s := store.NewStore(db)
+
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = fmt.Fprint(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Proxied</title></channel></rss>`)
+	}))
+	defer proxy.Close()
+
+	feedURL := "http://feeds.internal.example/rss.xml"
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: feedURL})
+	assert.NilError(t, err)
+	_, err = s.CreateTransportProfile(ctx, store.CreateTransportProfileParams{
+		ID:       "profile-1",
+		Name:     "proxy",
+		ProxyUrl: &proxy.URL,
+	})
+	assert.NilError(t, err)
+	err = s.SetFeedTransportProfile(ctx, store.SetFeedTransportProfileParams{FeedID: "feed-1", TransportProfileID: "profile-1"})
+	assert.NilError(t, err)
+
+	feed, err := NewGofeedFetcher(s).Fetch(ctx, "feed-1", feedURL)
+	assert.NilError(t, err)
+	assert.Equal(t, feed.Title, "Proxied")
+	assert.Equal(t, proxied, feedURL)
+}
+
+func TestGofeedFetcher_RetryAfter(t *testing.T) {
+	db, err := primarydb.OpenDB(":memory:")
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(schema.Schema)
+	assert.NilError(t, err)
+	f := NewGofeedFetcher(store.NewStore(db))
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err = f.Fetch(context.Background(), "", server.URL)
+	var statusErr *HTTPStatusError
+	assert.Assert(t, errors.As(err, &statusErr), "got %v", err)
+	assert.Equal(t, statusErr.StatusCode, http.StatusTooManyRequests)
+	assert.Equal(t, statusErr.RetryAfter, 2*time.Minute)
+	// Rate limited requests are not retried by the client.
+	assert.Equal(t, requests.Load(), int32(1))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: 0},
+		{value: "30", want: 30 * time.Second},
+		{value: "-1", want: 0},
+		{value: now.Add(time.Hour).Format(http.TimeFormat), want: time.Hour},
+		{value: now.Add(-time.Hour).Format(http.TimeFormat), want: 0},
+		{value: "soon", want: 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, parseRetryAfter(tt.value, now), tt.want, "Retry-After %q", tt.value)
+	}
+}
+
+func TestGofeedFetcher_Limits(t *testing.T) {
+	db, err := primarydb.OpenDB(":memory:")
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(schema.Schema)
+	assert.NilError(t, err)
+	f := NewGofeedFetcher(store.NewStore(db))
+	f.SetLimits(FetchLimits{
+		MaxBodySize:    1024,
+		RequestTimeout: 200 * time.Millisecond,
+		TotalTimeout:   time.Second,
+	})
+
+	rss := `<?xml version="1.0"?><rss version="2.0"><channel><title>Small</title></channel></rss>`
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    FetchErrorKind
+	}{
+		{
+			name: "content length over the limit",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/rss+xml")
+				_, _ = w.Write([]byte(rss + strings.Repeat(" ", 2048)))
+			},
+			want: FetchErrorTooLarge,
+		},
+		{
+			name: "streamed body over the limit",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/rss+xml")
+				for range 4 {
+					_, _ = w.Write([]byte(strings.Repeat(" ", 512)))
+					w.(http.Flusher).Flush()
+				}
+			},
+			want: FetchErrorTooLarge,
+		},
+		{
+			name: "slow response",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-time.After(2 * time.Second):
+				case <-r.Context().Done():
+				}
+			},
+			want: FetchErrorTimeout,
+		},
+		{
+			name: "image content type",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "image/png")
+				_, _ = w.Write([]byte(rss))
+			},
+			want: FetchErrorNotAFeed,
+		},
+		{
+			name: "plain text body",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte("hello"))
+			},
+			want: FetchErrorNotAFeed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			_, err := f.Fetch(context.Background(), "", server.URL)
+			var fetchErr *FetchError
+			assert.Assert(t, errors.As(err, &fetchErr), "got %v", err)
+			assert.Equal(t, fetchErr.Kind, tt.want)
+		})
+	}
+
+	t.Run("body within the limit", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/rss+xml")
+			_, _ = w.Write([]byte(rss))
+		}))
+		defer server.Close()
+
+		feed, err := f.Fetch(context.Background(), "", server.URL)
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Title, "Small")
+	})
+}
+
+func TestGofeedFetcher_Charset(t *testing.T) {
+	db, err := primarydb.OpenDB(":memory:")
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(schema.Schema)
+	assert.NilError(t, err)
+	f := NewGofeedFetcher(store.NewStore(db))
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+	}{
+		{
+			name:        "charset in header",
+			contentType: "application/rss+xml; charset=ISO-8859-1",
+			body:        []byte("<?xml version=\"1.0\"?><rss version=\"2.0\"><channel><title>Caf\xe9</title></channel></rss>"),
+		},
+		{
+			name:        "header overrides declaration",
+			contentType: "text/xml; charset=windows-1252",
+			body:        []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?><rss version=\"2.0\"><channel><title>Caf\xe9</title></channel></rss>"),
+		},
+		{
+			name:        "undeclared charset",
+			contentType: "application/rss+xml",
+			body:        []byte("<rss version=\"2.0\"><channel><title>Caf\xe9</title></channel></rss>"),
+		},
+		{
+			name:        "UTF-16 in header",
+			contentType: "application/rss+xml; charset=utf-16",
+			body:        utf16LEWithBOM("<?xml version=\"1.0\" encoding=\"UTF-16\"?><rss version=\"2.0\"><channel><title>Café</title></channel></rss>"),
+		},
+		{
+			name:        "UTF-16 byte order mark",
+			contentType: "application/rss+xml",
+			body:        utf16LEWithBOM("<?xml version=\"1.0\" encoding=\"UTF-16\"?><rss version=\"2.0\"><channel><title>Café</title></channel></rss>"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				_, _ = w.Write(tt.body)
+			}))
+			defer server.Close()
+
+			feed, err := f.Fetch(context.Background(), "", server.URL)
+			assert.NilError(t, err)
+			assert.Equal(t, feed.Title, "Café")
+		})
+	}
+}
+
+// utf16LEWithBOM encodes s as little-endian UTF-16 preceded by a byte order mark.
+func utf16LEWithBOM(s string) []byte {
+	b := []byte{0xff, 0xfe}
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = binary.LittleEndian.AppendUint16(b, u)
+	}
+	return b
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/internal/readability"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// PageFetcher downloads the HTML page an item links to.
+type PageFetcher interface {
+	FetchPage(ctx context.Context, feedID, pageURL string) ([]byte, *url.URL, error)
+}
+
+// FetchPage downloads an HTML page for a feed's item with the feed's transport
+// profile and the fetch limits. The feed's credentials are not sent, as the
+// page may live on another host. It returns the UTF-8 body and the final URL
+// after redirects.
+func (f *GofeedFetcher) FetchPage(ctx context.Context, feedID, pageURL string) ([]byte, *url.URL, error) {
+	if f.limits.TotalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.limits.TotalTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := f.clientFor(ctx, feedID)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, classifyTimeout(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, newHTTPStatusError(resp)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	body, err := readBody(resp.Body, resp.ContentLength, f.limits.MaxBodySize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isHTMLResponse(contentType, body) {
+		return nil, nil, fmt.Errorf("not an HTML page: %q", contentType)
+	}
+	body, err = decodeBody(body, contentType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return body, responseURL(req, resp), nil
+}
+
+// FullContentService downloads the pages of new items of feeds with full
+// content enabled and stores the extracted article as Markdown, next to the
+// content provided by the feed.
+type FullContentService struct {
+	store      *store.Store
+	fetcher    PageFetcher
+	pool       *WorkerPool
+	writeQueue *WriteQueueService
+	logger     *slog.Logger
+	// interval spaces out the start of extractions across all feeds.
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewFullContentService creates a new FullContentService.
+func NewFullContentService(s *store.Store, f PageFetcher, p *WorkerPool, wq *WriteQueueService, l *slog.Logger, interval time.Duration) *FullContentService {
+	return &FullContentService{
+		store:      s,
+		fetcher:    f,
+		pool:       p,
+		writeQueue: wq,
+		logger:     l,
+		interval:   interval,
+	}
+}
+
+// Enqueue schedules extraction of the given items of a feed. It returns
+// immediately; extractions start at most one per interval and then run on the
+// worker pool under its host limits.
+func (s *FullContentService) Enqueue(feedID string, items []store.Item) {
+	for _, item := range items {
+		host := feedHost(item.Url)
+		if host == "" {
+			continue
+		}
+		itemID, itemURL := item.ID, item.Url
+		time.AfterFunc(s.reserve(), func() {
+			s.pool.AddHostTask(host, func(ctx context.Context) error {
+				s.extract(ctx, feedID, itemID, itemURL, nil)
+				return nil
+			})
+		})
+	}
+}
+
+// ExtractItem extracts the full content of an item right away, ignoring the
+// interval, and waits until the result is stored. A failed extraction is
+// recorded on the item rather than returned.
+func (s *FullContentService) ExtractItem(ctx context.Context, itemID string) error {
+	item, err := s.store.GetItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	s.extract(ctx, item.FeedID, item.ID, item.Url, done)
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve returns how long to wait for the next free extraction slot.
+func (s *FullContentService) reserve() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if s.next.Before(now) {
+		s.next = now
+	}
+	delay := s.next.Sub(now)
+	s.next = s.next.Add(s.interval)
+	return delay
+}
+
+// extract fetches and converts the page of an item and submits the result.
+// Extraction failures are stored with the item. The error of the write is
+// sent to done when it is not nil.
+func (s *FullContentService) extract(ctx context.Context, feedID, itemID, itemURL string, done chan error) {
+	params := store.UpsertItemFullContentParams{
+		ItemID:    itemID,
+		FetchedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	content, sanitized, err := s.extractContent(ctx, feedID, itemURL)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to extract full content", "item_id", itemID, "url", itemURL, "error", err)
+		errorMessage := err.Error()
+		params.Error = &errorMessage
+	} else {
+		params.Content = &content
+		params.ContentHtml = &sanitized
+	}
+	s.writeQueue.Submit(&SaveItemFullContentJob{Params: params, Done: done})
+}
+
+// extractContent returns the Markdown of an item's page and the sanitized
+// HTML it was converted from.
+func (s *FullContentService) extractContent(ctx context.Context, feedID, itemURL string) (string, string, error) {
+	body, pageURL, err := s.fetcher.FetchPage(ctx, feedID, itemURL)
+	if err != nil {
+		return "", "", err
+	}
+	article, err := readability.Extract(bytes.NewReader(body), pageURL)
+	if err != nil {
+		return "", "", err
+	}
+	return ConvertHTML(article.Content, pageURL.String())
+}
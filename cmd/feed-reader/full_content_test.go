@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+const testArticlePage = `<html><head><title>Full story</title></head><body>
+<nav><a href="/">Home</a></nav>
+<article>
+  <p>This is the first paragraph of the full story, which the feed only teases in its summary.</p>
+  <p>This is the second paragraph, with a <a href="/more">link</a> that should become absolute.</p>
+</article>
+</body></html>`
+
+type mockPageFetcher struct {
+	mu    sync.Mutex
+	pages map[string]string
+	err   error
+	urls  []string
+}
+
+func (m *mockPageFetcher) FetchPage(ctx context.Context, feedID, pageURL string) ([]byte, *url.URL, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.urls = append(m.urls, pageURL)
+	if m.err != nil {
+		return nil, nil, m.err
+	}
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []byte(m.pages[pageURL]), u, nil
+}
+
+func (m *mockPageFetcher) fetched() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.urls...)
+}
+
+func TestFullContentService_NewItems(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+	pool := NewWorkerPool(2)
+	pool.Start(ctx)
+
+	enabled, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-full", Url: "https://news.example/feed.xml"})
+	assert.NilError(t, err)
+	assert.NilError(t, queries.SetFeedFetchFullContent(ctx, store.SetFeedFetchFullContentParams{ID: enabled.ID, FetchFullContent: 1}))
+	disabled, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-summary", Url: "https://blog.example/feed.xml"})
+	assert.NilError(t, err)
+
+	pages := &mockPageFetcher{pages: map[string]string{"https://news.example/story": testArticlePage}}
+	fullContent := NewFullContentService(s, pages, pool, wq, logger, 0)
+	fetcher := &mockFetcher{}
+	service := NewFetcherService(s, fetcher, pool, wq, logger, 30*time.Minute)
+	service.SetFullContent(fullContent)
+
+	fetcher.feed = &gofeed.Feed{Items: []*gofeed.Item{{Title: "Story", Link: "https://news.example/story", Content: "Teaser"}}}
+	feed, err := queries.GetFeed(ctx, enabled.ID)
+	assert.NilError(t, err)
+	assert.NilError(t, service.FetchAndSave(ctx, store.FullFeed(feed)))
+
+	fetcher.feed = &gofeed.Feed{Items: []*gofeed.Item{{Title: "Post", Link: "https://blog.example/post", Content: "Teaser"}}}
+	feed, err = queries.GetFeed(ctx, disabled.ID)
+	assert.NilError(t, err)
+	assert.NilError(t, service.FetchAndSave(ctx, store.FullFeed(feed)))
+	time.Sleep(200 * time.Millisecond)
+
+	assert.DeepEqual(t, pages.fetched(), []string{"https://news.example/story"})
+	items, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: enabled.ID, Limit: 10})
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 1)
+	item, err := queries.GetItem(ctx, items[0].ID)
+	assert.NilError(t, err)
+	assert.Equal(t, *item.Content, "Teaser")
+	assert.Assert(t, item.FullContent != nil)
+	assert.Assert(t, strings.Contains(*item.FullContent, "first paragraph of the full story"), *item.FullContent)
+	assert.Assert(t, strings.Contains(*item.FullContent, "https://news.example/more"), *item.FullContent)
+	assert.Assert(t, !strings.Contains(*item.FullContent, "Home"), *item.FullContent)
+	assert.Assert(t, item.FullContentFetchedAt != nil)
+
+	// Items that were already saved are not extracted again.
+	fetcher.feed = &gofeed.Feed{Items: []*gofeed.Item{{Title: "Story", Link: "https://news.example/story", Content: "Teaser"}}}
+	feed, err = queries.GetFeed(ctx, enabled.ID)
+	assert.NilError(t, err)
+	assert.NilError(t, service.FetchAndSave(ctx, store.FullFeed(feed)))
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, len(pages.fetched()), 1)
+}
+
+func TestFullContentService_ExtractItem(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+
+	_, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://news.example/feed.xml"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-1", Url: "https://news.example/story"}))
+	items, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10})
+	assert.NilError(t, err)
+	itemID := items[0].ID
+
+	pages := &mockPageFetcher{pages: map[string]string{"https://news.example/story": testArticlePage}}
+	pages.err = errors.New("connection refused")
+	fullContent := NewFullContentService(s, pages, nil, wq, logger, time.Hour)
+
+	assert.NilError(t, fullContent.ExtractItem(ctx, itemID))
+	item, err := queries.GetItem(ctx, itemID)
+	assert.NilError(t, err)
+	assert.Assert(t, item.FullContent == nil)
+	assert.Equal(t, *item.FullContentError, "connection refused")
+
+	pages.err = nil
+	assert.NilError(t, fullContent.ExtractItem(ctx, itemID))
+	item, err = queries.GetItem(ctx, itemID)
+	assert.NilError(t, err)
+	assert.Assert(t, item.FullContentError == nil)
+	assert.Assert(t, strings.Contains(*item.FullContent, "second paragraph"), *item.FullContent)
+
+	// A later failure keeps the content that was extracted before.
+	pages.err = errors.New("timeout")
+	assert.NilError(t, fullContent.ExtractItem(ctx, itemID))
+	item, err = queries.GetItem(ctx, itemID)
+	assert.NilError(t, err)
+	assert.Equal(t, *item.FullContentError, "timeout")
+	assert.Assert(t, strings.Contains(*item.FullContent, "second paragraph"), *item.FullContent)
+}
+
+func TestGofeedFetcher_FetchPage(t *testing.T) {
+	_, db := setupTestDB(t)
+	f := NewGofeedFetcher(store.NewStore(db))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/story", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/articles/story", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/articles/story", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=ISO-8859-1")
+		_, _ = w.Write([]byte("<html><body><p>Caf\xe9</p></body></html>"))
+	})
+	mux.HandleFunc("/report.pdf", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte("%PDF-1.7"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	body, pageURL, err := f.FetchPage(context.Background(), "", server.URL+"/story")
+	assert.NilError(t, err)
+	assert.Equal(t, pageURL.String(), server.URL+"/articles/story")
+	assert.Assert(t, strings.Contains(string(body), "Café"), string(body))
+
+	_, _, err = f.FetchPage(context.Background(), "", server.URL+"/report.pdf")
+	assert.ErrorContains(t, err, "not an HTML page")
+}
@@ -12,7 +12,9 @@ import (
 
 	"github.com/caarlos0/env/v11"
 	"github.com/nakatanakatana/feed-reader/frontend"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
 	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
 	"github.com/nakatanakatana/feed-reader/internal/primarydb"
 	"github.com/nakatanakatana/feed-reader/sql"
 	"github.com/nakatanakatana/feed-reader/store"
@@ -25,12 +27,46 @@ type config struct {
 	MaxWorkers      int           `env:"MAX_WORKERS" envDefault:"10"`
 	SkipDBMigration bool          `env:"SKIP_DB_MIGRATION" envDefault:"false"`
 
+	// Per-host politeness. A zero MAX_WORKERS_PER_HOST disables the cap.
+	MaxWorkersPerHost int           `env:"MAX_WORKERS_PER_HOST" envDefault:"2"`
+	HostFetchDelay    time.Duration `env:"HOST_FETCH_DELAY" envDefault:"1s"`
+
+	// Fetch limits. A zero FETCH_MAX_BODY_SIZE or timeout disables that limit.
+	FetchMaxBodySize    int64         `env:"FETCH_MAX_BODY_SIZE" envDefault:"10485760"`
+	FetchRequestTimeout time.Duration `env:"FETCH_REQUEST_TIMEOUT" envDefault:"30s"`
+	FetchTotalTimeout   time.Duration `env:"FETCH_TOTAL_TIMEOUT" envDefault:"2m"`
+
+	// Minimum time between starting two full content extractions.
+	FullContentInterval time.Duration `env:"FULL_CONTENT_INTERVAL" envDefault:"2s"`
+
+	// HTML elements kept when converting item content. Empty keeps the
+	// default set.
+	ContentAllowedElements []string `env:"CONTENT_ALLOWED_ELEMENTS" envSeparator:","`
+
 	// Write Queue settings
 	WriteQueueMaxBatchSize  int           `env:"WRITE_QUEUE_MAX_BATCH_SIZE" envDefault:"50"`
 	WriteQueueFlushInterval time.Duration `env:"WRITE_QUEUE_FLUSH_INTERVAL" envDefault:"100ms"`
 
 	// CORS settings
 	CORSAllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS" envSeparator:","`
+
+	// WebSub settings. Push subscriptions are disabled unless WEBSUB_CALLBACK_URL is set.
+	WebSubCallbackURL  string        `env:"WEBSUB_CALLBACK_URL"`
+	WebSubLeaseSeconds int           `env:"WEBSUB_LEASE_SECONDS" envDefault:"864000"`
+	WebSubPollInterval time.Duration `env:"WEBSUB_POLL_INTERVAL" envDefault:"24h"`
+	WebSubRenewBefore  time.Duration `env:"WEBSUB_RENEW_BEFORE" envDefault:"24h"`
+
+	// FeedCredentialsKey is a base64 encoded 32-byte key used to encrypt
+	// per-feed credentials. Credentials cannot be managed without it.
+	FeedCredentialsKey string `env:"FEED_CREDENTIALS_KEY"`
+
+	// Media proxy settings. Item images are proxied only when MEDIA_PROXY_KEY
+	// is set; a readonly replica needs the same key to serve them.
+	MediaProxyKey          string        `env:"MEDIA_PROXY_KEY"`
+	MediaProxyCacheDir     string        `env:"MEDIA_PROXY_CACHE_DIR"`
+	MediaProxyMaxSize      int64         `env:"MEDIA_PROXY_MAX_SIZE"`
+	MediaProxyCacheMaxSize int64         `env:"MEDIA_PROXY_CACHE_MAX_SIZE"`
+	MediaProxyCacheMaxAge  time.Duration `env:"MEDIA_PROXY_CACHE_MAX_AGE"`
 }
 
 func main() {
@@ -57,6 +93,7 @@ func main() {
 		logger.ErrorContext(ctx, "failed to parse env", "error", err)
 		os.Exit(1)
 	}
+	SetContentAllowedElements(cfg.ContentAllowedElements)
 
 	// Initialize schema
 	if err := schema.Migrate(ctx, cfg.DBPath, schema.Schema, cfg.SkipDBMigration); err != nil {
@@ -77,9 +114,49 @@ func main() {
 
 	// 1. Initialize Storage
 	s := store.NewStore(db)
+	backfilled, err := s.BackfillItemIdentities(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to backfill item identities", "error", err)
+		os.Exit(1)
+	}
+	if backfilled > 0 {
+		logger.InfoContext(ctx, "backfilled item identities", "count", backfilled)
+	}
+	normalized, err := s.BackfillItemCategories(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to backfill item categories", "error", err)
+		os.Exit(1)
+	}
+	if normalized > 0 {
+		logger.InfoContext(ctx, "backfilled item categories", "count", normalized)
+	}
+	rebuilt, err := s.BackfillItemSearchIndex(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to backfill the item search index", "error", err)
+		os.Exit(1)
+	}
+	if rebuilt {
+		logger.InfoContext(ctx, "rebuilt the item search index")
+	}
+
+	// "feed-reader reconvert" converts stored item HTML to Markdown again,
+	// after converter upgrades, and exits.
+	if len(os.Args) > 1 && os.Args[1] == "reconvert" {
+		items, fullContents, err := reconvertContent(ctx, s)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to reconvert content", "error", err)
+			os.Exit(1)
+		}
+		logger.InfoContext(ctx, "reconverted content", "items", items, "full_contents", fullContents)
+		return
+	}
 
 	// 2. Initialize Worker Pool
 	pool := NewWorkerPool(cfg.MaxWorkers)
+	pool.SetHostLimits(HostLimits{
+		MaxConcurrent: cfg.MaxWorkersPerHost,
+		MinDelay:      cfg.HostFetchDelay,
+	})
 	pool.Start(ctx)
 
 	// 3. Initialize Write Queue
@@ -94,24 +171,90 @@ func main() {
 
 	// 4. Initialize Fetcher components
 	fetcher := NewGofeedFetcher(s)
-	fetchService := NewFetcherService(s, fetcher, pool, writeQueue, logger, cfg.FetchInterval)
+	fetcher.SetLimits(FetchLimits{
+		MaxBodySize:    cfg.FetchMaxBodySize,
+		RequestTimeout: cfg.FetchRequestTimeout,
+		TotalTimeout:   cfg.FetchTotalTimeout,
+	})
+
+	var credentialCipher *feedauth.Cipher
+	if cfg.FeedCredentialsKey != "" {
+		key, err := feedauth.ParseKey(cfg.FeedCredentialsKey)
+		if err != nil {
+			logger.ErrorContext(ctx, "invalid FEED_CREDENTIALS_KEY", "error", err)
+			os.Exit(1)
+		}
+		credentialCipher, err = feedauth.NewCipher(key)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to initialize feed credentials cipher", "error", err)
+			os.Exit(1)
+		}
+		fetcher.SetCredentialCipher(credentialCipher)
+	}
+	feedFetcher := NewScraperFetcher(s, fetcher, fetcher)
+	fetchService := NewFetcherService(s, feedFetcher, pool, writeQueue, logger, cfg.FetchInterval)
+	fetchService.SetIcons(fetcher)
+	fullContent := NewFullContentService(s, fetcher, pool, writeQueue, logger, cfg.FullContentInterval)
+	fetchService.SetFullContent(fullContent)
 
 	opmlImporter := NewOPMLImporter(s, fetcher, logger, nil)
 
+	var websubHandler http.Handler
+	if cfg.WebSubCallbackURL != "" {
+		websub := NewWebSubService(s, fetchService, writeQueue, fetcher, logger, WebSubConfig{
+			CallbackBaseURL: cfg.WebSubCallbackURL,
+			LeaseSeconds:    cfg.WebSubLeaseSeconds,
+			PollInterval:    cfg.WebSubPollInterval,
+			RenewBefore:     cfg.WebSubRenewBefore,
+		})
+		fetchService.SetWebSub(websub)
+		websubHandler = websub
+
+		renewScheduler := NewScheduler(time.Hour, 5*time.Minute, websub.RenewLeases)
+		go renewScheduler.Start(ctx)
+	}
+
 	// 4. Initialize Scheduler
 	// Add random jitter up to 10% of interval
 	jitter := time.Duration(float64(cfg.FetchInterval) * 0.1)
 	scheduler := NewScheduler(cfg.FetchInterval, jitter, fetchService.FetchAllFeeds)
 	go scheduler.Start(ctx)
 
+	var mediaProxy *mediaproxy.Proxy
+	if cfg.MediaProxyKey != "" {
+		key, err := mediaproxy.ParseKey(cfg.MediaProxyKey)
+		if err != nil {
+			logger.ErrorContext(ctx, "invalid MEDIA_PROXY_KEY", "error", err)
+			os.Exit(1)
+		}
+		mediaProxy, err = mediaproxy.New(mediaproxy.Config{
+			Key:           key,
+			CacheDir:      cfg.MediaProxyCacheDir,
+			CacheMaxBytes: cfg.MediaProxyCacheMaxSize,
+			CacheMaxAge:   cfg.MediaProxyCacheMaxAge,
+			MaxSize:       cfg.MediaProxyMaxSize,
+			Logger:        logger,
+		})
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to initialize media proxy", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// 5. Initialize API Server
 	mux := httpapi.NewMux(httpapi.Dependencies{
-		Store:          s,
-		Fetcher:        fetcher,
-		ItemFetcher:    fetchService,
-		OPMLImporter:   opmlImporter,
-		Assets:         frontend.Assets,
-		AllowedOrigins: cfg.CORSAllowedOrigins,
+		Store:            s,
+		Fetcher:          feedFetcher,
+		ItemFetcher:      fetchService,
+		OPMLImporter:     opmlImporter,
+		Assets:           frontend.Assets,
+		AllowedOrigins:   cfg.CORSAllowedOrigins,
+		WebSubHandler:    websubHandler,
+		CredentialCipher: credentialCipher,
+		FetchQueue:       pool,
+		ContentExtractor: fullContent,
+		Scraper:          feedFetcher,
+		MediaProxy:       mediaProxy,
 	})
 
 	var protocols http.Protocols
@@ -18,6 +18,11 @@ func TestConfig_Parse(t *testing.T) {
 		_ = os.Unsetenv("FETCH_INTERVAL")
 		_ = os.Unsetenv("MAX_WORKERS")
 		_ = os.Unsetenv("SKIP_DB_MIGRATION")
+		_ = os.Unsetenv("MAX_WORKERS_PER_HOST")
+		_ = os.Unsetenv("HOST_FETCH_DELAY")
+		_ = os.Unsetenv("FETCH_MAX_BODY_SIZE")
+		_ = os.Unsetenv("FETCH_REQUEST_TIMEOUT")
+		_ = os.Unsetenv("FETCH_TOTAL_TIMEOUT")
 		_ = os.Unsetenv("WRITE_QUEUE_MAX_BATCH_SIZE")
 		_ = os.Unsetenv("WRITE_QUEUE_FLUSH_INTERVAL")
 		_ = os.Unsetenv("CORS_ALLOWED_ORIGINS")
@@ -38,9 +43,18 @@ func TestConfig_Parse(t *testing.T) {
 				FetchInterval:           30 * time.Minute,
 				MaxWorkers:              10,
 				SkipDBMigration:         false,
+				MaxWorkersPerHost:       2,
+				HostFetchDelay:          time.Second,
+				FetchMaxBodySize:        10 << 20,
+				FetchRequestTimeout:     30 * time.Second,
+				FetchTotalTimeout:       2 * time.Minute,
+				FullContentInterval:     2 * time.Second,
 				WriteQueueMaxBatchSize:  50,
 				WriteQueueFlushInterval: 100 * time.Millisecond,
 				CORSAllowedOrigins:      nil,
+				WebSubLeaseSeconds:      864000,
+				WebSubPollInterval:      24 * time.Hour,
+				WebSubRenewBefore:       24 * time.Hour,
 			},
 		},
 		{
@@ -51,6 +65,12 @@ func TestConfig_Parse(t *testing.T) {
 				"FETCH_INTERVAL":             "1h",
 				"MAX_WORKERS":                "20",
 				"SKIP_DB_MIGRATION":          "true",
+				"MAX_WORKERS_PER_HOST":       "4",
+				"HOST_FETCH_DELAY":           "250ms",
+				"FETCH_MAX_BODY_SIZE":        "1048576",
+				"FETCH_REQUEST_TIMEOUT":      "10s",
+				"FETCH_TOTAL_TIMEOUT":        "1m",
+				"FULL_CONTENT_INTERVAL":      "5s",
 				"WRITE_QUEUE_MAX_BATCH_SIZE": "100",
 				"WRITE_QUEUE_FLUSH_INTERVAL": "200ms",
 				"CORS_ALLOWED_ORIGINS":       "http://localhost:3000,https://example.com",
@@ -61,9 +81,18 @@ func TestConfig_Parse(t *testing.T) {
 				FetchInterval:           time.Hour,
 				MaxWorkers:              20,
 				SkipDBMigration:         true,
+				MaxWorkersPerHost:       4,
+				HostFetchDelay:          250 * time.Millisecond,
+				FetchMaxBodySize:        1 << 20,
+				FetchRequestTimeout:     10 * time.Second,
+				FetchTotalTimeout:       time.Minute,
+				FullContentInterval:     5 * time.Second,
 				WriteQueueMaxBatchSize:  100,
 				WriteQueueFlushInterval: 200 * time.Millisecond,
 				CORSAllowedOrigins:      []string{"http://localhost:3000", "https://example.com"},
+				WebSubLeaseSeconds:      864000,
+				WebSubPollInterval:      24 * time.Hour,
+				WebSubRenewBefore:       24 * time.Hour,
 			},
 		},
 	}
@@ -76,6 +105,12 @@ func TestConfig_Parse(t *testing.T) {
 			_ = os.Unsetenv("FETCH_INTERVAL")
 			_ = os.Unsetenv("MAX_WORKERS")
 			_ = os.Unsetenv("SKIP_DB_MIGRATION")
+			_ = os.Unsetenv("MAX_WORKERS_PER_HOST")
+			_ = os.Unsetenv("HOST_FETCH_DELAY")
+			_ = os.Unsetenv("FETCH_MAX_BODY_SIZE")
+			_ = os.Unsetenv("FETCH_REQUEST_TIMEOUT")
+			_ = os.Unsetenv("FETCH_TOTAL_TIMEOUT")
+			_ = os.Unsetenv("FULL_CONTENT_INTERVAL")
 			_ = os.Unsetenv("WRITE_QUEUE_MAX_BATCH_SIZE")
 			_ = os.Unsetenv("WRITE_QUEUE_FLUSH_INTERVAL")
 			_ = os.Unsetenv("CORS_ALLOWED_ORIGINS")
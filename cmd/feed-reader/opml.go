@@ -6,6 +6,10 @@ import (
 	"strings"
 )
 
+// podcastCategory is the OPML category path exports give podcast feeds. It
+// classifies the feed rather than tags it, so it is not imported as a tag.
+const podcastCategory = "/Podcasts"
+
 type OpmlFeed struct {
 	Title string
 	URL   string
@@ -74,7 +78,7 @@ func ParseOPML(content []byte) ([]OpmlFeed, error) {
 					cats := strings.SplitSeq(o.Category, ",")
 					for c := range cats {
 						c = strings.TrimSpace(c)
-						if c != "" {
+						if c != "" && c != podcastCategory {
 							tagSet[c] = struct{}{}
 						}
 					}
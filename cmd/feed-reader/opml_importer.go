@@ -44,6 +44,9 @@ func (i *OPMLImporter) ImportSync(ctx context.Context, opmlContent []byte) (*Imp
 		g.Go(func() error {
 			// Application-level deduplication
 			if _, loaded := fetchedURLs.LoadOrStore(f.URL, true); loaded {
+				mu.Lock()
+				results.Skipped++
+				mu.Unlock()
 				return nil
 			}
 
@@ -64,11 +67,33 @@ func (i *OPMLImporter) ImportSync(ctx context.Context, opmlContent []byte) (*Imp
 			}
 
 			// Fetch metadata
+			sourceURL := f.URL
 			fetchedFeed, err := i.fetcher.Fetch(gCtx, "", f.URL)
+
+			// Fallback: the outline points to a web page, subscribe to its best feed candidate
+			var discoveryErr *FeedDiscoveryError
+			if errors.As(err, &discoveryErr) && len(discoveryErr.Candidates) > 0 {
+				feedURL := discoveryErr.Candidates[0].URL
+				i.logger.InfoContext(gCtx, "discovered feed from HTML page", "url", sourceURL, "feed_url", feedURL)
+				if _, loaded := fetchedURLs.LoadOrStore(feedURL, true); loaded {
+					mu.Lock()
+					results.Skipped++
+					mu.Unlock()
+					return nil
+				}
+				if _, err := i.store.GetFeedByURL(gCtx, feedURL); err == nil {
+					mu.Lock()
+					results.Skipped++
+					mu.Unlock()
+					return nil
+				}
+				f.URL = feedURL
+				fetchedFeed, err = i.fetcher.Fetch(gCtx, "", f.URL)
+			}
 			if err != nil {
-				i.logger.ErrorContext(gCtx, "failed to fetch feed metadata", "url", f.URL, "error", err)
+				i.logger.ErrorContext(gCtx, "failed to fetch feed metadata", "url", sourceURL, "error", err)
 				mu.Lock()
-				results.FailedFeeds = append(results.FailedFeeds, ImportFailedFeed{URL: f.URL, ErrorMessage: "failed to fetch feed metadata: " + err.Error()})
+				results.FailedFeeds = append(results.FailedFeeds, ImportFailedFeed{URL: sourceURL, ErrorMessage: "failed to fetch feed metadata: " + err.Error()})
 				mu.Unlock()
 				return nil
 			}
@@ -144,21 +169,24 @@ func (i *OPMLImporter) ImportSync(ctx context.Context, opmlContent []byte) (*Imp
 				imageUrl = strPtr(sf.fetchedFeed.Image.URL)
 			}
 			title := sf.fetchedFeed.Title
-			if sf.opmlFeed.Title != "" {
+			var titleOverridden int64
+			if sf.opmlFeed.Title != "" && sf.opmlFeed.Title != sf.fetchedFeed.Title {
 				title = sf.opmlFeed.Title
+				titleOverridden = 1
 			}
 
 			_, err := qtx.CreateFeed(ctx, store.CreateFeedParams{
-				ID:          sf.feedID,
-				Url:         sf.opmlFeed.URL,
-				Title:       strPtr(title),
-				Description: strPtr(sf.fetchedFeed.Description),
-				Link:        strPtr(sf.fetchedFeed.Link),
-				Lang:        strPtr(sf.fetchedFeed.Language),
-				ImageUrl:    imageUrl,
-				Copyright:   strPtr(sf.fetchedFeed.Copyright),
-				FeedType:    strPtr(sf.fetchedFeed.FeedType),
-				FeedVersion: strPtr(sf.fetchedFeed.FeedVersion),
+				ID:              sf.feedID,
+				Url:             sf.opmlFeed.URL,
+				Title:           strPtr(title),
+				Description:     strPtr(sf.fetchedFeed.Description),
+				Link:            strPtr(sf.fetchedFeed.Link),
+				Lang:            strPtr(sf.fetchedFeed.Language),
+				ImageUrl:        imageUrl,
+				Copyright:       strPtr(sf.fetchedFeed.Copyright),
+				FeedType:        strPtr(sf.fetchedFeed.FeedType),
+				FeedVersion:     strPtr(sf.fetchedFeed.FeedVersion),
+				TitleOverridden: titleOverridden,
 			})
 			if err != nil {
 				return err
@@ -55,6 +55,55 @@ func TestOPMLImporter_ImportSync(t *testing.T) {
 	assert.Equal(t, len(feeds), 2) // existing + new
 }
 
+func TestOPMLImporter_ImportSync_DiscoversFeedFromHTMLPage(t *testing.T) {
+	ctx := context.Background()
+	opmlContent := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="1.0">
+    <body>
+        <outline text="Blog" xmlUrl="https://example.com/blog" />
+        <outline text="Known Blog" xmlUrl="https://example.com/known" />
+    </body>
+</opml>`
+
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+
+	_, err := queries.CreateFeed(ctx, store.CreateFeedParams{
+		ID:  "existing-id",
+		Url: "https://example.com/known/feed.xml",
+	})
+	assert.NilError(t, err)
+
+	fetcher := &mockFetcher{
+		feed: &gofeed.Feed{Title: "Fetched Title"},
+		errs: map[string]error{
+			"https://example.com/blog": &FeedDiscoveryError{
+				PageURL: "https://example.com/blog",
+				Candidates: []FeedCandidate{
+					{URL: "https://example.com/blog/atom.xml", Type: "application/atom+xml"},
+					{URL: "https://example.com/blog/rss.xml", Type: "application/rss+xml"},
+				},
+			},
+			"https://example.com/known": &FeedDiscoveryError{
+				PageURL:    "https://example.com/known",
+				Candidates: []FeedCandidate{{URL: "https://example.com/known/feed.xml", Type: "application/rss+xml"}},
+			},
+		},
+	}
+
+	importer := NewOPMLImporter(s, fetcher, slog.Default(), nil)
+
+	results, err := importer.ImportSync(ctx, []byte(opmlContent))
+	assert.NilError(t, err)
+	assert.Equal(t, results.Success, int32(1))
+	assert.Equal(t, results.Skipped, int32(1))
+	assert.Equal(t, len(results.FailedFeeds), 0)
+
+	feed, err := queries.GetFeedByURL(ctx, "https://example.com/blog/atom.xml")
+	assert.NilError(t, err)
+	assert.Equal(t, *feed.Title, "Blog")
+}
+
 func TestOPMLImporter_ImportSync_Errors(t *testing.T) {
 	ctx := context.Background()
 	_, db := setupTestDB(t)
@@ -77,3 +126,26 @@ func TestOPMLImporter_ImportSync_Errors(t *testing.T) {
 		assert.Assert(t, results == nil)
 	})
 }
+
+func TestOPMLImporter_ImportSync_CountsDuplicatesAsSkipped(t *testing.T) {
+	ctx := context.Background()
+	opmlContent := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="1.0">
+    <body>
+        <outline text="Feed" xmlUrl="https://example.com/feed" />
+        <outline text="Same Feed" xmlUrl="https://example.com/feed" />
+    </body>
+</opml>`
+
+	_, db := setupTestDB(t)
+	s := store.NewStore(db)
+	fetcher := &mockFetcher{feed: &gofeed.Feed{Title: "Fetched Title"}}
+
+	importer := NewOPMLImporter(s, fetcher, slog.Default(), nil)
+
+	results, err := importer.ImportSync(ctx, []byte(opmlContent))
+	assert.NilError(t, err)
+	assert.Equal(t, results.Total, int32(2))
+	assert.Equal(t, results.Success, int32(1))
+	assert.Equal(t, results.Skipped, int32(1))
+}
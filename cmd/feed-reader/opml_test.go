@@ -53,3 +53,17 @@ func TestParseOPML_NoFeeds(t *testing.T) {
 	assert.NilError(t, err)
 	golden.Assert(t, string(data), "parse_opml_no_feeds.golden")
 }
+
+func TestParseOPML_CategoryPaths(t *testing.T) {
+	opmlContent := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+    <body>
+        <outline type="rss" text="Show" title="Show" xmlUrl="https://example.com/podcast.xml" category="Audio,/Podcasts,/Tech/Go"/>
+    </body>
+</opml>`
+
+	feeds, err := ParseOPML([]byte(opmlContent))
+	assert.NilError(t, err)
+	assert.Equal(t, len(feeds), 1)
+	assert.DeepEqual(t, feeds[0].Tags, []string{"/Tech/Go", "Audio"})
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// reconvertBatchSize is the number of rows converted per transaction.
+const reconvertBatchSize = 200
+
+// reconvertContent converts the stored HTML of items and full contents to
+// Markdown again, so that they benefit from converter and policy changes.
+// Items saved before their HTML was kept are left as they are. Revisions that
+// hash the converted text are moved to the new text in the same transaction,
+// so that the next fetch does not take the conversion for a revision. It
+// returns the number of items and full contents converted.
+func reconvertContent(ctx context.Context, s *store.Store) (int, int, error) {
+	items, err := reconvertItems(ctx, s)
+	if err != nil {
+		return items, 0, err
+	}
+	fullContents, err := reconvertFullContents(ctx, s)
+	return items, fullContents, err
+}
+
+func reconvertItems(ctx context.Context, s *store.Store) (int, error) {
+	var afterID string
+	var total int
+	for {
+		rows, err := s.ListItemsWithHTML(ctx, store.ListItemsWithHTMLParams{AfterID: afterID, Limit: reconvertBatchSize})
+		if err != nil {
+			return total, fmt.Errorf("failed to list items with html: %w", err)
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+
+		params := make([]store.UpdateItemMarkdownParams, 0, len(rows))
+		hashes := make([]store.UpdateItemRevisionHashParams, 0, len(rows))
+		for _, row := range rows {
+			description, err := reconvert(row.DescriptionHtml)
+			if err != nil {
+				return total, fmt.Errorf("failed to convert description of item %s: %w", row.ID, err)
+			}
+			content, err := reconvert(row.ContentHtml)
+			if err != nil {
+				return total, fmt.Errorf("failed to convert content of item %s: %w", row.ID, err)
+			}
+			params = append(params, store.UpdateItemMarkdownParams{ID: row.ID, Description: description, Content: content})
+
+			// The Markdown of columns without HTML is kept.
+			if row.DescriptionHtml == nil {
+				description = row.Description
+			}
+			if row.ContentHtml == nil {
+				content = row.Content
+			}
+			hashes = append(hashes, store.UpdateItemRevisionHashParams{
+				ItemID:      row.ID,
+				ContentHash: store.ItemContentHash(row.Title, description, content),
+			})
+		}
+		err = s.WithTransaction(ctx, func(qtx *store.Queries) error {
+			for i, p := range params {
+				if err := qtx.UpdateItemMarkdown(ctx, p); err != nil {
+					return fmt.Errorf("failed to update item %s: %w", p.ID, err)
+				}
+				previous := store.ItemContentHash(rows[i].Title, rows[i].Description, rows[i].Content)
+				if err := rebaselineItemRevision(ctx, qtx, previous, hashes[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += len(rows)
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+func reconvertFullContents(ctx context.Context, s *store.Store) (int, error) {
+	var afterItemID string
+	var total int
+	for {
+		rows, err := s.ListItemFullContentsWithHTML(ctx, store.ListItemFullContentsWithHTMLParams{AfterItemID: afterItemID, Limit: reconvertBatchSize})
+		if err != nil {
+			return total, fmt.Errorf("failed to list full contents with html: %w", err)
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+
+		params := make([]store.UpdateItemFullContentMarkdownParams, 0, len(rows))
+		for _, row := range rows {
+			content, err := reconvert(row.ContentHtml)
+			if err != nil {
+				return total, fmt.Errorf("failed to convert full content of item %s: %w", row.ItemID, err)
+			}
+			params = append(params, store.UpdateItemFullContentMarkdownParams{ItemID: row.ItemID, Content: content})
+		}
+		err = s.WithTransaction(ctx, func(qtx *store.Queries) error {
+			for _, p := range params {
+				if err := qtx.UpdateItemFullContentMarkdown(ctx, p); err != nil {
+					return fmt.Errorf("failed to update full content of item %s: %w", p.ItemID, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += len(rows)
+		afterItemID = rows[len(rows)-1].ItemID
+	}
+}
+
+// rebaselineItemRevision moves the latest revision of an item to the hash in
+// params when it is the hash of the text before conversion, previous.
+// Revisions identified by the hash of their source text are left as they are.
+func rebaselineItemRevision(ctx context.Context, q *store.Queries, previous string, params store.UpdateItemRevisionHashParams) error {
+	latest, err := q.GetLatestItemRevision(ctx, params.ItemID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get latest revision of item %s: %w", params.ItemID, err)
+	}
+	if latest.ContentHash != previous {
+		return nil
+	}
+	params.Revision = latest.Revision
+	if err := q.UpdateItemRevisionHash(ctx, params); err != nil {
+		return fmt.Errorf("failed to update revision of item %s: %w", params.ItemID, err)
+	}
+	return nil
+}
+
+// reconvert converts stored HTML, whose URLs are already absolute.
+func reconvert(sanitized *string) (*string, error) {
+	if sanitized == nil {
+		return nil, nil
+	}
+	markdown, err := ConvertHTMLToMarkdown(*sanitized, "")
+	if err != nil {
+		return nil, err
+	}
+	return &markdown, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestConvertItemsToMarkdown_KeepsHTML(t *testing.T) {
+	item := &gofeed.Item{
+		Link:        "https://example.com/posts/1",
+		Description: `<p>Summary <img src="a.png"></p>`,
+		Content:     `<div><p>Body</p><script>alert(1)</script></div>`,
+	}
+	sourceHash := store.ItemContentHash(&item.Title, &item.Description, &item.Content)
+	convertItemsToMarkdown([]*gofeed.Item{item}, "https://example.com/feed.xml")
+	assert.Equal(t, item.Description, "Summary ![](https://example.com/posts/a.png)")
+	assert.Equal(t, item.Custom[descriptionHTMLKey], `<p>Summary <img src="https://example.com/posts/a.png"/></p>`)
+	assert.Equal(t, item.Custom[contentHTMLKey], `<div><p>Body</p></div>`)
+
+	params := (&FetcherService{}).normalizeItem("feed-1", item)
+	assert.Equal(t, *params.DescriptionHtml, item.Custom[descriptionHTMLKey])
+	assert.Equal(t, *params.ContentHtml, item.Custom[contentHTMLKey])
+	assert.Equal(t, params.SourceHash, sourceHash)
+}
+
+func TestReconvertContent(t *testing.T) {
+	ctx := context.Background()
+	_, db := setupTestDB(t)
+	s := store.NewStore(db)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	stale, html := "stale", "<p><strong>Fresh</strong></p>"
+	legacy := "Legacy *markdown*"
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+		FeedID:          "feed-1",
+		Url:             "https://example.com/with-html",
+		Description:     &stale,
+		Content:         &stale,
+		DescriptionHtml: &html,
+		ContentHtml:     &html,
+	}))
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+		FeedID:  "feed-1",
+		Url:     "https://example.com/legacy",
+		Content: &legacy,
+	}))
+	items, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10})
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 2)
+	for _, item := range items {
+		assert.NilError(t, s.UpsertItemFullContent(ctx, store.UpsertItemFullContentParams{
+			ItemID:      item.ID,
+			Content:     &stale,
+			ContentHtml: &html,
+			FetchedAt:   "2026-01-01T00:00:00Z",
+		}))
+	}
+
+	converted, fullContents, err := reconvertContent(ctx, s)
+	assert.NilError(t, err)
+	assert.Equal(t, converted, 1)
+	assert.Equal(t, fullContents, 2)
+
+	for _, row := range items {
+		item, err := s.GetItem(ctx, row.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, *item.FullContent, "**Fresh**")
+		if item.Url == "https://example.com/legacy" {
+			assert.Equal(t, *item.Content, legacy)
+			assert.Assert(t, item.Description == nil)
+			continue
+		}
+		assert.Equal(t, *item.Description, "**Fresh**")
+		assert.Equal(t, *item.Content, "**Fresh**")
+	}
+
+	t.Run("Revisions of the converted text are rebaselined", func(t *testing.T) {
+		fresh := "**Fresh**"
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+			FeedID:          "feed-1",
+			Url:             "https://example.com/with-html",
+			Description:     &fresh,
+			Content:         &fresh,
+			DescriptionHtml: &html,
+			ContentHtml:     &html,
+		}))
+		for _, row := range items {
+			revisions, err := s.ListItemRevisions(ctx, row.ID)
+			assert.NilError(t, err)
+			assert.Equal(t, len(revisions), 1, row.Url)
+		}
+	})
+}
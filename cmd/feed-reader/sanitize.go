@@ -0,0 +1,295 @@
+package main
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// DefaultAllowedElements are the HTML elements kept when converting item
+// content. Other elements are unwrapped, keeping their text.
+var DefaultAllowedElements = []string{
+	"a", "abbr", "b", "blockquote", "br", "caption", "cite", "code", "dd",
+	"del", "details", "div", "dl", "dt", "em", "figcaption", "figure", "h1",
+	"h2", "h3", "h4", "h5", "h6", "hr", "i", "img", "ins", "kbd", "li", "mark",
+	"ol", "p", "pre", "q", "s", "small", "span", "strike", "strong", "sub",
+	"summary", "sup", "table", "tbody", "td", "tfoot", "th", "thead", "time",
+	"tr", "u", "ul",
+}
+
+// droppedElements are removed with their content whatever the policy allows.
+var droppedElements = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true,
+	atom.Object: true, atom.Embed: true, atom.Applet: true, atom.Frame: true,
+	atom.Frameset: true, atom.Form: true, atom.Input: true, atom.Button: true,
+	atom.Select: true, atom.Textarea: true, atom.Svg: true, atom.Math: true,
+	atom.Meta: true, atom.Link: true, atom.Base: true, atom.Title: true,
+	atom.Source: true, atom.Track: true,
+	atom.Canvas: true, atom.Dialog: true,
+}
+
+// keptAttributes are the attributes left on allowed elements, besides the
+// src of images.
+var keptAttributes = map[string]bool{
+	"href": true, "alt": true, "title": true, "colspan": true,
+	"rowspan": true, "start": true, "datetime": true, "cite": true,
+}
+
+// SanitizePolicy decides which elements of item content are kept.
+type SanitizePolicy struct {
+	allowed map[string]bool
+}
+
+// NewSanitizePolicy returns a policy keeping the given elements, or
+// DefaultAllowedElements when none are given. Elements that run code or
+// load content, such as script and object, are always removed; iframes
+// always become links.
+func NewSanitizePolicy(elements []string) SanitizePolicy {
+	if len(elements) == 0 {
+		elements = DefaultAllowedElements
+	}
+	allowed := make(map[string]bool, len(elements))
+	for _, element := range elements {
+		if element = strings.ToLower(strings.TrimSpace(element)); element != "" {
+			allowed[element] = true
+		}
+	}
+	return SanitizePolicy{allowed: allowed}
+}
+
+// contentPolicy is the policy used by ConvertHTMLToMarkdown.
+var contentPolicy = NewSanitizePolicy(nil)
+
+// SetContentAllowedElements changes the elements kept in item content. It
+// must be called before feeds are fetched.
+func SetContentAllowedElements(elements []string) {
+	contentPolicy = NewSanitizePolicy(elements)
+}
+
+// sanitize applies the policy to the children of n and resolves their links
+// and images against base, which may be nil.
+func (p SanitizePolicy) sanitize(n *html.Node, base *url.URL) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		switch c.Type {
+		case html.CommentNode:
+			n.RemoveChild(c)
+		case html.ElementNode:
+			p.sanitizeElement(n, c, base)
+		}
+		c = next
+	}
+}
+
+func (p SanitizePolicy) sanitizeElement(parent, el *html.Node, base *url.URL) {
+	switch {
+	case el.DataAtom == atom.Html || el.DataAtom == atom.Head || el.DataAtom == atom.Body:
+		p.sanitize(el, base)
+	case el.DataAtom == atom.Iframe || el.DataAtom == atom.Video || el.DataAtom == atom.Audio:
+		if card := embedCard(el, base); card != nil {
+			parent.InsertBefore(card, el)
+		}
+		parent.RemoveChild(el)
+	case droppedElements[el.DataAtom]:
+		parent.RemoveChild(el)
+	case p.allowed[el.Data] && cleanAttributes(el, base):
+		p.sanitize(el, base)
+	case el.DataAtom == atom.Img:
+		parent.RemoveChild(el)
+	default:
+		// Unwrap the element, keeping its sanitized content.
+		p.sanitize(el, base)
+		for el.FirstChild != nil {
+			c := el.FirstChild
+			el.RemoveChild(c)
+			parent.InsertBefore(c, el)
+		}
+		parent.RemoveChild(el)
+	}
+}
+
+// cleanAttributes removes attributes that are not needed for conversion and
+// resolves URLs. Images loaded lazily or only through srcset get a src. It
+// reports false for links and images left without a usable URL, which are
+// not kept.
+func cleanAttributes(el *html.Node, base *url.URL) bool {
+	var src, srcset, dataSrc string
+	attrs := el.Attr[:0]
+	for _, attr := range el.Attr {
+		key := strings.ToLower(attr.Key)
+		switch key {
+		case "srcset":
+			srcset = attr.Val
+			continue
+		case "data-src":
+			dataSrc = attr.Val
+			continue
+		case "src":
+			src = strings.TrimSpace(attr.Val)
+			continue
+		}
+		if !keptAttributes[key] || attr.Namespace != "" {
+			continue
+		}
+		if key == "href" || key == "cite" {
+			resolved, ok := resolveURL(base, attr.Val, false)
+			if !ok {
+				continue
+			}
+			attr.Val = resolved
+		}
+		attrs = append(attrs, attr)
+	}
+	el.Attr = attrs
+
+	if el.DataAtom == atom.A {
+		return slices.ContainsFunc(el.Attr, func(attr html.Attribute) bool { return attr.Key == "href" })
+	}
+	if el.DataAtom != atom.Img {
+		return true
+	}
+	if src == "" || strings.HasPrefix(src, "data:image/gif") {
+		if dataSrc != "" {
+			src = dataSrc
+		} else if candidate := largestSrcsetCandidate(srcset); candidate != "" {
+			src = candidate
+		}
+	}
+	resolved, ok := resolveURL(base, src, true)
+	if !ok || resolved == "" {
+		return false
+	}
+	el.Attr = append(el.Attr, html.Attribute{Key: "src", Val: resolved})
+	return true
+}
+
+// resolveURL resolves ref against base. It reports false for URLs with a
+// scheme other than http, https or mailto; data URIs of images are accepted
+// when image is set.
+func resolveURL(base *url.URL, ref string, image bool) (string, bool) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || strings.HasPrefix(ref, "#") {
+		return ref, true
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "", false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "":
+		if base != nil {
+			u = base.ResolveReference(u)
+		}
+		return u.String(), true
+	case "http", "https":
+		return u.String(), true
+	case "mailto":
+		return ref, !image
+	case "data":
+		return ref, image && strings.HasPrefix(strings.ToLower(u.Opaque), "image/")
+	}
+	return "", false
+}
+
+// largestSrcsetCandidate returns the URL of the widest or densest candidate
+// of a srcset attribute, or the last one when there are no descriptors.
+func largestSrcsetCandidate(srcset string) string {
+	var best string
+	var bestSize float64
+	for _, candidate := range strings.Split(srcset, ",") {
+		fields := strings.Fields(candidate)
+		if len(fields) == 0 {
+			continue
+		}
+		size := 0.0
+		if len(fields) > 1 {
+			descriptor := strings.ToLower(fields[1])
+			unit := descriptor[len(descriptor)-1]
+			if n, err := strconv.ParseFloat(descriptor[:len(descriptor)-1], 64); err == nil && (unit == 'w' || unit == 'x') {
+				size = n
+			}
+		}
+		if best == "" || size >= bestSize {
+			best, bestSize = fields[0], size
+		}
+	}
+	return best
+}
+
+// embedCard returns a paragraph linking to the content of an iframe, video
+// or audio element, with a thumbnail for YouTube videos, or nil when the
+// element has no usable source.
+func embedCard(embed *html.Node, base *url.URL) *html.Node {
+	var src, title string
+	for _, attr := range embed.Attr {
+		switch strings.ToLower(attr.Key) {
+		case "src":
+			src = attr.Val
+		case "data-src":
+			if src == "" {
+				src = attr.Val
+			}
+		case "title":
+			title = strings.TrimSpace(attr.Val)
+		}
+	}
+	for c := embed.FirstChild; c != nil && src == ""; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.DataAtom == atom.Source {
+			src = tokenAttr(html.Token{Attr: c.Attr}, "src")
+		}
+	}
+	resolved, ok := resolveURL(base, src, false)
+	if !ok || resolved == "" || strings.HasPrefix(resolved, "#") {
+		return nil
+	}
+	u, err := url.Parse(resolved)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+
+	href, thumbnail := resolved, ""
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch {
+	case host == "youtube.com" || host == "youtube-nocookie.com":
+		if id, ok := strings.CutPrefix(u.Path, "/embed/"); ok && id != "" && !strings.Contains(id, "/") {
+			href = "https://www.youtube.com/watch?v=" + url.QueryEscape(id)
+			thumbnail = "https://i.ytimg.com/vi/" + url.PathEscape(id) + "/hqdefault.jpg"
+			if title == "" {
+				title = "YouTube video"
+			}
+		}
+	case host == "player.vimeo.com":
+		if id, ok := strings.CutPrefix(u.Path, "/video/"); ok && id != "" {
+			href = "https://vimeo.com/" + id
+			if title == "" {
+				title = "Vimeo video"
+			}
+		}
+	}
+	if title == "" {
+		title = host
+	}
+
+	card := &html.Node{Type: html.ElementNode, Data: "p", DataAtom: atom.P}
+	if thumbnail != "" {
+		image := newLink(href)
+		image.AppendChild(&html.Node{Type: html.ElementNode, Data: "img", DataAtom: atom.Img, Attr: []html.Attribute{
+			{Key: "src", Val: thumbnail},
+			{Key: "alt", Val: title},
+		}})
+		card.AppendChild(image)
+		card.AppendChild(&html.Node{Type: html.ElementNode, Data: "br", DataAtom: atom.Br})
+	}
+	link := newLink(href)
+	link.AppendChild(&html.Node{Type: html.TextNode, Data: title})
+	card.AppendChild(link)
+	return card
+}
+
+func newLink(href string) *html.Node {
+	return &html.Node{Type: html.ElementNode, Data: "a", DataAtom: atom.A, Attr: []html.Attribute{{Key: "href", Val: href}}}
+}
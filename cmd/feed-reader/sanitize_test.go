@@ -0,0 +1,88 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"gotest.tools/v3/assert"
+)
+
+func TestConvertHTMLToMarkdown_Sanitizes(t *testing.T) {
+	const base = "https://example.com/blog/2024/entry"
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "resolves relative links and images",
+			input: `<p><a href="/post">post</a> <img src="img/a.png" alt="A"> <a href="#note">note</a></p>`,
+			want:  "[post](https://example.com/post) ![A](https://example.com/blog/2024/img/a.png) [note](#note)",
+		},
+		{
+			name:  "picks the largest srcset candidate",
+			input: `<img srcset="/s.png 300w, /l.png 1200w, /m.png 600w" alt="B">`,
+			want:  "![B](https://example.com/l.png)",
+		},
+		{
+			name:  "uses lazily loaded sources",
+			input: `<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" data-src="/lazy.png" alt="C">`,
+			want:  "![C](https://example.com/lazy.png)",
+		},
+		{
+			name:  "drops scripts, styles and forms",
+			input: `<style>p { color: red }</style><p>Text<script>alert(1)</script></p><form><input name="q"><button>Go</button></form>`,
+			want:  "Text",
+		},
+		{
+			name:  "unwraps links with unsafe schemes",
+			input: `<p><a href="javascript:alert(1)">bad</a> <img src="javascript:alert(1)" alt="x"></p>`,
+			want:  "bad",
+		},
+		{
+			name:  "unwraps elements that are not allowed",
+			input: `<p><font color="red">red</font> <custom-tag><em>text</em></custom-tag></p>`,
+			want:  "red *text*",
+		},
+		{
+			name:  "turns YouTube embeds into link cards",
+			input: `<iframe src="https://www.youtube.com/embed/abc123" title="Demo"></iframe>`,
+			want:  "[![Demo](https://i.ytimg.com/vi/abc123/hqdefault.jpg)](https://www.youtube.com/watch?v=abc123)  \n[Demo](https://www.youtube.com/watch?v=abc123)",
+		},
+		{
+			name:  "turns other embeds into links",
+			input: `<iframe src="//player.vimeo.com/video/42"></iframe><video><source src="/clip.mp4"></video><iframe></iframe>`,
+			want:  "[Vimeo video](https://vimeo.com/42)\n\n[example.com](https://example.com/clip.mp4)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ConvertHTMLToMarkdown(tt.input, base)
+			assert.NilError(t, err)
+			assert.Equal(t, result, tt.want)
+		})
+	}
+
+	t.Run("leaves relative URLs without a base", func(t *testing.T) {
+		result, err := ConvertHTMLToMarkdown(`<a href="/post">post</a>`, "")
+		assert.NilError(t, err)
+		assert.Equal(t, result, "[post](/post)")
+	})
+}
+
+func TestSetContentAllowedElements(t *testing.T) {
+	t.Cleanup(func() { SetContentAllowedElements(nil) })
+	SetContentAllowedElements([]string{"p", " A "})
+
+	result, err := ConvertHTMLToMarkdown(`<p><strong>Bold</strong> <a href="/x">link</a> <img src="/a.png"></p><script>alert(1)</script>`, "https://example.com/")
+	assert.NilError(t, err)
+	assert.Equal(t, result, "Bold [link](https://example.com/x)")
+}
+
+func TestItemBaseURL(t *testing.T) {
+	const feedURL = "https://example.com/feed.xml"
+	assert.Equal(t, itemBaseURL(&gofeed.Item{Link: "https://blog.example.com/post"}, feedURL), "https://blog.example.com/post")
+	assert.Equal(t, itemBaseURL(&gofeed.Item{Link: "/posts/1"}, feedURL), "https://example.com/posts/1")
+	assert.Equal(t, itemBaseURL(&gofeed.Item{}, feedURL), feedURL)
+	assert.Equal(t, itemBaseURL(&gofeed.Item{Link: "/posts/1"}, ""), "")
+}
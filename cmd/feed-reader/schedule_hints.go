@@ -0,0 +1,273 @@
+package main
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/rss"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// Reasons recorded with next_fetch, naming what decided it.
+const (
+	scheduleReasonAdaptive     = "adaptive"
+	scheduleReasonCacheControl = "cache_control"
+	scheduleReasonTTL          = "ttl"
+	scheduleReasonUpdatePeriod = "update_period"
+	scheduleReasonSkipHours    = "skip_hours"
+	scheduleReasonSkipDays     = "skip_days"
+	scheduleReasonWebSub       = "websub"
+	scheduleReasonIgnoreWindow = "ignore_window"
+	scheduleReasonBackoff      = "backoff"
+	scheduleReasonRetryAfter   = "retry_after"
+)
+
+// maxHintInterval caps the interval a publisher hint can impose, so that a
+// far-off Expires or a huge max-age does not stop a feed from being fetched.
+const maxHintInterval = 7 * 24 * time.Hour
+
+// scheduleHints are the caching and update hints given by a publisher.
+type scheduleHints struct {
+	// MaxAge comes from Cache-Control max-age or Expires.
+	MaxAge time.Duration
+	// TTL is the RSS <ttl>.
+	TTL time.Duration
+	// UpdatePeriod comes from sy:updatePeriod and sy:updateFrequency.
+	UpdatePeriod time.Duration
+	// SkipHours and SkipDays are the RSS <skipHours> and <skipDays>, in UTC.
+	SkipHours []int
+	SkipDays  []time.Weekday
+}
+
+// parseCacheMaxAge returns how long a response may be cached according to
+// Cache-Control, or Expires when there is no max-age. It returns zero when the
+// response must not be cached or gives no lifetime.
+func parseCacheMaxAge(header http.Header, now time.Time) time.Duration {
+	var maxAge time.Duration
+	hasMaxAge := false
+	for directive := range strings.SplitSeq(strings.ToLower(header.Get("Cache-Control")), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch name {
+		case "no-store", "no-cache":
+			return 0
+		case "max-age":
+			seconds, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+			if err != nil || seconds < 0 {
+				continue
+			}
+			maxAge = time.Duration(min(seconds, int64(maxHintInterval/time.Second))) * time.Second
+			hasMaxAge = true
+		}
+	}
+	if hasMaxAge {
+		if age, err := strconv.ParseInt(strings.TrimSpace(header.Get("Age")), 10, 64); err == nil && age > 0 {
+			maxAge -= time.Duration(age) * time.Second
+		}
+		return max(maxAge, 0)
+	}
+
+	expires, err := http.ParseTime(header.Get("Expires"))
+	if err != nil {
+		return 0
+	}
+	if date, err := http.ParseTime(header.Get("Date")); err == nil {
+		now = date
+	}
+	return max(expires.Sub(now), 0)
+}
+
+// rssHintTranslator keeps the parsed RSS feed, whose <ttl>, <skipHours> and
+// <skipDays> are not translated to gofeed.Feed.
+type rssHintTranslator struct {
+	gofeed.DefaultRSSTranslator
+	feed *rss.Feed
+}
+
+func (t *rssHintTranslator) Translate(feed any) (*gofeed.Feed, error) {
+	if rssFeed, ok := feed.(*rss.Feed); ok {
+		t.feed = rssFeed
+	}
+	return t.DefaultRSSTranslator.Translate(feed)
+}
+
+// feedScheduleHints reads the update hints of a parsed feed. rssFeed is nil
+// for other formats.
+func feedScheduleHints(feed *gofeed.Feed, rssFeed *rss.Feed) scheduleHints {
+	var hints scheduleHints
+	if rssFeed != nil {
+		if minutes, err := strconv.Atoi(strings.TrimSpace(rssFeed.TTL)); err == nil && minutes > 0 {
+			hints.TTL = time.Duration(minutes) * time.Minute
+		}
+		for _, value := range rssFeed.SkipHours {
+			hour, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || hour < 0 || hour > 24 {
+				continue
+			}
+			// Some feeds number the hours 1 to 24.
+			hints.SkipHours = append(hints.SkipHours, hour%24)
+		}
+		for _, value := range rssFeed.SkipDays {
+			if day, ok := parseWeekday(value); ok {
+				hints.SkipDays = append(hints.SkipDays, day)
+			}
+		}
+		slices.Sort(hints.SkipHours)
+		hints.SkipHours = slices.Compact(hints.SkipHours)
+		slices.Sort(hints.SkipDays)
+		hints.SkipDays = slices.Compact(hints.SkipDays)
+	}
+
+	if sy := feed.Extensions["sy"]; sy != nil {
+		var period time.Duration
+		if values := sy["updatePeriod"]; len(values) > 0 {
+			switch strings.ToLower(strings.TrimSpace(values[0].Value)) {
+			case "hourly":
+				period = time.Hour
+			case "daily":
+				period = 24 * time.Hour
+			case "weekly":
+				period = 7 * 24 * time.Hour
+			case "monthly":
+				period = 30 * 24 * time.Hour
+			case "yearly":
+				period = 365 * 24 * time.Hour
+			}
+		}
+		frequency := 1
+		if values := sy["updateFrequency"]; len(values) > 0 {
+			if n, err := strconv.Atoi(strings.TrimSpace(values[0].Value)); err == nil && n > 0 {
+				frequency = n
+			}
+		}
+		hints.UpdatePeriod = period / time.Duration(frequency)
+	}
+	return hints
+}
+
+func parseWeekday(value string) (time.Weekday, bool) {
+	value = strings.TrimSpace(value)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(value, day.String()) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// recordParams converts the hints to their stored form.
+func (h scheduleHints) recordParams(feedID string) store.RecordFeedScheduleHintsParams {
+	params := store.RecordFeedScheduleHintsParams{
+		FeedID:           feedID,
+		HintMaxAge:       durationSeconds(h.MaxAge),
+		HintTtl:          durationSeconds(h.TTL),
+		HintUpdatePeriod: durationSeconds(h.UpdatePeriod),
+	}
+	if len(h.SkipHours) > 0 {
+		hours := make([]string, 0, len(h.SkipHours))
+		for _, hour := range h.SkipHours {
+			hours = append(hours, strconv.Itoa(hour))
+		}
+		joined := strings.Join(hours, ",")
+		params.HintSkipHours = &joined
+	}
+	if len(h.SkipDays) > 0 {
+		days := make([]string, 0, len(h.SkipDays))
+		for _, day := range h.SkipDays {
+			days = append(days, day.String())
+		}
+		joined := strings.Join(days, ",")
+		params.HintSkipDays = &joined
+	}
+	return params
+}
+
+// storedScheduleHints reads the hints recorded for a feed.
+func storedScheduleHints(row store.FeedFetcher) scheduleHints {
+	hints := scheduleHints{
+		MaxAge:       secondsDuration(row.HintMaxAge),
+		TTL:          secondsDuration(row.HintTtl),
+		UpdatePeriod: secondsDuration(row.HintUpdatePeriod),
+	}
+	if row.HintSkipHours != nil {
+		for value := range strings.SplitSeq(*row.HintSkipHours, ",") {
+			if hour, err := strconv.Atoi(value); err == nil {
+				hints.SkipHours = append(hints.SkipHours, hour)
+			}
+		}
+	}
+	if row.HintSkipDays != nil {
+		for value := range strings.SplitSeq(*row.HintSkipDays, ",") {
+			if day, ok := parseWeekday(value); ok {
+				hints.SkipDays = append(hints.SkipDays, day)
+			}
+		}
+	}
+	return hints
+}
+
+// applyIntervalHints raises interval to the longest hinted interval. It
+// returns the interval and, when a hint raised it, the reason.
+func (h scheduleHints) applyIntervalHints(interval time.Duration) (time.Duration, string) {
+	reason := ""
+	for _, hint := range []struct {
+		value  time.Duration
+		reason string
+	}{
+		{h.MaxAge, scheduleReasonCacheControl},
+		{h.TTL, scheduleReasonTTL},
+		{h.UpdatePeriod, scheduleReasonUpdatePeriod},
+	} {
+		value := min(hint.value, maxHintInterval)
+		if value > interval {
+			interval = value
+			reason = hint.reason
+		}
+	}
+	return interval, reason
+}
+
+// applySkipWindows moves next to the start of the first hour that is neither
+// a skipped hour nor on a skipped day. When every hour is skipped, next is
+// returned as is.
+func (h scheduleHints) applySkipWindows(next time.Time) (time.Time, string) {
+	if len(h.SkipHours) == 0 && len(h.SkipDays) == 0 {
+		return next, ""
+	}
+	t := next.UTC()
+	reason := ""
+	for range 7 * 24 {
+		switch {
+		case slices.Contains(h.SkipDays, t.Weekday()):
+			if reason == "" {
+				reason = scheduleReasonSkipDays
+			}
+		case slices.Contains(h.SkipHours, t.Hour()):
+			if reason == "" {
+				reason = scheduleReasonSkipHours
+			}
+		default:
+			return t, reason
+		}
+		t = t.Truncate(time.Hour).Add(time.Hour)
+	}
+	return next, ""
+}
+
+func durationSeconds(d time.Duration) *int64 {
+	if d <= 0 {
+		return nil
+	}
+	seconds := int64(d / time.Second)
+	return &seconds
+}
+
+func secondsDuration(seconds *int64) time.Duration {
+	if seconds == nil || *seconds <= 0 {
+		return 0
+	}
+	return time.Duration(*seconds) * time.Second
+}
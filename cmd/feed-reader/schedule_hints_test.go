@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestParseCacheMaxAge(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name   string
+		header map[string]string
+		want   time.Duration
+	}{
+		{"none", nil, 0},
+		{"max-age", map[string]string{"Cache-Control": "public, max-age=3600"}, time.Hour},
+		{"max-age minus age", map[string]string{"Cache-Control": "max-age=3600", "Age": "600"}, 50 * time.Minute},
+		{"no-cache wins", map[string]string{"Cache-Control": "max-age=3600, no-cache"}, 0},
+		{"no-store", map[string]string{"Cache-Control": "no-store"}, 0},
+		{"capped", map[string]string{"Cache-Control": "max-age=99999999"}, maxHintInterval},
+		{"expires", map[string]string{"Expires": now.Add(2 * time.Hour).Format(http.TimeFormat)}, 2 * time.Hour},
+		{"expires relative to date", map[string]string{
+			"Date":    now.Add(-time.Hour).Format(http.TimeFormat),
+			"Expires": now.Add(time.Hour).Format(http.TimeFormat),
+		}, 2 * time.Hour},
+		{"max-age over expires", map[string]string{
+			"Cache-Control": "max-age=60",
+			"Expires":       now.Add(time.Hour).Format(http.TimeFormat),
+		}, time.Minute},
+		{"expired", map[string]string{"Expires": "0"}, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tc.header {
+				header.Set(k, v)
+			}
+			assert.Equal(t, parseCacheMaxAge(header, now), tc.want)
+		})
+	}
+}
+
+func TestFeedScheduleHints(t *testing.T) {
+	translator := &rssHintTranslator{}
+	fp := gofeed.NewParser()
+	fp.RSSTranslator = translator
+	feed, err := fp.ParseString(`<?xml version="1.0"?>
+<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/"><channel><title>T</title>
+<ttl>120</ttl>
+<skipHours><hour>3</hour><hour>2</hour><hour>2</hour><hour>25</hour></skipHours>
+<skipDays><day>saturday</day><day>Someday</day><day>Sunday</day></skipDays>
+<sy:updatePeriod>hourly</sy:updatePeriod>
+<sy:updateFrequency>4</sy:updateFrequency>
+</channel></rss>`)
+	assert.NilError(t, err)
+
+	hints := feedScheduleHints(feed, translator.feed)
+	assert.DeepEqual(t, hints, scheduleHints{
+		TTL:          2 * time.Hour,
+		UpdatePeriod: 15 * time.Minute,
+		SkipHours:    []int{2, 3},
+		SkipDays:     []time.Weekday{time.Sunday, time.Saturday},
+	})
+
+	t.Run("round trips through the store", func(t *testing.T) {
+		hints.MaxAge = time.Minute
+		params := hints.recordParams("feed-1")
+		stored := storedScheduleHints(store.FeedFetcher{
+			HintMaxAge:       params.HintMaxAge,
+			HintTtl:          params.HintTtl,
+			HintUpdatePeriod: params.HintUpdatePeriod,
+			HintSkipHours:    params.HintSkipHours,
+			HintSkipDays:     params.HintSkipDays,
+		})
+		assert.DeepEqual(t, stored, hints)
+	})
+
+	t.Run("atom feeds have no rss hints", func(t *testing.T) {
+		feed, err := gofeed.NewParser().ParseString(`<?xml version="1.0"?>
+<feed xmlns="http://www.w3.org/2005/Atom"><title>T</title></feed>`)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, feedScheduleHints(feed, nil), scheduleHints{})
+	})
+}
+
+func TestScheduleHints_ApplyIntervalHints(t *testing.T) {
+	hints := scheduleHints{MaxAge: 20 * time.Minute, TTL: time.Hour, UpdatePeriod: 2 * time.Hour}
+
+	interval, reason := hints.applyIntervalHints(30 * time.Minute)
+	assert.Equal(t, interval, 2*time.Hour)
+	assert.Equal(t, reason, scheduleReasonUpdatePeriod)
+
+	interval, reason = hints.applyIntervalHints(3 * time.Hour)
+	assert.Equal(t, interval, 3*time.Hour)
+	assert.Equal(t, reason, "")
+
+	interval, reason = scheduleHints{MaxAge: 20 * time.Minute}.applyIntervalHints(10 * time.Minute)
+	assert.Equal(t, interval, 20*time.Minute)
+	assert.Equal(t, reason, scheduleReasonCacheControl)
+
+	interval, reason = scheduleHints{TTL: 30 * 24 * time.Hour}.applyIntervalHints(time.Hour)
+	assert.Equal(t, interval, maxHintInterval)
+	assert.Equal(t, reason, scheduleReasonTTL)
+}
+
+func TestScheduleHints_ApplySkipWindows(t *testing.T) {
+	// 2026-01-03 is a Saturday.
+	saturday := time.Date(2026, 1, 3, 22, 30, 0, 0, time.UTC)
+
+	next, reason := scheduleHints{SkipHours: []int{22, 23}}.applySkipWindows(saturday)
+	assert.Equal(t, next, time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, reason, scheduleReasonSkipHours)
+
+	next, reason = scheduleHints{SkipDays: []time.Weekday{time.Saturday, time.Sunday}, SkipHours: []int{0}}.applySkipWindows(saturday)
+	assert.Equal(t, next, time.Date(2026, 1, 5, 1, 0, 0, 0, time.UTC))
+	assert.Equal(t, reason, scheduleReasonSkipDays)
+
+	next, reason = scheduleHints{SkipHours: []int{8}}.applySkipWindows(saturday)
+	assert.Equal(t, next, saturday)
+	assert.Equal(t, reason, "")
+
+	everyDay := scheduleHints{SkipDays: []time.Weekday{0, 1, 2, 3, 4, 5, 6}}
+	next, reason = everyDay.applySkipWindows(saturday)
+	assert.Equal(t, next, saturday)
+	assert.Equal(t, reason, "")
+}
+
+func TestFetcherService_MarkFetched_ScheduleHints(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+	service := NewFetcherService(s, &mockFetcher{}, nil, wq, logger, 30*time.Minute)
+
+	feed, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-hints", Url: "http://hints"})
+	assert.NilError(t, err)
+
+	nextFetch := func(t *testing.T) (time.Duration, string) {
+		t.Helper()
+		start := time.Now().UTC()
+		service.markFetched(ctx, feed.ID, http.StatusOK, nil)
+		time.Sleep(100 * time.Millisecond)
+
+		updated, err := queries.GetFeed(ctx, feed.ID)
+		assert.NilError(t, err)
+		assert.Assert(t, updated.NextFetch != nil)
+		assert.Assert(t, updated.ScheduleReason != nil)
+		next, err := time.Parse(time.RFC3339, *updated.NextFetch)
+		assert.NilError(t, err)
+		return next.Sub(start).Round(time.Minute), *updated.ScheduleReason
+	}
+
+	delay, reason := nextFetch(t)
+	assert.Equal(t, delay, 30*time.Minute)
+	assert.Equal(t, reason, scheduleReasonAdaptive)
+
+	assert.NilError(t, queries.RecordFeedScheduleHints(ctx, scheduleHints{
+		MaxAge: time.Hour,
+		TTL:    3 * time.Hour,
+	}.recordParams(feed.ID)))
+	delay, reason = nextFetch(t)
+	assert.Equal(t, delay, 3*time.Hour)
+	assert.Equal(t, reason, scheduleReasonTTL)
+}
@@ -126,3 +126,22 @@ func AdjustIntervalForPeak(distribution []store.UpdateDistributionRow, baseInter
 
 	return baseInterval
 }
+
+// maxFailureBackoff caps how far a failing feed is pushed back.
+const maxFailureBackoff = 24 * time.Hour
+
+// CalculateFailureBackoff doubles the base interval for each consecutive failure
+// beyond the first, capped at maxInterval.
+func CalculateFailureBackoff(baseInterval time.Duration, failures int64, maxInterval time.Duration) time.Duration {
+	if failures <= 1 {
+		return min(baseInterval, maxInterval)
+	}
+	backoff := baseInterval
+	for i := int64(1); i < failures; i++ {
+		backoff *= 2
+		if backoff >= maxInterval {
+			return maxInterval
+		}
+	}
+	return backoff
+}
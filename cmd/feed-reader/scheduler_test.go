@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -217,3 +218,26 @@ func TestAdjustIntervalForPeak(t *testing.T) {
 	})
 }
 
+
+func TestCalculateFailureBackoff(t *testing.T) {
+	base := 30 * time.Minute
+	maxInterval := 24 * time.Hour
+
+	tests := []struct {
+		failures int64
+		want     time.Duration
+	}{
+		{failures: 0, want: base},
+		{failures: 1, want: base},
+		{failures: 2, want: time.Hour},
+		{failures: 3, want: 2 * time.Hour},
+		{failures: 6, want: 16 * time.Hour},
+		{failures: 7, want: maxInterval},
+		{failures: 100, want: maxInterval},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d failures", tt.failures), func(t *testing.T) {
+			assert.Equal(t, CalculateFailureBackoff(base, tt.failures, maxInterval), tt.want)
+		})
+	}
+}
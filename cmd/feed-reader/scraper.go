@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/internal/scraper"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// ScraperFetcher fetches feeds, scraping the page of feeds that have CSS
+// selectors configured and delegating every other feed to the feed fetcher.
+type ScraperFetcher struct {
+	store *store.Store
+	feeds FeedFetcher
+	pages PageFetcher
+}
+
+func NewScraperFetcher(s *store.Store, feeds FeedFetcher, pages PageFetcher) *ScraperFetcher {
+	return &ScraperFetcher{
+		store: s,
+		feeds: feeds,
+		pages: pages,
+	}
+}
+
+// Fetch implements FeedFetcher.
+func (f *ScraperFetcher) Fetch(ctx context.Context, feedID string, url string) (*gofeed.Feed, error) {
+	if feedID != "" {
+		row, err := f.store.GetFeedScraper(ctx, feedID)
+		if err == nil {
+			return f.Scrape(ctx, feedID, url, scraperSelectors(row))
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+	return f.feeds.Fetch(ctx, feedID, url)
+}
+
+// Scrape downloads pageURL and extracts its items with selectors. The feed's
+// credentials are sent, as the page is the feed itself.
+func (f *ScraperFetcher) Scrape(ctx context.Context, feedID, pageURL string, selectors scraper.Selectors) (*gofeed.Feed, error) {
+	if err := selectors.Validate(); err != nil {
+		return nil, err
+	}
+	if feedID != "" {
+		ctx = context.WithValue(ctx, feedIDKey{}, feedID)
+	}
+	body, finalURL, err := f.pages.FetchPage(ctx, feedID, pageURL)
+	if err != nil {
+		return nil, err
+	}
+	feed, err := scraper.Parse(bytes.NewReader(body), finalURL, selectors)
+	if errors.Is(err, scraper.ErrNoItems) {
+		return nil, &FetchError{Kind: FetchErrorNotAFeed, Err: err}
+	}
+	if err != nil {
+		return nil, err
+	}
+	convertItemsToMarkdown(feed.Items, finalURL.String())
+	return feed, nil
+}
+
+func scraperSelectors(row store.FeedScraper) scraper.Selectors {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return scraper.Selectors{
+		Item:    row.ItemSelector,
+		Title:   value(row.TitleSelector),
+		Link:    value(row.LinkSelector),
+		Date:    value(row.DateSelector),
+		Content: value(row.ContentSelector),
+	}
+}
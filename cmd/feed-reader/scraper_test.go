@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/internal/scraper"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+const testListingPage = `<html><head><title>Releases</title></head><body>
+<ul>
+  <li class="release"><a href="/releases/2">v2.0</a><time datetime="2026-02-01">Feb 1</time></li>
+  <li class="release"><a href="/releases/1">v1.0</a></li>
+</ul>
+</body></html>`
+
+func TestScraperFetcher_Fetch(t *testing.T) {
+	ctx := context.Background()
+	queries, db := setupTestDB(t)
+	s := store.NewStore(db)
+
+	_, err := queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-scraped", Url: "https://example.com/releases"})
+	assert.NilError(t, err)
+	dateSelector := "time"
+	assert.NilError(t, queries.UpsertFeedScraper(ctx, store.UpsertFeedScraperParams{
+		FeedID:       "feed-scraped",
+		ItemSelector: "li.release",
+		DateSelector: &dateSelector,
+	}))
+	_, err = queries.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-rss", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	pages := &mockPageFetcher{pages: map[string]string{"https://example.com/releases": testListingPage}}
+	feeds := &mockFetcher{feed: &gofeed.Feed{Title: "RSS"}}
+	fetcher := NewScraperFetcher(s, feeds, pages)
+
+	t.Run("scrapes feeds with selectors", func(t *testing.T) {
+		feed, err := fetcher.Fetch(ctx, "feed-scraped", "https://example.com/releases")
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Title, "Releases")
+		assert.Equal(t, len(feed.Items), 2)
+		assert.Equal(t, feed.Items[0].Title, "v2.0")
+		assert.Equal(t, feed.Items[0].Link, "https://example.com/releases/2")
+		assert.Assert(t, feed.Items[0].PublishedParsed != nil)
+		assert.Assert(t, feed.Items[1].PublishedParsed == nil)
+	})
+
+	t.Run("delegates other feeds", func(t *testing.T) {
+		feed, err := fetcher.Fetch(ctx, "feed-rss", "https://example.com/feed.xml")
+		assert.NilError(t, err)
+		assert.Equal(t, feed.Title, "RSS")
+		assert.DeepEqual(t, pages.fetched(), []string{"https://example.com/releases"})
+	})
+}
+
+func TestScraperFetcher_Scrape_NoItems(t *testing.T) {
+	_, db := setupTestDB(t)
+	pages := &mockPageFetcher{pages: map[string]string{"https://example.com/releases": testListingPage}}
+	fetcher := NewScraperFetcher(store.NewStore(db), &mockFetcher{}, pages)
+
+	_, err := fetcher.Scrape(context.Background(), "", "https://example.com/releases", scraper.Selectors{Item: "article"})
+	var fetchErr *FetchError
+	assert.Assert(t, errors.As(err, &fetchErr))
+	assert.Equal(t, fetchErr.Kind, FetchErrorNotAFeed)
+	assert.ErrorIs(t, err, scraper.ErrNoItems)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"github.com/nakatanakatana/feed-reader/internal/feedtransport"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// profileClient is a client built from a snapshot of a transport profile row.
+type profileClient struct {
+	profile store.TransportProfile
+	client  *http.Client
+}
+
+// clientFor returns the HTTP client for a feed. Feeds without a transport
+// profile, and requests without a feed ID, use the default client.
+func (f *GofeedFetcher) clientFor(ctx context.Context, feedID string) (*http.Client, error) {
+	if feedID == "" {
+		return f.defaultClient(), nil
+	}
+	row, err := f.store.GetTransportProfileForFeed(ctx, feedID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return f.defaultClient(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transport profile: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cached, ok := f.profileClients[row.ID]
+	if ok && reflect.DeepEqual(cached.profile, row) {
+		return cached.client, nil
+	}
+
+	profile, err := f.transportProfile(row)
+	if err != nil {
+		return nil, fmt.Errorf("transport profile %q: %w", row.Name, err)
+	}
+	client, err := f.newClient(profile)
+	if err != nil {
+		return nil, fmt.Errorf("transport profile %q: %w", row.Name, err)
+	}
+	if ok {
+		cached.client.CloseIdleConnections()
+	}
+	f.profileClients[row.ID] = profileClient{profile: row, client: client}
+	return client, nil
+}
+
+// defaultClient returns the client for feeds without a transport profile.
+func (f *GofeedFetcher) defaultClient() *http.Client {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.client
+}
+
+// transportProfile converts a stored profile, decrypting its client key.
+func (f *GofeedFetcher) transportProfile(row store.TransportProfile) (*feedtransport.Profile, error) {
+	profile := &feedtransport.Profile{
+		InsecureSkipVerify: row.InsecureSkipVerify != 0,
+	}
+	if row.ProxyUrl != nil {
+		profile.ProxyURL = *row.ProxyUrl
+	}
+	if row.CaBundle != nil {
+		profile.CABundle = *row.CaBundle
+	}
+	if row.ClientCert != nil {
+		profile.ClientCert = *row.ClientCert
+	}
+	if row.ClientKey != nil {
+		if f.cipher == nil {
+			return nil, feedauth.ErrNoKey
+		}
+		key, err := f.cipher.Open(*row.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		profile.ClientKey = string(key)
+	}
+	if row.TimeoutSeconds != nil {
+		profile.Timeout = time.Duration(*row.TimeoutSeconds) * time.Second
+	}
+	if row.RetryMax != nil {
+		retryMax := int(*row.RetryMax)
+		profile.RetryMax = &retryMax
+	}
+	return profile, nil
+}
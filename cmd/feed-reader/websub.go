@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// Keys under which GofeedFetcher reports WebSub links in gofeed.Feed.Custom.
+const (
+	websubHubKey  = "websub_hub"
+	websubSelfKey = "websub_self"
+)
+
+const (
+	websubStateActive = "active"
+
+	// websubPendingTimeout is how long an unverified subscription request is
+	// trusted before it is sent again.
+	websubPendingTimeout = time.Hour
+	// websubMaxContentSize bounds the size of a content distribution request.
+	websubMaxContentSize = 10 << 20
+)
+
+// WebSubConfig configures WebSub push subscriptions.
+type WebSubConfig struct {
+	// CallbackBaseURL is the externally reachable base URL of this server.
+	CallbackBaseURL string
+	// LeaseSeconds is the lease requested from hubs.
+	LeaseSeconds int
+	// PollInterval is the minimum polling interval for feeds with an active subscription.
+	PollInterval time.Duration
+	// RenewBefore is how long before lease expiry a subscription is renewed.
+	RenewBefore time.Duration
+}
+
+// HubRequester sends subscription requests to WebSub hubs.
+type HubRequester interface {
+	RequestHub(ctx context.Context, feedID, hubURL string, form url.Values) error
+}
+
+// RequestHub posts a subscription request to a hub with the feed's transport
+// profile, so that hubs are reached the way the feed is. The feed's
+// credentials are not sent, as the hub lives on another host.
+func (f *GofeedFetcher) RequestHub(ctx context.Context, feedID, hubURL string, form url.Values) error {
+	if f.limits.TotalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.limits.TotalTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hubURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	client, err := f.clientFor(ctx, feedID)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return classifyTimeout(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("hub rejected subscription: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// WebSubService subscribes feeds to WebSub hubs and receives their content
+// distribution requests.
+type WebSubService struct {
+	store        *store.Store
+	fetchService *FetcherService
+	writeQueue   *WriteQueueService
+	hub          HubRequester
+	logger       *slog.Logger
+	config       WebSubConfig
+}
+
+// NewWebSubService creates a new WebSubService.
+func NewWebSubService(s *store.Store, fs *FetcherService, wq *WriteQueueService, h HubRequester, l *slog.Logger, cfg WebSubConfig) *WebSubService {
+	return &WebSubService{
+		store:        s,
+		fetchService: fs,
+		writeQueue:   wq,
+		hub:          h,
+		logger:       l,
+		config:       cfg,
+	}
+}
+
+func (w *WebSubService) callbackURL(feedID string) string {
+	return strings.TrimSuffix(w.config.CallbackBaseURL, "/") + httpapi.WebSubCallbackPath + url.PathEscape(feedID)
+}
+
+// EnsureSubscribed subscribes the feed to hubURL for topicURL unless an
+// equivalent subscription is already active or awaiting verification.
+func (w *WebSubService) EnsureSubscribed(ctx context.Context, feedID, hubURL, topicURL string) error {
+	sub, err := w.store.GetWebSubSubscription(ctx, feedID)
+	if err == nil && sub.HubUrl == hubURL && sub.TopicUrl == topicURL {
+		if sub.State == websubStateActive {
+			return nil
+		}
+		if updatedAt, err := time.Parse(time.RFC3339, sub.UpdatedAt); err == nil && time.Since(updatedAt) < websubPendingTimeout {
+			return nil
+		}
+	} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	secret, err := newWebSubSecret()
+	if err != nil {
+		return err
+	}
+	if _, err := w.store.UpsertWebSubSubscription(ctx, store.UpsertWebSubSubscriptionParams{
+		FeedID:   feedID,
+		HubUrl:   hubURL,
+		TopicUrl: topicURL,
+		Secret:   secret,
+	}); err != nil {
+		return err
+	}
+
+	if err := w.requestSubscription(ctx, feedID, hubURL, topicURL, secret); err != nil {
+		_ = w.store.DeleteWebSubSubscription(ctx, feedID)
+		return err
+	}
+	w.logger.InfoContext(ctx, "requested websub subscription", "feed_id", feedID, "hub", hubURL, "topic", topicURL)
+	return nil
+}
+
+// RenewLeases re-sends subscription requests for leases that expire within RenewBefore.
+func (w *WebSubService) RenewLeases(ctx context.Context) error {
+	renewBefore := time.Now().UTC().Add(w.config.RenewBefore).Format(time.RFC3339)
+	subs, err := w.store.ListWebSubSubscriptionsToRenew(ctx, &renewBefore)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "failed to list websub subscriptions to renew", "error", err)
+		return err
+	}
+	for _, sub := range subs {
+		if err := w.requestSubscription(ctx, sub.FeedID, sub.HubUrl, sub.TopicUrl, sub.Secret); err != nil {
+			w.logger.WarnContext(ctx, "failed to renew websub subscription", "feed_id", sub.FeedID, "hub", sub.HubUrl, "error", err)
+			continue
+		}
+		w.logger.InfoContext(ctx, "requested websub lease renewal", "feed_id", sub.FeedID, "hub", sub.HubUrl)
+	}
+	return nil
+}
+
+func (w *WebSubService) requestSubscription(ctx context.Context, feedID, hubURL, topicURL, secret string) error {
+	form := url.Values{}
+	form.Set("hub.mode", "subscribe")
+	form.Set("hub.topic", topicURL)
+	form.Set("hub.callback", w.callbackURL(feedID))
+	form.Set("hub.secret", secret)
+	if w.config.LeaseSeconds > 0 {
+		form.Set("hub.lease_seconds", strconv.Itoa(w.config.LeaseSeconds))
+	}
+	return w.hub.RequestHub(ctx, feedID, hubURL, form)
+}
+
+// IsPushActive reports whether the feed has a verified subscription with an unexpired lease.
+func (w *WebSubService) IsPushActive(ctx context.Context, feedID string) bool {
+	sub, err := w.store.GetWebSubSubscription(ctx, feedID)
+	if err != nil || sub.State != websubStateActive {
+		return false
+	}
+	if sub.LeaseExpiresAt == nil {
+		return true
+	}
+	expiresAt, err := time.Parse(time.RFC3339, *sub.LeaseExpiresAt)
+	return err == nil && expiresAt.After(time.Now())
+}
+
+// ServeHTTP handles intent verification (GET) and content distribution (POST)
+// requests from hubs at WebSubCallbackPath + feed ID.
+func (w *WebSubService) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	feedID, err := url.PathUnescape(strings.TrimPrefix(r.URL.Path, httpapi.WebSubCallbackPath))
+	if err != nil || feedID == "" {
+		http.NotFound(rw, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.verifyIntent(rw, r, feedID)
+	case http.MethodPost:
+		w.receiveContent(rw, r, feedID)
+	default:
+		rw.Header().Set("Allow", "GET, POST")
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (w *WebSubService) verifyIntent(rw http.ResponseWriter, r *http.Request, feedID string) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	mode := query.Get("hub.mode")
+	topic := query.Get("hub.topic")
+	challenge := query.Get("hub.challenge")
+
+	sub, err := w.store.GetWebSubSubscription(ctx, feedID)
+	found := err == nil
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		w.logger.ErrorContext(ctx, "failed to get websub subscription", "feed_id", feedID, "error", err)
+		http.Error(rw, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	switch mode {
+	case "subscribe":
+		if !found || sub.TopicUrl != topic || challenge == "" {
+			http.NotFound(rw, r)
+			return
+		}
+		params := store.ActivateWebSubSubscriptionParams{FeedID: feedID}
+		if leaseSeconds, err := strconv.ParseInt(query.Get("hub.lease_seconds"), 10, 64); err == nil && leaseSeconds > 0 {
+			expiresAt := time.Now().UTC().Add(time.Duration(leaseSeconds) * time.Second).Format(time.RFC3339)
+			params.LeaseSeconds = &leaseSeconds
+			params.LeaseExpiresAt = &expiresAt
+		}
+		if err := w.store.ActivateWebSubSubscription(ctx, params); err != nil {
+			w.logger.ErrorContext(ctx, "failed to activate websub subscription", "feed_id", feedID, "error", err)
+			http.Error(rw, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.logger.InfoContext(ctx, "websub subscription verified", "feed_id", feedID, "lease_expires_at", params.LeaseExpiresAt)
+	case "unsubscribe":
+		// We only unsubscribe by dropping our record, so confirm only when none remains.
+		if found || challenge == "" {
+			http.NotFound(rw, r)
+			return
+		}
+	case "denied":
+		if found && sub.TopicUrl == topic {
+			if err := w.store.DeleteWebSubSubscription(ctx, feedID); err != nil {
+				w.logger.ErrorContext(ctx, "failed to delete denied websub subscription", "feed_id", feedID, "error", err)
+			}
+		}
+		w.logger.WarnContext(ctx, "websub subscription denied by hub", "feed_id", feedID, "reason", query.Get("hub.reason"))
+		rw.WriteHeader(http.StatusOK)
+		return
+	default:
+		http.Error(rw, "unsupported hub.mode", http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/plain")
+	rw.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(rw, challenge)
+}
+
+func (w *WebSubService) receiveContent(rw http.ResponseWriter, r *http.Request, feedID string) {
+	ctx := r.Context()
+	sub, err := w.store.GetWebSubSubscription(ctx, feedID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			w.logger.ErrorContext(ctx, "failed to get websub subscription", "feed_id", feedID, "error", err)
+			http.Error(rw, "internal error", http.StatusInternalServerError)
+			return
+		}
+		http.NotFound(rw, r)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, websubMaxContentSize+1))
+	if err != nil {
+		http.Error(rw, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > websubMaxContentSize {
+		http.Error(rw, "content too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Unauthenticated content is acknowledged but ignored, as the spec requires.
+	if !verifyWebSubSignature(sub.Secret, r.Header.Get("X-Hub-Signature"), body) {
+		w.logger.WarnContext(ctx, "ignoring websub content with invalid signature", "feed_id", feedID)
+		rw.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	parsedFeed, err := gofeed.NewParser().Parse(bytes.NewReader(body))
+	if err != nil {
+		w.logger.WarnContext(ctx, "failed to parse websub content", "feed_id", feedID, "error", err)
+		rw.WriteHeader(http.StatusAccepted)
+		return
+	}
+	convertItemsToMarkdown(parsedFeed.Items, sub.TopicUrl)
+
+	if len(parsedFeed.Items) > 0 {
+		var fullContent bool
+		if feed, err := w.store.GetFeed(ctx, feedID); err == nil {
+			fullContent = feed.FetchFullContent != 0
+		}
+		w.writeQueue.Submit(w.fetchService.newSaveItemsJob(feedID, fullContent, parsedFeed.Items))
+	}
+	w.logger.InfoContext(ctx, "received websub content", "feed_id", feedID, "items", len(parsedFeed.Items))
+	rw.WriteHeader(http.StatusAccepted)
+}
+
+func newWebSubSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate websub secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// verifyWebSubSignature checks an X-Hub-Signature header of the form method=hexdigest.
+func verifyWebSubSignature(secret, header string, body []byte) bool {
+	method, signature, ok := strings.Cut(header, "=")
+	if !ok {
+		return false
+	}
+	var newHash func() hash.Hash
+	switch strings.ToLower(method) {
+	case "sha1":
+		newHash = sha1.New
+	case "sha256":
+		newHash = sha256.New
+	case "sha384":
+		newHash = sha512.New384
+	case "sha512":
+		newHash = sha512.New
+	default:
+		return false
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// discoverWebSub returns the hub and self URLs advertised for a feed, either
+// in HTTP Link headers or in the feed document.
+func discoverWebSub(header http.Header, body []byte, baseURL *url.URL) (hub, self string) {
+	hub, self = parseWebSubLinkHeaders(header.Values("Link"))
+	if hub == "" {
+		hub, self = parseWebSubDocumentLinks(body)
+	}
+	if hub == "" {
+		return "", ""
+	}
+	if resolved, err := baseURL.Parse(hub); err == nil {
+		hub = resolved.String()
+	}
+	if self != "" {
+		if resolved, err := baseURL.Parse(self); err == nil {
+			self = resolved.String()
+		}
+	}
+	return hub, self
+}
+
+func parseWebSubLinkHeaders(values []string) (hub, self string) {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+			target = strings.TrimSpace(target)
+			if !ok || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			target = strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+			for _, param := range strings.Split(params, ";") {
+				key, val, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(strings.TrimSpace(key), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(val), `"`)) {
+					switch strings.ToLower(rel) {
+					case "hub":
+						if hub == "" {
+							hub = target
+						}
+					case "self":
+						if self == "" {
+							self = target
+						}
+					}
+				}
+			}
+		}
+	}
+	return hub, self
+}
+
+func parseWebSubDocumentLinks(body []byte) (hub, self string) {
+	trimmed := bytes.TrimSpace(body)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		var jsonFeed struct {
+			FeedURL string `json:"feed_url"`
+			Hubs    []struct {
+				Type string `json:"type"`
+				URL  string `json:"url"`
+			} `json:"hubs"`
+		}
+		if err := json.Unmarshal(trimmed, &jsonFeed); err != nil {
+			return "", ""
+		}
+		for _, h := range jsonFeed.Hubs {
+			if strings.EqualFold(h.Type, "websub") && h.URL != "" {
+				return h.URL, jsonFeed.FeedURL
+			}
+		}
+		return "", ""
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.Strict = false
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return hub, self
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(start.Name.Local) {
+		case "item", "entry":
+			// Hub links are channel-level; stop before scanning entries.
+			return hub, self
+		case "link":
+			var rel, href string
+			for _, attr := range start.Attr {
+				switch strings.ToLower(attr.Name.Local) {
+				case "rel":
+					rel = strings.ToLower(strings.TrimSpace(attr.Value))
+				case "href":
+					href = strings.TrimSpace(attr.Value)
+				}
+			}
+			if href == "" {
+				continue
+			}
+			switch rel {
+			case "hub":
+				if hub == "" {
+					hub = href
+				}
+			case "self":
+				if self == "" {
+					self = href
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestDiscoverWebSub(t *testing.T) {
+	base, err := url.Parse("https://example.com/feed.xml")
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		body     string
+		wantHub  string
+		wantSelf string
+	}{
+		{
+			name:     "link headers",
+			header:   http.Header{"Link": []string{`<https://hub.example.com/>; rel="hub", <https://example.com/feed.xml>; rel="self"`}},
+			wantHub:  "https://hub.example.com/",
+			wantSelf: "https://example.com/feed.xml",
+		},
+		{
+			name: "atom links",
+			body: `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <link rel="hub" href="https://pubsubhubbub.appspot.com/"/>
+  <link rel="self" href="/atom.xml"/>
+  <entry><link rel="hub" href="https://ignored.example.com/"/></entry>
+</feed>`,
+			wantHub:  "https://pubsubhubbub.appspot.com/",
+			wantSelf: "https://example.com/atom.xml",
+		},
+		{
+			name: "rss atom extension",
+			body: `<?xml version="1.0"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel>
+  <atom:link rel="hub" href="https://hub.example.com/"/>
+  <item><title>x</title></item>
+</channel></rss>`,
+			wantHub: "https://hub.example.com/",
+		},
+		{
+			name:     "json feed hubs",
+			body:     `{"version":"https://jsonfeed.org/version/1.1","feed_url":"https://example.com/feed.json","hubs":[{"type":"rssCloud","url":"https://cloud.example.com/"},{"type":"WebSub","url":"https://hub.example.com/"}]}`,
+			wantHub:  "https://hub.example.com/",
+			wantSelf: "https://example.com/feed.json",
+		},
+		{
+			name: "no hub",
+			body: `<?xml version="1.0"?><rss version="2.0"><channel><title>t</title></channel></rss>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.header
+			if header == nil {
+				header = http.Header{}
+			}
+			hub, self := discoverWebSub(header, []byte(tt.body), base)
+			assert.Equal(t, hub, tt.wantHub)
+			assert.Equal(t, self, tt.wantSelf)
+		})
+	}
+}
+
+func TestVerifyWebSubSignature(t *testing.T) {
+	body := []byte("payload")
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	valid := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Assert(t, verifyWebSubSignature("secret", valid, body))
+	assert.Assert(t, !verifyWebSubSignature("other", valid, body))
+	assert.Assert(t, !verifyWebSubSignature("secret", valid, []byte("tampered")))
+	assert.Assert(t, !verifyWebSubSignature("secret", "", body))
+	assert.Assert(t, !verifyWebSubSignature("secret", "md5=abcd", body))
+}
+
+func TestWebSubService_SubscribeVerifyAndReceive(t *testing.T) {
+	ctx := context.Background()
+	_, db := setupTestDB(t)
+	s := store.NewStore(db)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+	fetchService := NewFetcherService(s, &mockFetcher{}, nil, wq, logger, 30*time.Minute)
+
+	hubRequests := make(chan url.Values, 1)
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NilError(t, r.ParseForm())
+		hubRequests <- r.PostForm
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer hub.Close()
+
+	websub := NewWebSubService(s, fetchService, wq, NewGofeedFetcher(s), logger, WebSubConfig{
+		CallbackBaseURL: "https://reader.example.com/",
+		LeaseSeconds:    3600,
+		PollInterval:    24 * time.Hour,
+		RenewBefore:     2 * time.Hour,
+	})
+	fetchService.SetWebSub(websub)
+
+	// 1. Subscribe
+	err = websub.EnsureSubscribed(ctx, "feed-1", hub.URL, "https://example.com/feed.xml")
+	assert.NilError(t, err)
+	form := <-hubRequests
+	assert.Equal(t, form.Get("hub.mode"), "subscribe")
+	assert.Equal(t, form.Get("hub.topic"), "https://example.com/feed.xml")
+	assert.Equal(t, form.Get("hub.callback"), "https://reader.example.com/websub/callback/feed-1")
+	assert.Equal(t, form.Get("hub.lease_seconds"), "3600")
+	secret := form.Get("hub.secret")
+	assert.Assert(t, secret != "")
+	assert.Assert(t, !websub.IsPushActive(ctx, "feed-1"))
+
+	// A pending subscription is not requested again.
+	err = websub.EnsureSubscribed(ctx, "feed-1", hub.URL, "https://example.com/feed.xml")
+	assert.NilError(t, err)
+	assert.Equal(t, len(hubRequests), 0)
+
+	// 2. Intent verification
+	query := url.Values{}
+	query.Set("hub.mode", "subscribe")
+	query.Set("hub.topic", "https://other.example.com/feed.xml")
+	query.Set("hub.challenge", "challenge-1")
+	query.Set("hub.lease_seconds", "3600")
+	rec := httptest.NewRecorder()
+	websub.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/websub/callback/feed-1?"+query.Encode(), nil))
+	assert.Equal(t, rec.Code, http.StatusNotFound, "topic mismatch must not be confirmed")
+
+	query.Set("hub.topic", "https://example.com/feed.xml")
+	rec = httptest.NewRecorder()
+	websub.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/websub/callback/feed-1?"+query.Encode(), nil))
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, rec.Body.String(), "challenge-1")
+	assert.Assert(t, websub.IsPushActive(ctx, "feed-1"))
+
+	// 3. Content distribution
+	content := []byte(`<?xml version="1.0"?><rss version="2.0"><channel><title>t</title>
+<item><title>Pushed</title><link>https://example.com/pushed</link><description>&lt;b&gt;bold&lt;/b&gt;</description></item>
+</channel></rss>`)
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/websub/callback/feed-1", bytes.NewReader(content))
+	req.Header.Set("X-Hub-Signature", "sha256=deadbeef")
+	websub.ServeHTTP(rec, req)
+	assert.Equal(t, rec.Code, http.StatusAccepted)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(content)
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/websub/callback/feed-1", bytes.NewReader(content))
+	req.Header.Set("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	websub.ServeHTTP(rec, req)
+	assert.Equal(t, rec.Code, http.StatusAccepted)
+
+	time.Sleep(100 * time.Millisecond)
+	items, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: "feed-1", Limit: 10})
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 1, "only the signed delivery should be saved")
+	assert.Equal(t, *items[0].Title, "Pushed")
+	assert.Equal(t, strings.TrimSpace(items[0].Description), "**bold**")
+
+	// 4. Push-enabled feeds fall back to long polling
+	fetchService.markFetched(ctx, "feed-1", http.StatusOK, nil)
+	time.Sleep(100 * time.Millisecond)
+	feed, err := s.GetFeed(ctx, "feed-1")
+	assert.NilError(t, err)
+	nextFetch, err := time.Parse(time.RFC3339, *feed.NextFetch)
+	assert.NilError(t, err)
+	assert.Assert(t, time.Until(nextFetch) > 23*time.Hour, "next fetch %s should be about a day away", nextFetch)
+
+	// 5. Leases expiring within RenewBefore are renewed with the same secret
+	err = websub.RenewLeases(ctx)
+	assert.NilError(t, err)
+	form = <-hubRequests
+	assert.Equal(t, form.Get("hub.secret"), secret)
+}
+
+func TestGofeedFetcher_RequestHubUsesTransportProfile(t *testing.T) {
+	ctx := context.Background()
+	_, db := setupTestDB(t)
+	s := store.NewStore(db)
+
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer proxy.Close()
+
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "http://feeds.internal.example/rss.xml"})
+	assert.NilError(t, err)
+	_, err = s.CreateTransportProfile(ctx, store.CreateTransportProfileParams{
+		ID:       "profile-1",
+		Name:     "proxy",
+		ProxyUrl: &proxy.URL,
+	})
+	assert.NilError(t, err)
+	err = s.SetFeedTransportProfile(ctx, store.SetFeedTransportProfileParams{FeedID: "feed-1", TransportProfileID: "profile-1"})
+	assert.NilError(t, err)
+
+	hubURL := "http://hub.internal.example/"
+	err = NewGofeedFetcher(s).RequestHub(ctx, "feed-1", hubURL, url.Values{"hub.mode": {"subscribe"}})
+	assert.NilError(t, err)
+	assert.Equal(t, proxied, hubURL)
+}
+
+func TestWebSubService_DeniedAndUnknownFeeds(t *testing.T) {
+	ctx := context.Background()
+	_, db := setupTestDB(t)
+	s := store.NewStore(db)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	_, err = s.UpsertWebSubSubscription(ctx, store.UpsertWebSubSubscriptionParams{
+		FeedID:   "feed-1",
+		HubUrl:   "https://hub.example.com/",
+		TopicUrl: "https://example.com/feed.xml",
+		Secret:   "secret",
+	})
+	assert.NilError(t, err)
+
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	websub := NewWebSubService(s, nil, nil, nil, logger, WebSubConfig{CallbackBaseURL: "https://reader.example.com"})
+
+	rec := httptest.NewRecorder()
+	websub.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/websub/callback/unknown", strings.NewReader("x")))
+	assert.Equal(t, rec.Code, http.StatusNotFound)
+
+	rec = httptest.NewRecorder()
+	websub.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/websub/callback/feed-1?hub.mode=unsubscribe&hub.topic=https%3A%2F%2Fexample.com%2Ffeed.xml&hub.challenge=c", nil))
+	assert.Equal(t, rec.Code, http.StatusNotFound, "unsubscribe we did not request must not be confirmed")
+
+	rec = httptest.NewRecorder()
+	websub.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/websub/callback/feed-1?hub.mode=denied&hub.topic=https%3A%2F%2Fexample.com%2Ffeed.xml&hub.reason=nope", nil))
+	assert.Equal(t, rec.Code, http.StatusOK)
+	_, err = s.GetWebSubSubscription(ctx, "feed-1")
+	assert.ErrorContains(t, err, "no rows")
+}
+
+func TestFetcherService_SubscribesToAdvertisedHub(t *testing.T) {
+	ctx := context.Background()
+	_, db := setupTestDB(t)
+	s := store.NewStore(db)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	hubRequests := make(chan url.Values, 1)
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NilError(t, r.ParseForm())
+		hubRequests <- r.PostForm
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer hub.Close()
+
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	wq := NewWriteQueueService(s, WriteQueueConfig{MaxBatchSize: 1, FlushInterval: 10 * time.Millisecond}, logger)
+	go wq.Start(ctx)
+	fetcher := &mockFetcher{feed: &gofeed.Feed{
+		Custom: map[string]string{websubHubKey: hub.URL, websubSelfKey: "https://example.com/canonical.xml"},
+	}}
+	fetchService := NewFetcherService(s, fetcher, nil, wq, logger, 30*time.Minute)
+	fetchService.SetWebSub(NewWebSubService(s, fetchService, wq, NewGofeedFetcher(s), logger, WebSubConfig{CallbackBaseURL: "https://reader.example.com"}))
+
+	err = fetchService.FetchAndSave(ctx, store.FullFeed{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	form := <-hubRequests
+	assert.Equal(t, form.Get("hub.topic"), "https://example.com/canonical.xml")
+	sub, err := s.GetWebSubSubscription(ctx, "feed-1")
+	assert.NilError(t, err)
+	assert.Equal(t, sub.HubUrl, hub.URL)
+	assert.Equal(t, sub.State, "pending")
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel"
 )
@@ -10,21 +12,62 @@ import (
 // Task represents a unit of work to be executed by the worker pool.
 type Task func(ctx context.Context) error
 
+// HostLimits bound how hard the pool hits a single host.
+type HostLimits struct {
+	// MaxConcurrent caps the tasks running at once for a host. Zero means no cap.
+	MaxConcurrent int
+	// MinDelay is the minimum time between starting two tasks for a host.
+	MinDelay time.Duration
+}
+
+// hostState tracks the queued and running tasks of a single host.
+type hostState struct {
+	queue  []Task
+	active int
+	// nextStart is the earliest time the next task may start, set by
+	// MinDelay or by DeferHost.
+	nextStart time.Time
+}
+
 // WorkerPool manages a pool of workers to execute tasks concurrently.
 type WorkerPool struct {
 	maxWorkers int
 	tasks      chan Task
 	wg         sync.WaitGroup
+
+	// Host tasks wait in per-host queues until the dispatcher hands them to
+	// a worker within the host limits.
+	mu             sync.Mutex
+	limits         HostLimits
+	hosts          map[string]*hostState
+	wake           chan struct{}
+	pending        sync.WaitGroup
+	started        bool
+	stopped        bool
+	stop           chan struct{}
+	dispatcherDone chan struct{}
 }
 
 // NewWorkerPool creates a new WorkerPool with the specified number of workers.
 func NewWorkerPool(maxWorkers int) *WorkerPool {
 	return &WorkerPool{
-		maxWorkers: maxWorkers,
-		tasks:      make(chan Task),
+		maxWorkers:     maxWorkers,
+		tasks:          make(chan Task),
+		hosts:          make(map[string]*hostState),
+		wake:           make(chan struct{}, 1),
+		stop:           make(chan struct{}),
+		dispatcherDone: make(chan struct{}),
 	}
 }
 
+// SetHostLimits sets the per-host concurrency cap and politeness delay.
+func (wp *WorkerPool) SetHostLimits(limits HostLimits) {
+	wp.mu.Lock()
+	wp.limits = limits
+	wp.mu.Unlock()
+	wp.signal()
+}
+
 // Start initializes the workers and starts processing tasks.
 func (wp *WorkerPool) Start(ctx context.Context) {
 	for i := 0; i < wp.maxWorkers; i++ {
@@ -40,6 +83,11 @@ func (wp *WorkerPool) Start(ctx context.Context) {
 			}
 		})
 	}
+
+	wp.mu.Lock()
+	wp.started = true
+	wp.mu.Unlock()
+	go wp.dispatch(ctx)
 }
 
 // AddTask adds a task to the pool.
@@ -47,9 +95,210 @@ func (wp *WorkerPool) AddTask(task Task) {
 	wp.tasks <- task
 }
 
-// Wait blocks until all workers have finished (after the channel is closed).
+// AddHostTask queues a task that talks to host. It returns immediately; the
+// task runs once the host limits allow it. An empty host behaves like AddTask.
+func (wp *WorkerPool) AddHostTask(host string, task Task) {
+	if host == "" {
+		wp.AddTask(task)
+		return
+	}
+
+	wp.mu.Lock()
+	if wp.stopped {
+		wp.mu.Unlock()
+		return
+	}
+	wp.pending.Add(1)
+	state := wp.host(host)
+	state.queue = append(state.queue, task)
+	wp.mu.Unlock()
+	wp.signal()
+}
+
+// DeferHost holds back tasks for host until the given time, for example when
+// the host answered with Retry-After.
+func (wp *WorkerPool) DeferHost(host string, until time.Time) {
+	if host == "" {
+		return
+	}
+	wp.mu.Lock()
+	state := wp.host(host)
+	if until.After(state.nextStart) {
+		state.nextStart = until
+	}
+	wp.mu.Unlock()
+	wp.signal()
+}
+
+// HostStats reports the queued and running tasks per host, sorted by host.
+func (wp *WorkerPool) HostStats() []FetchQueueHost {
+	now := time.Now()
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+
+	stats := make([]FetchQueueHost, 0, len(wp.hosts))
+	for host, state := range wp.hosts {
+		stat := FetchQueueHost{
+			Host:   host,
+			Queued: len(state.queue),
+			Active: state.active,
+		}
+		if state.nextStart.After(now) {
+			nextStart := state.nextStart
+			stat.DeferredUntil = &nextStart
+		}
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Host < stats[j].Host })
+	return stats
+}
+
+// Wait blocks until all queued host tasks and all workers have finished.
 // Note: This implementation assumes the caller closes the tasks channel or cancels context.
 func (wp *WorkerPool) Wait() {
+	wp.pending.Wait()
+
+	wp.mu.Lock()
+	started := wp.started
+	wp.stopped = true
+	wp.mu.Unlock()
+	if started {
+		close(wp.stop)
+		<-wp.dispatcherDone
+	}
+
 	close(wp.tasks)
 	wp.wg.Wait()
 }
+
+// host returns the state of host, creating it if needed. wp.mu must be held.
+func (wp *WorkerPool) host(host string) *hostState {
+	state, ok := wp.hosts[host]
+	if !ok {
+		state = &hostState{}
+		wp.hosts[host] = state
+	}
+	return state
+}
+
+func (wp *WorkerPool) signal() {
+	select {
+	case wp.wake <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch hands queued host tasks to the workers as the host limits allow.
+func (wp *WorkerPool) dispatch(ctx context.Context) {
+	defer close(wp.dispatcherDone)
+
+	for {
+		host, task, wait := wp.next(time.Now())
+		if task != nil {
+			select {
+			case wp.tasks <- task:
+				wp.mu.Lock()
+				if state, ok := wp.hosts[host]; ok && wp.limits.MinDelay > 0 {
+					if nextStart := time.Now().Add(wp.limits.MinDelay); nextStart.After(state.nextStart) {
+						state.nextStart = nextStart
+					}
+				}
+				wp.mu.Unlock()
+			case <-ctx.Done():
+				wp.finish(host)
+				wp.drop()
+				return
+			}
+			continue
+		}
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+		select {
+		case <-wp.wake:
+		case <-timeout:
+		case <-wp.stop:
+			return
+		case <-ctx.Done():
+			wp.drop()
+			return
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// next pops the next task that may start now. When none may, it returns how
+// long to wait for the earliest deferred host, or zero to wait for a signal.
+func (wp *WorkerPool) next(now time.Time) (string, Task, time.Duration) {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+
+	var readyHost string
+	var ready *hostState
+	var wait time.Duration
+	for host, state := range wp.hosts {
+		if len(state.queue) == 0 {
+			if state.active == 0 && !state.nextStart.After(now) {
+				delete(wp.hosts, host)
+			}
+			continue
+		}
+		if wp.limits.MaxConcurrent > 0 && state.active >= wp.limits.MaxConcurrent {
+			continue
+		}
+		if d := state.nextStart.Sub(now); d > 0 {
+			if wait == 0 || d < wait {
+				wait = d
+			}
+			continue
+		}
+		// Prefer the host that has waited longest so busy hosts do not starve others.
+		if ready == nil || state.nextStart.Before(ready.nextStart) {
+			readyHost, ready = host, state
+		}
+	}
+	if ready == nil {
+		return "", nil, wait
+	}
+
+	task := ready.queue[0]
+	ready.queue[0] = nil
+	ready.queue = ready.queue[1:]
+	ready.active++
+	return readyHost, func(ctx context.Context) error {
+		defer wp.finish(readyHost)
+		return task(ctx)
+	}, 0
+}
+
+// finish releases the slot of a task that ran, or will not run, for host.
+func (wp *WorkerPool) finish(host string) {
+	wp.mu.Lock()
+	if state, ok := wp.hosts[host]; ok {
+		state.active--
+	}
+	wp.mu.Unlock()
+	wp.pending.Done()
+	wp.signal()
+}
+
+// drop discards queued host tasks once the pool is shutting down.
+func (wp *WorkerPool) drop() {
+	wp.mu.Lock()
+	wp.stopped = true
+	var dropped int
+	for _, state := range wp.hosts {
+		dropped += len(state.queue)
+		state.queue = nil
+	}
+	wp.mu.Unlock()
+	for range dropped {
+		wp.pending.Done()
+	}
+}
@@ -58,3 +58,83 @@ func TestWorkerPool_Concurrency(t *testing.T) {
 	assert.Equal(t, completedTasks, int32(totalTasks))
 	assert.Assert(t, maxActiveWorkers <= int32(maxWorkers), "Should not exceed max workers")
 }
+
+func TestWorkerPool_HostLimits(t *testing.T) {
+	wp := NewWorkerPool(4)
+	wp.SetHostLimits(HostLimits{MaxConcurrent: 1, MinDelay: 20 * time.Millisecond})
+	wp.Start(t.Context())
+
+	var mu sync.Mutex
+	active := map[string]int{}
+	maxActive := map[string]int{}
+	starts := map[string][]time.Time{}
+
+	for _, host := range []string{"a.example", "a.example", "a.example", "b.example", "b.example"} {
+		wp.AddHostTask(host, func(ctx context.Context) error {
+			mu.Lock()
+			active[host]++
+			maxActive[host] = max(maxActive[host], active[host])
+			starts[host] = append(starts[host], time.Now())
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			active[host]--
+			mu.Unlock()
+			return nil
+		})
+	}
+	wp.Wait()
+
+	assert.Equal(t, maxActive["a.example"], 1)
+	assert.Equal(t, maxActive["b.example"], 1)
+	assert.Equal(t, len(starts["a.example"]), 3)
+	assert.Equal(t, len(starts["b.example"]), 2)
+	for host, times := range starts {
+		for i := 1; i < len(times); i++ {
+			gap := times[i].Sub(times[i-1])
+			assert.Assert(t, gap >= 20*time.Millisecond, "%s started tasks %v apart", host, gap)
+		}
+	}
+}
+
+func TestWorkerPool_DeferHost(t *testing.T) {
+	wp := NewWorkerPool(2)
+	wp.Start(t.Context())
+
+	deferredUntil := time.Now().Add(100 * time.Millisecond)
+	wp.DeferHost("slow.example", deferredUntil)
+
+	var startedAt atomic.Value
+	wp.AddHostTask("slow.example", func(ctx context.Context) error {
+		startedAt.Store(time.Now())
+		return nil
+	})
+
+	stats := wp.HostStats()
+	assert.Equal(t, len(stats), 1)
+	assert.Equal(t, stats[0].Host, "slow.example")
+	assert.Equal(t, stats[0].Queued, 1)
+	assert.Assert(t, stats[0].DeferredUntil != nil)
+
+	wp.Wait()
+	assert.Assert(t, !startedAt.Load().(time.Time).Before(deferredUntil))
+}
+
+func TestWorkerPool_DropsQueuedTasksOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	wp := NewWorkerPool(1)
+	wp.Start(ctx)
+
+	wp.DeferHost("later.example", time.Now().Add(time.Hour))
+	var ran atomic.Bool
+	wp.AddHostTask("later.example", func(ctx context.Context) error {
+		ran.Store(true)
+		return nil
+	})
+
+	cancel()
+	wp.Wait()
+	assert.Assert(t, !ran.Load())
+}
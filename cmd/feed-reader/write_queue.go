@@ -6,7 +6,6 @@ import (
 	"log/slog"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/nakatanakatana/feed-reader/store"
 )
 
@@ -15,6 +14,12 @@ type WriteQueueJob interface {
 	Execute(ctx context.Context, q *store.Queries) error
 }
 
+// committedJob is implemented by jobs with work to do once their batch has
+// been committed, such as telling others about the rows they wrote.
+type committedJob interface {
+	Committed()
+}
+
 // WriteQueueConfig defines the configuration for the write queue service.
 type WriteQueueConfig struct {
 	MaxBatchSize  int
@@ -104,6 +109,12 @@ func (s *WriteQueueService) flush(ctx context.Context, batch []WriteQueueJob) {
 
 	if err != nil {
 		s.logger.ErrorContext(ctx, "batch transaction failed", "error", err)
+		return
+	}
+	for _, job := range batch {
+		if job, ok := job.(committedJob); ok {
+			job.Committed()
+		}
 	}
 }
 
@@ -111,6 +122,12 @@ func (s *WriteQueueService) flush(ctx context.Context, batch []WriteQueueJob) {
 type SaveItemsJob struct {
 	Items      []store.SaveFetchedItemParams
 	ResultChan chan SaveItemsResult
+	// OnNewItems, if set, receives the items that did not exist before. It
+	// runs once the write transaction has been committed, so it is not called
+	// when the batch is rolled back.
+	OnNewItems func(items []store.Item)
+
+	inserted []store.Item
 }
 
 type SaveItemsResult struct {
@@ -141,6 +158,8 @@ func (j *SaveItemsJob) Execute(ctx context.Context, q *store.Queries) error {
 	urlParser := NewURLParser(urlRules)
 
 	var newItems int32
+	var inserted []store.Item
+	unreadOnRevision := map[string]bool{}
 	for _, params := range j.Items {
 		if err := store.ValidateSaveFetchedItemParams(params); err != nil {
 			err = fmt.Errorf("invalid item params: %w", err)
@@ -154,26 +173,16 @@ func (j *SaveItemsJob) Execute(ctx context.Context, q *store.Queries) error {
 		}
 
 		// 1. Upsert Item
-		newID := uuid.NewString()
-		item, err := q.CreateItem(ctx, store.CreateItemParams{
-			ID:          newID,
-			Url:         params.Url,
-			Title:       params.Title,
-			Description: params.Description,
-			PublishedAt: params.PublishedAt,
-			Author:      params.Author,
-			Guid:        params.Guid,
-			Content:     params.Content,
-			ImageUrl:    params.ImageUrl,
-			Categories:  params.Categories,
-		})
+		item, previous, err := store.UpsertFetchedItem(ctx, q, params)
 		if err != nil {
-			err = fmt.Errorf("failed to create/update item: %w", err)
 			if j.ResultChan != nil {
 				j.ResultChan <- SaveItemsResult{Error: err}
 			}
 			return err
 		}
+		if previous == nil {
+			inserted = append(inserted, item)
+		}
 
 		// 2. Link to Feed
 		err = q.CreateFeedItem(ctx, store.CreateFeedItemParams{
@@ -198,6 +207,50 @@ func (j *SaveItemsJob) Execute(ctx context.Context, q *store.Queries) error {
 			return err
 		}
 
+		// 3.25. Save Enclosures
+		if err := store.SaveItemEnclosures(ctx, q, item.ID, params.Enclosures); err != nil {
+			if j.ResultChan != nil {
+				j.ResultChan <- SaveItemsResult{Error: err}
+			}
+			return err
+		}
+
+		// 3.3. Save Categories
+		if err := store.SaveItemCategories(ctx, q, item.ID, item.Categories); err != nil {
+			if j.ResultChan != nil {
+				j.ResultChan <- SaveItemsResult{Error: err}
+			}
+			return err
+		}
+
+		// 3.5. Record Revision
+		markUnread, ok := unreadOnRevision[params.FeedID]
+		if !ok {
+			feed, err := q.GetFeed(ctx, params.FeedID)
+			if err != nil {
+				err = fmt.Errorf("failed to get feed: %w", err)
+				if j.ResultChan != nil {
+					j.ResultChan <- SaveItemsResult{Error: err}
+				}
+				return err
+			}
+			markUnread = feed.UnreadOnRevision != 0
+			unreadOnRevision[params.FeedID] = markUnread
+		}
+		_, err = store.RecordItemRevision(ctx, q, store.RecordItemRevisionParams{
+			FeedID:           params.FeedID,
+			Previous:         previous,
+			Item:             item,
+			SourceHash:       params.SourceHash,
+			UnreadOnRevision: markUnread,
+		})
+		if err != nil {
+			if j.ResultChan != nil {
+				j.ResultChan <- SaveItemsResult{Error: err}
+			}
+			return err
+		}
+
 		// 4. Check Block Rules
 		fullItem := store.FullItem{
 			ID:          item.ID,
@@ -237,12 +290,36 @@ func (j *SaveItemsJob) Execute(ctx context.Context, q *store.Queries) error {
 		newItems++
 	}
 
+	j.inserted = inserted
 	if j.ResultChan != nil {
 		j.ResultChan <- SaveItemsResult{NewItemsCount: newItems}
 	}
 	return nil
 }
 
+// Committed reports the new items to OnNewItems.
+func (j *SaveItemsJob) Committed() {
+	if j.OnNewItems != nil && len(j.inserted) > 0 {
+		j.OnNewItems(j.inserted)
+	}
+}
+
+// SaveItemFullContentJob stores the extracted full content of an item.
+type SaveItemFullContentJob struct {
+	Params store.UpsertItemFullContentParams
+	// Done, if set, receives the result of the write.
+	Done chan error
+}
+
+// Execute performs the upsert operation.
+func (j *SaveItemFullContentJob) Execute(ctx context.Context, q *store.Queries) error {
+	err := q.UpsertItemFullContent(ctx, j.Params)
+	if j.Done != nil {
+		j.Done <- err
+	}
+	return err
+}
+
 // UpdateFeedJob represents a job to update feed metadata.
 type UpdateFeedJob struct {
 	Params store.UpdateFeedParams
@@ -254,14 +331,62 @@ func (j *UpdateFeedJob) Execute(ctx context.Context, q *store.Queries) error {
 	return err
 }
 
+// SaveFeedIconJob stores the icon of a feed, or the error finding it.
+type SaveFeedIconJob struct {
+	Params store.UpsertFeedIconParams
+}
+
+// Execute performs the upsert operation.
+func (j *SaveFeedIconJob) Execute(ctx context.Context, q *store.Queries) error {
+	return q.UpsertFeedIcon(ctx, j.Params)
+}
+
 // MarkFetchedJob represents a job to update only the last_fetched_at field.
+// When Success is set, the fetch is also recorded as successful.
 type MarkFetchedJob struct {
-	Params store.MarkFeedFetchedParams
+	Params  store.MarkFeedFetchedParams
+	Success *store.RecordFeedFetchSuccessParams
 }
 
 // Execute performs the mark fetched operation.
 func (j *MarkFetchedJob) Execute(ctx context.Context, q *store.Queries) error {
-	return q.MarkFeedFetched(ctx, j.Params)
+	if err := q.MarkFeedFetched(ctx, j.Params); err != nil {
+		return err
+	}
+	if j.Success != nil {
+		return q.RecordFeedFetchSuccess(ctx, *j.Success)
+	}
+	return nil
+}
+
+// DisableFeedJob represents a job to stop scheduling fetches for a feed.
+type DisableFeedJob struct {
+	Params store.DisableFeedParams
+}
+
+// Execute performs the disable operation.
+func (j *DisableFeedJob) Execute(ctx context.Context, q *store.Queries) error {
+	return q.DisableFeed(ctx, j.Params)
+}
+
+// RecordFetchFailureJob represents a job to record a failed fetch.
+type RecordFetchFailureJob struct {
+	Params store.RecordFeedFetchFailureParams
+}
+
+// Execute performs the record failure operation.
+func (j *RecordFetchFailureJob) Execute(ctx context.Context, q *store.Queries) error {
+	return q.RecordFeedFetchFailure(ctx, j.Params)
+}
+
+// PostponeHostFetchesJob represents a job to delay fetches of every feed on a host.
+type PostponeHostFetchesJob struct {
+	Params store.PostponeHostFetchesParams
+}
+
+// Execute performs the postpone operation.
+func (j *PostponeHostFetchesJob) Execute(ctx context.Context, q *store.Queries) error {
+	return q.PostponeHostFetches(ctx, j.Params)
 }
 
 // CreateFeedJob represents a job to create a new feed.
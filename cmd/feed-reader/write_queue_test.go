@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"strings"
 	"testing"
@@ -183,3 +184,93 @@ func TestSaveItemsJobCleansTrackingParametersBeforeDeduplication(t *testing.T) {
 		t.Errorf("stored URL = %q, want cleaned URL", items[0].Url)
 	}
 }
+
+func TestSaveItemsJobRecordsRevisions(t *testing.T) {
+	st := setupTestStore(t)
+	ctx := t.Context()
+
+	feed, err := st.CreateFeed(ctx, store.CreateFeedParams{ID: "f-revisions", Url: "https://example.com/feed.xml"})
+	if err != nil {
+		t.Fatalf("failed to create feed: %v", err)
+	}
+	if err := st.SetFeedUnreadOnRevision(ctx, store.SetFeedUnreadOnRevisionParams{ID: feed.ID, UnreadOnRevision: 1}); err != nil {
+		t.Fatalf("failed to enable unread on revision: %v", err)
+	}
+
+	save := func(title string) {
+		t.Helper()
+		job := &SaveItemsJob{
+			Items: []store.SaveFetchedItemParams{{FeedID: feed.ID, Url: "https://example.com/article", Title: new(title)}},
+		}
+		if err := job.Execute(ctx, st.Queries); err != nil {
+			t.Fatalf("failed to save items: %v", err)
+		}
+	}
+
+	save("Original")
+	items, err := st.ListItems(ctx, store.StoreListItemsParams{FeedID: feed.ID, Limit: 10, IsBlocked: false})
+	if err != nil || len(items) != 1 {
+		t.Fatalf("expected one item, got %d (%v)", len(items), err)
+	}
+	itemID := items[0].ID
+	if _, err := st.SetItemRead(ctx, store.SetItemReadParams{ItemID: itemID, IsRead: 1}); err != nil {
+		t.Fatalf("failed to mark item read: %v", err)
+	}
+
+	save("Corrected")
+	revisions, err := st.ListItemRevisions(ctx, itemID)
+	if err != nil {
+		t.Fatalf("failed to list revisions: %v", err)
+	}
+	if len(revisions) != 2 || revisions[0].Revision != 2 {
+		t.Fatalf("expected revisions 2 and 1, got %+v", revisions)
+	}
+	item, err := st.GetItem(ctx, itemID)
+	if err != nil {
+		t.Fatalf("failed to get item: %v", err)
+	}
+	if item.RevisedAt == nil {
+		t.Error("expected revised_at to be set")
+	}
+	if item.IsRead != 0 {
+		t.Error("expected the revised item to be unread again")
+	}
+}
+
+type failingJob struct{}
+
+func (failingJob) Execute(ctx context.Context, q *store.Queries) error {
+	return errors.New("failed")
+}
+
+func TestSaveItemsJobReportsNewItemsAfterCommit(t *testing.T) {
+	st := setupTestStore(t)
+	ctx := t.Context()
+	s := NewWriteQueueService(st, WriteQueueConfig{MaxBatchSize: 10, FlushInterval: time.Hour}, slog.Default())
+	feed, err := st.CreateFeed(ctx, store.CreateFeedParams{ID: "f1", Url: "url1"})
+	if err != nil {
+		t.Fatalf("failed to create feed: %v", err)
+	}
+
+	var reported []store.Item
+	newJob := func(url string) *SaveItemsJob {
+		return &SaveItemsJob{
+			Items:      []store.SaveFetchedItemParams{{FeedID: feed.ID, Url: url}},
+			OnNewItems: func(items []store.Item) { reported = append(reported, items...) },
+		}
+	}
+
+	// A later job failing rolls the new item back, so it is not reported.
+	s.flush(ctx, []WriteQueueJob{newJob("item1"), failingJob{}})
+	if len(reported) != 0 {
+		t.Errorf("expected no reported items after a rollback, got %d", len(reported))
+	}
+
+	s.flush(ctx, []WriteQueueJob{newJob("item2")})
+	if len(reported) != 1 || reported[0].Url != "item2" {
+		t.Fatalf("expected item2 to be reported, got %+v", reported)
+	}
+	if _, err := st.GetItem(ctx, reported[0].ID); err != nil {
+		t.Errorf("expected the reported item to be committed: %v", err)
+	}
+}
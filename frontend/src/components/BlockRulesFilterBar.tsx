@@ -57,6 +57,7 @@ export function BlockRulesFilterBar(props: BlockRulesFilterBarProps) {
           <option value="domain">Domain</option>
           <option value="user_domain">User @ Domain</option>
           <option value="keyword">Keyword</option>
+          <option value="category">Category</option>
         </select>
       </div>
 
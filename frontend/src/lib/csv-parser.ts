@@ -1,4 +1,9 @@
-export type BlockRuleType = "user" | "domain" | "user_domain" | "keyword";
+export type BlockRuleType =
+  | "user"
+  | "domain"
+  | "user_domain"
+  | "keyword"
+  | "category";
 
 export interface ParsedBlockRule {
   ruleType: string;
@@ -13,6 +18,7 @@ const VALID_TYPES: readonly string[] = [
   "domain",
   "user_domain",
   "keyword",
+  "category",
 ];
 
 /**
@@ -481,7 +481,9 @@ export const statefulHandlers = [
     };
     for (const [i, rule] of (body.rules || []).entries()) {
       if (
-        !["user", "domain", "user_domain", "keyword"].includes(rule.ruleType)
+        !["user", "domain", "user_domain", "keyword", "category"].includes(
+          rule.ruleType,
+        )
       ) {
         return HttpResponse.json(
           { error: `invalid ruleType at index ${i}` },
@@ -216,6 +216,7 @@ function BlockRulesComponent() {
                   <option value="domain">Domain</option>
                   <option value="user_domain">User @ Domain</option>
                   <option value="keyword">Keyword</option>
+                  <option value="category">Category</option>
                 </select>
               </div>
               <div class={stack({ gap: "1", flex: "1", minWidth: "200px" })}>
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -47,15 +48,44 @@ type ApiError struct {
 	Message string `json:"message"`
 }
 
+// AssignFeedTransportProfileRequest defines model for AssignFeedTransportProfileRequest.
+type AssignFeedTransportProfileRequest struct {
+	FeedIds            []string `json:"feedIds"`
+	TransportProfileId *string  `json:"transportProfileId,omitempty"`
+}
+
+// AssignTagTransportProfileRequest defines model for AssignTagTransportProfileRequest.
+type AssignTagTransportProfileRequest struct {
+	TagIds             []string `json:"tagIds"`
+	TransportProfileId *string  `json:"transportProfileId,omitempty"`
+}
+
+// CategoryFacet defines model for CategoryFacet.
+type CategoryFacet struct {
+	ItemCount   string `json:"itemCount"`
+	Name        string `json:"name"`
+	UnreadCount string `json:"unreadCount"`
+}
+
+// CountsResponse defines model for CountsResponse.
+type CountsResponse struct {
+	Feeds         []FeedItemCounts        `json:"feeds"`
+	SavedSearches []SavedSearchItemCounts `json:"savedSearches"`
+	Tags          []TagItemCounts         `json:"tags"`
+	Total         ItemCounts              `json:"total"`
+}
+
 // CreateFeedRequest defines model for CreateFeedRequest.
 type CreateFeedRequest struct {
-	TagIds []string `json:"tagIds"`
-	Url    string   `json:"url"`
+	AutoSelect *bool    `json:"autoSelect,omitempty"`
+	TagIds     []string `json:"tagIds"`
+	Url        string   `json:"url"`
 }
 
 // CreateFeedResponse defines model for CreateFeedResponse.
 type CreateFeedResponse struct {
-	Feed Feed `json:"feed"`
+	Candidates *[]FeedCandidate `json:"candidates,omitempty"`
+	Feed       *Feed            `json:"feed,omitempty"`
 }
 
 // CreateIgnoreWindowRequest defines model for CreateIgnoreWindowRequest.
@@ -72,6 +102,33 @@ type CreateIgnoreWindowResponse struct {
 	IgnoreWindow IgnoreWindow `json:"ignoreWindow"`
 }
 
+// CreateSavedSearchRequest defines model for CreateSavedSearchRequest.
+type CreateSavedSearchRequest struct {
+	Author     *string    `json:"author,omitempty"`
+	FeedIds    *[]string  `json:"feedIds,omitempty"`
+	IsRead     *bool      `json:"isRead,omitempty"`
+	IsStarred  *bool      `json:"isStarred,omitempty"`
+	MaxAgeDays *int32     `json:"maxAgeDays,omitempty"`
+	Name       string     `json:"name"`
+	Query      *string    `json:"query,omitempty"`
+	Since      *time.Time `json:"since,omitempty"`
+	TagIds     *[]string  `json:"tagIds,omitempty"`
+	Until      *time.Time `json:"until,omitempty"`
+}
+
+// CreateSavedSearchResponse defines model for CreateSavedSearchResponse.
+type CreateSavedSearchResponse struct {
+	SavedSearch SavedSearch `json:"savedSearch"`
+}
+
+// CreateScrapedFeedRequest defines model for CreateScrapedFeedRequest.
+type CreateScrapedFeedRequest struct {
+	Selectors ScraperSelectors `json:"selectors"`
+	TagIds    []string         `json:"tagIds"`
+	Title     *string          `json:"title,omitempty"`
+	Url       string           `json:"url"`
+}
+
 // CreateTagRequest defines model for CreateTagRequest.
 type CreateTagRequest struct {
 	Name string `json:"name"`
@@ -82,6 +139,39 @@ type CreateTagResponse struct {
 	Tag Tag `json:"tag"`
 }
 
+// CreateTransportProfileRequest defines model for CreateTransportProfileRequest.
+type CreateTransportProfileRequest struct {
+	CaBundle           *string `json:"caBundle,omitempty"`
+	ClientCert         *string `json:"clientCert,omitempty"`
+	ClientKey          *string `json:"clientKey,omitempty"`
+	InsecureSkipVerify *bool   `json:"insecureSkipVerify,omitempty"`
+	Name               string  `json:"name"`
+	ProxyUrl           *string `json:"proxyUrl,omitempty"`
+	RetryMax           *int32  `json:"retryMax,omitempty"`
+	TimeoutSeconds     *int32  `json:"timeoutSeconds,omitempty"`
+}
+
+// CreateTransportProfileResponse defines model for CreateTransportProfileResponse.
+type CreateTransportProfileResponse struct {
+	TransportProfile TransportProfile `json:"transportProfile"`
+}
+
+// DiffItemRevisionsResponse defines model for DiffItemRevisionsResponse.
+type DiffItemRevisionsResponse struct {
+	Diff string       `json:"diff"`
+	From ItemRevision `json:"from"`
+	To   ItemRevision `json:"to"`
+}
+
+// Enclosure defines model for Enclosure.
+type Enclosure struct {
+	DurationSeconds *int32  `json:"durationSeconds,omitempty"`
+	Length          *int64  `json:"length,omitempty"`
+	MimeType        *string `json:"mimeType,omitempty"`
+	ThumbnailUrl    *string `json:"thumbnailUrl,omitempty"`
+	Url             string  `json:"url"`
+}
+
 // ExportOpmlRequest defines model for ExportOpmlRequest.
 type ExportOpmlRequest struct {
 	Ids []string `json:"ids"`
@@ -92,18 +182,48 @@ type ExportOpmlResponse struct {
 	OpmlContent []byte `json:"opmlContent"`
 }
 
+// ExtractItemContentResponse defines model for ExtractItemContentResponse.
+type ExtractItemContentResponse struct {
+	Item Item `json:"item"`
+}
+
 // Feed defines model for Feed.
 type Feed struct {
-	CreatedAt     time.Time  `json:"createdAt"`
-	Id            string     `json:"id"`
-	LastFetchedAt *time.Time `json:"lastFetchedAt,omitempty"`
-	Link          *string    `json:"link,omitempty"`
-	NextFetchAt   *time.Time `json:"nextFetchAt,omitempty"`
-	Tags          []Tag      `json:"tags"`
-	Title         string     `json:"title"`
-	UnreadCount   string     `json:"unreadCount"`
-	UpdatedAt     time.Time  `json:"updatedAt"`
-	Url           string     `json:"url"`
+	ConsecutiveFailures int32      `json:"consecutiveFailures"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	DisabledAt          *time.Time `json:"disabledAt,omitempty"`
+	DisabledReason      *string    `json:"disabledReason,omitempty"`
+	FetchFullContent    bool       `json:"fetchFullContent"`
+	Id                  string     `json:"id"`
+	LastError           *string    `json:"lastError,omitempty"`
+	LastErrorKind       *string    `json:"lastErrorKind,omitempty"`
+	LastFetchedAt       *time.Time `json:"lastFetchedAt,omitempty"`
+	LastStatusCode      *int32     `json:"lastStatusCode,omitempty"`
+	LastSuccessAt       *time.Time `json:"lastSuccessAt,omitempty"`
+	Link                *string    `json:"link,omitempty"`
+	NextFetchAt         *time.Time `json:"nextFetchAt,omitempty"`
+	RedirectUrl         *string    `json:"redirectUrl,omitempty"`
+	ScheduleReason      *string    `json:"scheduleReason,omitempty"`
+	Tags                []Tag      `json:"tags"`
+	Title               string     `json:"title"`
+	UnreadCount         string     `json:"unreadCount"`
+	UnreadOnRevision    bool       `json:"unreadOnRevision"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+	Url                 string     `json:"url"`
+}
+
+// FeedCandidate defines model for FeedCandidate.
+type FeedCandidate struct {
+	Title string `json:"title"`
+	Type  string `json:"type"`
+	Url   string `json:"url"`
+}
+
+// FeedCredentials defines model for FeedCredentials.
+type FeedCredentials struct {
+	AuthType    string    `json:"authType"`
+	HeaderNames []string  `json:"headerNames"`
+	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
 // FeedFetchStatus defines model for FeedFetchStatus.
@@ -120,17 +240,59 @@ type FeedIgnoreWindow struct {
 	IgnoreWindowId string `json:"ignoreWindowId"`
 }
 
+// FeedItemCounts defines model for FeedItemCounts.
+type FeedItemCounts struct {
+	FeedId       string `json:"feedId"`
+	StarredCount string `json:"starredCount"`
+	TotalCount   string `json:"totalCount"`
+	UnreadCount  string `json:"unreadCount"`
+}
+
 // FeedTag defines model for FeedTag.
 type FeedTag struct {
 	FeedId string `json:"feedId"`
 	TagId  string `json:"tagId"`
 }
 
+// FeedTransportProfile defines model for FeedTransportProfile.
+type FeedTransportProfile struct {
+	FeedId             string `json:"feedId"`
+	TransportProfileId string `json:"transportProfileId"`
+}
+
+// FetchQueueHost defines model for FetchQueueHost.
+type FetchQueueHost struct {
+	Active        int32      `json:"active"`
+	DeferredUntil *time.Time `json:"deferredUntil,omitempty"`
+	Host          string     `json:"host"`
+	Queued        int32      `json:"queued"`
+}
+
+// GetFeedCredentialsResponse defines model for GetFeedCredentialsResponse.
+type GetFeedCredentialsResponse struct {
+	Credentials *FeedCredentials `json:"credentials,omitempty"`
+}
+
+// GetFeedScraperResponse defines model for GetFeedScraperResponse.
+type GetFeedScraperResponse struct {
+	Selectors *ScraperSelectors `json:"selectors,omitempty"`
+}
+
+// GetFetchQueueResponse defines model for GetFetchQueueResponse.
+type GetFetchQueueResponse struct {
+	Hosts []FetchQueueHost `json:"hosts"`
+}
+
 // GetItemResponse defines model for GetItemResponse.
 type GetItemResponse struct {
 	Item *Item `json:"item,omitempty"`
 }
 
+// GetSavedSearchResponse defines model for GetSavedSearchResponse.
+type GetSavedSearchResponse struct {
+	SavedSearch SavedSearch `json:"savedSearch"`
+}
+
 // IgnoreWindow defines model for IgnoreWindow.
 type IgnoreWindow struct {
 	CreatedAt  time.Time `json:"createdAt"`
@@ -164,19 +326,28 @@ type ImportOpmlResponse struct {
 
 // Item defines model for Item.
 type Item struct {
-	Author      string      `json:"author"`
-	Categories  string      `json:"categories"`
-	Content     string      `json:"content"`
-	CreatedAt   time.Time   `json:"createdAt"`
-	Description string      `json:"description"`
-	FeedId      string      `json:"feedId"`
-	Feeds       *[]ItemFeed `json:"feeds,omitempty"`
-	Id          string      `json:"id"`
-	ImageUrl    string      `json:"imageUrl"`
-	IsRead      bool        `json:"isRead"`
-	PublishedAt *time.Time  `json:"publishedAt,omitempty"`
-	Title       string      `json:"title"`
-	Url         string      `json:"url"`
+	Author               string       `json:"author"`
+	Categories           string       `json:"categories"`
+	Content              string       `json:"content"`
+	CreatedAt            time.Time    `json:"createdAt"`
+	Description          string       `json:"description"`
+	Enclosures           *[]Enclosure `json:"enclosures,omitempty"`
+	FeedId               string       `json:"feedId"`
+	Feeds                *[]ItemFeed  `json:"feeds,omitempty"`
+	FullContent          *string      `json:"fullContent,omitempty"`
+	FullContentError     *string      `json:"fullContentError,omitempty"`
+	FullContentFetchedAt *time.Time   `json:"fullContentFetchedAt,omitempty"`
+	Id                   string       `json:"id"`
+	ImageUrl             string       `json:"imageUrl"`
+	IsRead               bool         `json:"isRead"`
+	IsStarred            bool         `json:"isStarred"`
+	Note                 *string      `json:"note,omitempty"`
+	PublishedAt          *time.Time   `json:"publishedAt,omitempty"`
+	RevisedAt            *time.Time   `json:"revisedAt,omitempty"`
+	StarredAt            *time.Time   `json:"starredAt,omitempty"`
+	Title                string       `json:"title"`
+	Url                  string       `json:"url"`
+	UserTags             []string     `json:"userTags"`
 }
 
 // ItemBlockRule defines model for ItemBlockRule.
@@ -187,6 +358,13 @@ type ItemBlockRule struct {
 	Value    string  `json:"value"`
 }
 
+// ItemCounts defines model for ItemCounts.
+type ItemCounts struct {
+	StarredCount string `json:"starredCount"`
+	TotalCount   string `json:"totalCount"`
+	UnreadCount  string `json:"unreadCount"`
+}
+
 // ItemFeed defines model for ItemFeed.
 type ItemFeed struct {
 	Id    string `json:"id"`
@@ -200,6 +378,37 @@ type ItemRead struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// ItemRevision defines model for ItemRevision.
+type ItemRevision struct {
+	ContentHash string    `json:"contentHash"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Revision    int32     `json:"revision"`
+	Title       string    `json:"title"`
+}
+
+// ItemSearchResult defines model for ItemSearchResult.
+type ItemSearchResult struct {
+	Item           Item    `json:"item"`
+	Score          float64 `json:"score"`
+	Snippet        string  `json:"snippet"`
+	TitleHighlight string  `json:"titleHighlight"`
+}
+
+// ItemStar defines model for ItemStar.
+type ItemStar struct {
+	IsStarred bool       `json:"isStarred"`
+	ItemId    string     `json:"itemId"`
+	Note      *string    `json:"note,omitempty"`
+	StarredAt *time.Time `json:"starredAt,omitempty"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	UserTags  []string   `json:"userTags"`
+}
+
+// ListCategoriesResponse defines model for ListCategoriesResponse.
+type ListCategoriesResponse struct {
+	Categories []CategoryFacet `json:"categories"`
+}
+
 // ListFeedIgnoreWindowsResponse defines model for ListFeedIgnoreWindowsResponse.
 type ListFeedIgnoreWindowsResponse struct {
 	FeedIgnoreWindows []FeedIgnoreWindow `json:"feedIgnoreWindows"`
@@ -210,6 +419,11 @@ type ListFeedTagsResponse struct {
 	FeedTags []FeedTag `json:"feedTags"`
 }
 
+// ListFeedTransportProfilesResponse defines model for ListFeedTransportProfilesResponse.
+type ListFeedTransportProfilesResponse struct {
+	FeedTransportProfiles []FeedTransportProfile `json:"feedTransportProfiles"`
+}
+
 // ListFeedsResponse defines model for ListFeedsResponse.
 type ListFeedsResponse struct {
 	Feeds []Feed `json:"feeds"`
@@ -231,23 +445,49 @@ type ListItemReadResponse struct {
 	NextPageToken string     `json:"nextPageToken"`
 }
 
+// ListItemRevisionsResponse defines model for ListItemRevisionsResponse.
+type ListItemRevisionsResponse struct {
+	Revisions []ItemRevision `json:"revisions"`
+}
+
+// ListItemStarsResponse defines model for ListItemStarsResponse.
+type ListItemStarsResponse struct {
+	ItemStars     []ItemStar `json:"itemStars"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
 // ListItemsResponse defines model for ListItemsResponse.
 type ListItemsResponse struct {
 	Items         []Item `json:"items"`
 	NextPageToken string `json:"nextPageToken"`
 }
 
+// ListSavedSearchesResponse defines model for ListSavedSearchesResponse.
+type ListSavedSearchesResponse struct {
+	SavedSearches []SavedSearch `json:"savedSearches"`
+}
+
 // ListTagIgnoreWindowsResponse defines model for ListTagIgnoreWindowsResponse.
 type ListTagIgnoreWindowsResponse struct {
 	TagIgnoreWindows []TagIgnoreWindow `json:"tagIgnoreWindows"`
 }
 
+// ListTagTransportProfilesResponse defines model for ListTagTransportProfilesResponse.
+type ListTagTransportProfilesResponse struct {
+	TagTransportProfiles []TagTransportProfile `json:"tagTransportProfiles"`
+}
+
 // ListTagsResponse defines model for ListTagsResponse.
 type ListTagsResponse struct {
 	Tags             []Tag  `json:"tags"`
 	TotalUnreadCount string `json:"totalUnreadCount"`
 }
 
+// ListTransportProfilesResponse defines model for ListTransportProfilesResponse.
+type ListTransportProfilesResponse struct {
+	TransportProfiles []TransportProfile `json:"transportProfiles"`
+}
+
 // ListURLParsingRulesResponse defines model for ListURLParsingRulesResponse.
 type ListURLParsingRulesResponse struct {
 	Rules []URLParsingRule `json:"rules"`
@@ -274,6 +514,18 @@ type ManageTagIgnoreWindowsRequest struct {
 	TagIds                []string `json:"tagIds"`
 }
 
+// PreviewScrapeRequest defines model for PreviewScrapeRequest.
+type PreviewScrapeRequest struct {
+	Selectors ScraperSelectors `json:"selectors"`
+	Url       string           `json:"url"`
+}
+
+// PreviewScrapeResponse defines model for PreviewScrapeResponse.
+type PreviewScrapeResponse struct {
+	Items []ScrapedItem `json:"items"`
+	Title string        `json:"title"`
+}
+
 // RefreshFeedsRequest defines model for RefreshFeedsRequest.
 type RefreshFeedsRequest struct {
 	Ids []string `json:"ids"`
@@ -284,6 +536,92 @@ type RefreshFeedsResponse struct {
 	Results []FeedFetchStatus `json:"results"`
 }
 
+// SavedSearch defines model for SavedSearch.
+type SavedSearch struct {
+	Author      *string    `json:"author,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	FeedIds     []string   `json:"feedIds"`
+	Id          string     `json:"id"`
+	IsRead      *bool      `json:"isRead,omitempty"`
+	IsStarred   *bool      `json:"isStarred,omitempty"`
+	MaxAgeDays  *int32     `json:"maxAgeDays,omitempty"`
+	Name        string     `json:"name"`
+	Query       *string    `json:"query,omitempty"`
+	Since       *time.Time `json:"since,omitempty"`
+	TagIds      []string   `json:"tagIds"`
+	UnreadCount string     `json:"unreadCount"`
+	Until       *time.Time `json:"until,omitempty"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// SavedSearchItemCounts defines model for SavedSearchItemCounts.
+type SavedSearchItemCounts struct {
+	SavedSearchId string `json:"savedSearchId"`
+	StarredCount  string `json:"starredCount"`
+	TotalCount    string `json:"totalCount"`
+	UnreadCount   string `json:"unreadCount"`
+}
+
+// ScrapedItem defines model for ScrapedItem.
+type ScrapedItem struct {
+	Content     string     `json:"content"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	Title       string     `json:"title"`
+	Url         string     `json:"url"`
+}
+
+// ScraperSelectors defines model for ScraperSelectors.
+type ScraperSelectors struct {
+	Content *string `json:"content,omitempty"`
+	Date    *string `json:"date,omitempty"`
+	Item    string  `json:"item"`
+	Link    *string `json:"link,omitempty"`
+	Title   *string `json:"title,omitempty"`
+}
+
+// SearchItemsResponse defines model for SearchItemsResponse.
+type SearchItemsResponse struct {
+	NextPageToken string             `json:"nextPageToken"`
+	Results       []ItemSearchResult `json:"results"`
+}
+
+// SetFeedCredentialsRequest defines model for SetFeedCredentialsRequest.
+type SetFeedCredentialsRequest struct {
+	AuthType string             `json:"authType"`
+	Headers  *map[string]string `json:"headers,omitempty"`
+	Password *string            `json:"password,omitempty"`
+	Token    *string            `json:"token,omitempty"`
+	Username *string            `json:"username,omitempty"`
+}
+
+// SetFeedScraperRequest defines model for SetFeedScraperRequest.
+type SetFeedScraperRequest struct {
+	Selectors ScraperSelectors `json:"selectors"`
+}
+
+// SetFeedsFullContentRequest defines model for SetFeedsFullContentRequest.
+type SetFeedsFullContentRequest struct {
+	Enabled bool     `json:"enabled"`
+	Ids     []string `json:"ids"`
+}
+
+// SetFeedsUnreadOnRevisionRequest defines model for SetFeedsUnreadOnRevisionRequest.
+type SetFeedsUnreadOnRevisionRequest struct {
+	Enabled bool     `json:"enabled"`
+	Ids     []string `json:"ids"`
+}
+
+// StarItemRequest defines model for StarItemRequest.
+type StarItemRequest struct {
+	Note     *string   `json:"note,omitempty"`
+	UserTags *[]string `json:"userTags,omitempty"`
+}
+
+// StarItemResponse defines model for StarItemResponse.
+type StarItemResponse struct {
+	ItemStar ItemStar `json:"itemStar"`
+}
+
 // SuspendFeedsRequest defines model for SuspendFeedsRequest.
 type SuspendFeedsRequest struct {
 	Ids            []string `json:"ids"`
@@ -306,6 +644,35 @@ type TagIgnoreWindow struct {
 	TagId          string `json:"tagId"`
 }
 
+// TagItemCounts defines model for TagItemCounts.
+type TagItemCounts struct {
+	StarredCount string `json:"starredCount"`
+	TagId        string `json:"tagId"`
+	TotalCount   string `json:"totalCount"`
+	UnreadCount  string `json:"unreadCount"`
+}
+
+// TagTransportProfile defines model for TagTransportProfile.
+type TagTransportProfile struct {
+	TagId              string `json:"tagId"`
+	TransportProfileId string `json:"transportProfileId"`
+}
+
+// TransportProfile defines model for TransportProfile.
+type TransportProfile struct {
+	CaBundle           *string   `json:"caBundle,omitempty"`
+	ClientCert         *string   `json:"clientCert,omitempty"`
+	CreatedAt          time.Time `json:"createdAt"`
+	HasClientKey       bool      `json:"hasClientKey"`
+	Id                 string    `json:"id"`
+	InsecureSkipVerify bool      `json:"insecureSkipVerify"`
+	Name               string    `json:"name"`
+	ProxyUrl           *string   `json:"proxyUrl,omitempty"`
+	RetryMax           *int32    `json:"retryMax,omitempty"`
+	TimeoutSeconds     *int32    `json:"timeoutSeconds,omitempty"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
 // URLParsingRule defines model for URLParsingRule.
 type URLParsingRule struct {
 	Domain   string `json:"domain"`
@@ -334,6 +701,54 @@ type UpdateItemStatusRequest struct {
 	IsRead *bool    `json:"isRead,omitempty"`
 }
 
+// UpdateSavedSearchRequest defines model for UpdateSavedSearchRequest.
+type UpdateSavedSearchRequest struct {
+	Author     *string    `json:"author,omitempty"`
+	FeedIds    *[]string  `json:"feedIds,omitempty"`
+	IsRead     *bool      `json:"isRead,omitempty"`
+	IsStarred  *bool      `json:"isStarred,omitempty"`
+	MaxAgeDays *int32     `json:"maxAgeDays,omitempty"`
+	Name       string     `json:"name"`
+	Query      *string    `json:"query,omitempty"`
+	Since      *time.Time `json:"since,omitempty"`
+	TagIds     *[]string  `json:"tagIds,omitempty"`
+	Until      *time.Time `json:"until,omitempty"`
+}
+
+// UpdateSavedSearchResponse defines model for UpdateSavedSearchResponse.
+type UpdateSavedSearchResponse struct {
+	SavedSearch SavedSearch `json:"savedSearch"`
+}
+
+// UpdateTransportProfileRequest defines model for UpdateTransportProfileRequest.
+type UpdateTransportProfileRequest struct {
+	CaBundle           *string `json:"caBundle,omitempty"`
+	ClientCert         *string `json:"clientCert,omitempty"`
+	ClientKey          *string `json:"clientKey,omitempty"`
+	InsecureSkipVerify *bool   `json:"insecureSkipVerify,omitempty"`
+	Name               string  `json:"name"`
+	ProxyUrl           *string `json:"proxyUrl,omitempty"`
+	RetryMax           *int32  `json:"retryMax,omitempty"`
+	TimeoutSeconds     *int32  `json:"timeoutSeconds,omitempty"`
+}
+
+// UpdateTransportProfileResponse defines model for UpdateTransportProfileResponse.
+type UpdateTransportProfileResponse struct {
+	TransportProfile TransportProfile `json:"transportProfile"`
+}
+
+// CategoriesListParams defines parameters for CategoriesList.
+type CategoriesListParams struct {
+	FeedId *string `form:"feedId,omitempty" json:"feedId,omitempty"`
+	TagId  *string `form:"tagId,omitempty" json:"tagId,omitempty"`
+	Limit  *int32  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CountsGetParams defines parameters for CountsGet.
+type CountsGetParams struct {
+	IfNoneMatch *string `json:"if-none-match,omitempty"`
+}
+
 // FeedIgnoreWindowsListParams defines parameters for FeedIgnoreWindowsList.
 type FeedIgnoreWindowsListParams struct {
 	FeedId         *string `form:"feedId,omitempty" json:"feedId,omitempty"`
@@ -346,6 +761,12 @@ type FeedTagsListParams struct {
 	TagId  *string `form:"tagId,omitempty" json:"tagId,omitempty"`
 }
 
+// FeedTransportProfilesListParams defines parameters for FeedTransportProfilesList.
+type FeedTransportProfilesListParams struct {
+	FeedId             *string `form:"feedId,omitempty" json:"feedId,omitempty"`
+	TransportProfileId *string `form:"transportProfileId,omitempty" json:"transportProfileId,omitempty"`
+}
+
 // FeedsListParams defines parameters for FeedsList.
 type FeedsListParams struct {
 	TagId *string `form:"tagId,omitempty" json:"tagId,omitempty"`
@@ -358,22 +779,72 @@ type ItemReadsListParams struct {
 	PageToken *string    `form:"pageToken,omitempty" json:"pageToken,omitempty"`
 }
 
-// ItemsListParams defines parameters for ItemsList.
-type ItemsListParams struct {
-	FeedId    *string    `form:"feedId,omitempty" json:"feedId,omitempty"`
-	IsRead    *bool      `form:"isRead,omitempty" json:"isRead,omitempty"`
-	TagId     *string    `form:"tagId,omitempty" json:"tagId,omitempty"`
+// ItemStarsListParams defines parameters for ItemStarsList.
+type ItemStarsListParams struct {
 	Since     *time.Time `form:"since,omitempty" json:"since,omitempty"`
 	PageSize  *int32     `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 	PageToken *string    `form:"pageToken,omitempty" json:"pageToken,omitempty"`
 }
 
+// ItemsListParams defines parameters for ItemsList.
+type ItemsListParams struct {
+	FeedId         *string    `form:"feedId,omitempty" json:"feedId,omitempty"`
+	FeedIds        *[]string  `form:"feedIds,omitempty" json:"feedIds,omitempty"`
+	IsRead         *bool      `form:"isRead,omitempty" json:"isRead,omitempty"`
+	IsStarred      *bool      `form:"isStarred,omitempty" json:"isStarred,omitempty"`
+	TagId          *string    `form:"tagId,omitempty" json:"tagId,omitempty"`
+	TagIds         *[]string  `form:"tagIds,omitempty" json:"tagIds,omitempty"`
+	Since          *time.Time `form:"since,omitempty" json:"since,omitempty"`
+	Until          *time.Time `form:"until,omitempty" json:"until,omitempty"`
+	PublishedSince *time.Time `form:"publishedSince,omitempty" json:"publishedSince,omitempty"`
+	PublishedUntil *time.Time `form:"publishedUntil,omitempty" json:"publishedUntil,omitempty"`
+	Author         *string    `form:"author,omitempty" json:"author,omitempty"`
+	Category       *string    `form:"category,omitempty" json:"category,omitempty"`
+	HasImage       *bool      `form:"hasImage,omitempty" json:"hasImage,omitempty"`
+	HasEnclosure   *bool      `form:"hasEnclosure,omitempty" json:"hasEnclosure,omitempty"`
+	MediaType      *string    `form:"mediaType,omitempty" json:"mediaType,omitempty"`
+	SavedSearchId  *string    `form:"savedSearchId,omitempty" json:"savedSearchId,omitempty"`
+	IncludeBlocked *bool      `form:"includeBlocked,omitempty" json:"includeBlocked,omitempty"`
+	Order          *string    `form:"order,omitempty" json:"order,omitempty"`
+	Format         *string    `form:"format,omitempty" json:"format,omitempty"`
+	PageSize       *int32     `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+	PageToken      *string    `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+}
+
+// ItemsSearchParams defines parameters for ItemsSearch.
+type ItemsSearchParams struct {
+	Q         string  `form:"q" json:"q"`
+	FeedId    *string `form:"feedId,omitempty" json:"feedId,omitempty"`
+	IsRead    *bool   `form:"isRead,omitempty" json:"isRead,omitempty"`
+	TagId     *string `form:"tagId,omitempty" json:"tagId,omitempty"`
+	Format    *string `form:"format,omitempty" json:"format,omitempty"`
+	PageSize  *int32  `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+}
+
+// ItemsGetParams defines parameters for ItemsGet.
+type ItemsGetParams struct {
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ItemsDiffRevisionsParams defines parameters for ItemsDiffRevisions.
+type ItemsDiffRevisionsParams struct {
+	From *int32 `form:"from,omitempty" json:"from,omitempty"`
+	To   *int32 `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // TagIgnoreWindowsListParams defines parameters for TagIgnoreWindowsList.
 type TagIgnoreWindowsListParams struct {
 	TagId          *string `form:"tagId,omitempty" json:"tagId,omitempty"`
 	IgnoreWindowId *string `form:"ignoreWindowId,omitempty" json:"ignoreWindowId,omitempty"`
 }
 
+// TagTransportProfilesListParams defines parameters for TagTransportProfilesList.
+type TagTransportProfilesListParams struct {
+	TagId              *string `form:"tagId,omitempty" json:"tagId,omitempty"`
+	TransportProfileId *string `form:"transportProfileId,omitempty" json:"transportProfileId,omitempty"`
+}
+
 // BlockRulesAddJSONRequestBody defines body for BlockRulesAdd for application/json ContentType.
 type BlockRulesAddJSONRequestBody = AddItemBlockRulesRequest
 
@@ -383,21 +854,42 @@ type FeedIgnoreWindowsManageJSONRequestBody = ManageFeedIgnoreWindowsRequest
 // FeedTagsManageJSONRequestBody defines body for FeedTagsManage for application/json ContentType.
 type FeedTagsManageJSONRequestBody = ManageFeedTagsRequest
 
+// FeedTransportProfilesAssignJSONRequestBody defines body for FeedTransportProfilesAssign for application/json ContentType.
+type FeedTransportProfilesAssignJSONRequestBody = AssignFeedTransportProfileRequest
+
 // FeedsCreateJSONRequestBody defines body for FeedsCreate for application/json ContentType.
 type FeedsCreateJSONRequestBody = CreateFeedRequest
 
 // FeedsExportOpmlJSONRequestBody defines body for FeedsExportOpml for application/json ContentType.
 type FeedsExportOpmlJSONRequestBody = ExportOpmlRequest
 
+// FeedsSetFullContentJSONRequestBody defines body for FeedsSetFullContent for application/json ContentType.
+type FeedsSetFullContentJSONRequestBody = SetFeedsFullContentRequest
+
 // FeedsImportOpmlJSONRequestBody defines body for FeedsImportOpml for application/json ContentType.
 type FeedsImportOpmlJSONRequestBody = ImportOpmlRequest
 
 // FeedsRefreshJSONRequestBody defines body for FeedsRefresh for application/json ContentType.
 type FeedsRefreshJSONRequestBody = RefreshFeedsRequest
 
+// FeedsCreateScrapedJSONRequestBody defines body for FeedsCreateScraped for application/json ContentType.
+type FeedsCreateScrapedJSONRequestBody = CreateScrapedFeedRequest
+
+// FeedsPreviewScrapeJSONRequestBody defines body for FeedsPreviewScrape for application/json ContentType.
+type FeedsPreviewScrapeJSONRequestBody = PreviewScrapeRequest
+
 // FeedsSuspendJSONRequestBody defines body for FeedsSuspend for application/json ContentType.
 type FeedsSuspendJSONRequestBody = SuspendFeedsRequest
 
+// FeedsSetUnreadOnRevisionJSONRequestBody defines body for FeedsSetUnreadOnRevision for application/json ContentType.
+type FeedsSetUnreadOnRevisionJSONRequestBody = SetFeedsUnreadOnRevisionRequest
+
+// FeedsSetCredentialsJSONRequestBody defines body for FeedsSetCredentials for application/json ContentType.
+type FeedsSetCredentialsJSONRequestBody = SetFeedCredentialsRequest
+
+// FeedsSetScraperJSONRequestBody defines body for FeedsSetScraper for application/json ContentType.
+type FeedsSetScraperJSONRequestBody = SetFeedScraperRequest
+
 // IgnoreWindowsCreateJSONRequestBody defines body for IgnoreWindowsCreate for application/json ContentType.
 type IgnoreWindowsCreateJSONRequestBody = CreateIgnoreWindowRequest
 
@@ -407,12 +899,30 @@ type IgnoreWindowsUpdateJSONRequestBody = UpdateIgnoreWindowRequest
 // ItemsUpdateStatusJSONRequestBody defines body for ItemsUpdateStatus for application/json ContentType.
 type ItemsUpdateStatusJSONRequestBody = UpdateItemStatusRequest
 
+// ItemsStarJSONRequestBody defines body for ItemsStar for application/json ContentType.
+type ItemsStarJSONRequestBody = StarItemRequest
+
+// SavedSearchesCreateJSONRequestBody defines body for SavedSearchesCreate for application/json ContentType.
+type SavedSearchesCreateJSONRequestBody = CreateSavedSearchRequest
+
+// SavedSearchesUpdateJSONRequestBody defines body for SavedSearchesUpdate for application/json ContentType.
+type SavedSearchesUpdateJSONRequestBody = UpdateSavedSearchRequest
+
 // TagIgnoreWindowsManageJSONRequestBody defines body for TagIgnoreWindowsManage for application/json ContentType.
 type TagIgnoreWindowsManageJSONRequestBody = ManageTagIgnoreWindowsRequest
 
+// TagTransportProfilesAssignJSONRequestBody defines body for TagTransportProfilesAssign for application/json ContentType.
+type TagTransportProfilesAssignJSONRequestBody = AssignTagTransportProfileRequest
+
 // TagsCreateJSONRequestBody defines body for TagsCreate for application/json ContentType.
 type TagsCreateJSONRequestBody = CreateTagRequest
 
+// TransportProfilesCreateJSONRequestBody defines body for TransportProfilesCreate for application/json ContentType.
+type TransportProfilesCreateJSONRequestBody = CreateTransportProfileRequest
+
+// TransportProfilesUpdateJSONRequestBody defines body for TransportProfilesUpdate for application/json ContentType.
+type TransportProfilesUpdateJSONRequestBody = UpdateTransportProfileRequest
+
 // URLRulesAddJSONRequestBody defines body for URLRulesAdd for application/json ContentType.
 type URLRulesAddJSONRequestBody = AddURLParsingRuleRequest
 
@@ -428,6 +938,12 @@ type ServerInterface interface {
 	// (DELETE /block-rules/{id})
 	BlockRulesDelete(w http.ResponseWriter, r *http.Request, id string)
 
+	// (GET /categories)
+	CategoriesList(w http.ResponseWriter, r *http.Request, params CategoriesListParams)
+
+	// (GET /counts)
+	CountsGet(w http.ResponseWriter, r *http.Request, params CountsGetParams)
+
 	// (GET /feed-ignore-windows)
 	FeedIgnoreWindowsList(w http.ResponseWriter, r *http.Request, params FeedIgnoreWindowsListParams)
 
@@ -440,6 +956,12 @@ type ServerInterface interface {
 	// (POST /feed-tags/manage)
 	FeedTagsManage(w http.ResponseWriter, r *http.Request)
 
+	// (GET /feed-transport-profiles)
+	FeedTransportProfilesList(w http.ResponseWriter, r *http.Request, params FeedTransportProfilesListParams)
+
+	// (POST /feed-transport-profiles/assign)
+	FeedTransportProfilesAssign(w http.ResponseWriter, r *http.Request)
+
 	// (GET /feeds)
 	FeedsList(w http.ResponseWriter, r *http.Request, params FeedsListParams)
 
@@ -449,18 +971,51 @@ type ServerInterface interface {
 	// (POST /feeds/export-opml)
 	FeedsExportOpml(w http.ResponseWriter, r *http.Request)
 
+	// (POST /feeds/full-content)
+	FeedsSetFullContent(w http.ResponseWriter, r *http.Request)
+
 	// (POST /feeds/import-opml)
 	FeedsImportOpml(w http.ResponseWriter, r *http.Request)
 
 	// (POST /feeds/refresh)
 	FeedsRefresh(w http.ResponseWriter, r *http.Request)
 
+	// (POST /feeds/scrape)
+	FeedsCreateScraped(w http.ResponseWriter, r *http.Request)
+
+	// (POST /feeds/scrape/preview)
+	FeedsPreviewScrape(w http.ResponseWriter, r *http.Request)
+
 	// (POST /feeds/suspend)
 	FeedsSuspend(w http.ResponseWriter, r *http.Request)
 
+	// (POST /feeds/unread-on-revision)
+	FeedsSetUnreadOnRevision(w http.ResponseWriter, r *http.Request)
+
 	// (DELETE /feeds/{id})
 	FeedsDelete(w http.ResponseWriter, r *http.Request, id string)
 
+	// (DELETE /feeds/{id}/credentials)
+	FeedsDeleteCredentials(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /feeds/{id}/credentials)
+	FeedsGetCredentials(w http.ResponseWriter, r *http.Request, id string)
+
+	// (PUT /feeds/{id}/credentials)
+	FeedsSetCredentials(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /feeds/{id}/icon)
+	FeedsGetIcon(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /feeds/{id}/scraper)
+	FeedsGetScraper(w http.ResponseWriter, r *http.Request, id string)
+
+	// (PUT /feeds/{id}/scraper)
+	FeedsSetScraper(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /fetch-queue)
+	FetchQueueGet(w http.ResponseWriter, r *http.Request)
+
 	// (GET /ignore-windows)
 	IgnoreWindowsList(w http.ResponseWriter, r *http.Request)
 
@@ -476,14 +1031,50 @@ type ServerInterface interface {
 	// (GET /item-reads)
 	ItemReadsList(w http.ResponseWriter, r *http.Request, params ItemReadsListParams)
 
+	// (GET /item-stars)
+	ItemStarsList(w http.ResponseWriter, r *http.Request, params ItemStarsListParams)
+
 	// (GET /items)
 	ItemsList(w http.ResponseWriter, r *http.Request, params ItemsListParams)
 
+	// (GET /items/search)
+	ItemsSearch(w http.ResponseWriter, r *http.Request, params ItemsSearchParams)
+
 	// (POST /items/status)
 	ItemsUpdateStatus(w http.ResponseWriter, r *http.Request)
 
 	// (GET /items/{id})
-	ItemsGet(w http.ResponseWriter, r *http.Request, id string)
+	ItemsGet(w http.ResponseWriter, r *http.Request, id string, params ItemsGetParams)
+
+	// (POST /items/{id}/extract-content)
+	ItemsExtractContent(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /items/{id}/revisions)
+	ItemsListRevisions(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /items/{id}/revisions/diff)
+	ItemsDiffRevisions(w http.ResponseWriter, r *http.Request, id string, params ItemsDiffRevisionsParams)
+
+	// (DELETE /items/{id}/star)
+	ItemsUnstar(w http.ResponseWriter, r *http.Request, id string)
+
+	// (PUT /items/{id}/star)
+	ItemsStar(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /saved-searches)
+	SavedSearchesList(w http.ResponseWriter, r *http.Request)
+
+	// (POST /saved-searches)
+	SavedSearchesCreate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /saved-searches/{id})
+	SavedSearchesDelete(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /saved-searches/{id})
+	SavedSearchesGet(w http.ResponseWriter, r *http.Request, id string)
+
+	// (PUT /saved-searches/{id})
+	SavedSearchesUpdate(w http.ResponseWriter, r *http.Request, id string)
 
 	// (GET /tag-ignore-windows)
 	TagIgnoreWindowsList(w http.ResponseWriter, r *http.Request, params TagIgnoreWindowsListParams)
@@ -491,6 +1082,12 @@ type ServerInterface interface {
 	// (POST /tag-ignore-windows/manage)
 	TagIgnoreWindowsManage(w http.ResponseWriter, r *http.Request)
 
+	// (GET /tag-transport-profiles)
+	TagTransportProfilesList(w http.ResponseWriter, r *http.Request, params TagTransportProfilesListParams)
+
+	// (POST /tag-transport-profiles/assign)
+	TagTransportProfilesAssign(w http.ResponseWriter, r *http.Request)
+
 	// (GET /tags)
 	TagsList(w http.ResponseWriter, r *http.Request)
 
@@ -500,6 +1097,18 @@ type ServerInterface interface {
 	// (DELETE /tags/{id})
 	TagsDelete(w http.ResponseWriter, r *http.Request, id string)
 
+	// (GET /transport-profiles)
+	TransportProfilesList(w http.ResponseWriter, r *http.Request)
+
+	// (POST /transport-profiles)
+	TransportProfilesCreate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /transport-profiles/{id})
+	TransportProfilesDelete(w http.ResponseWriter, r *http.Request, id string)
+
+	// (PUT /transport-profiles/{id})
+	TransportProfilesUpdate(w http.ResponseWriter, r *http.Request, id string)
+
 	// (GET /url-rules)
 	URLRulesList(w http.ResponseWriter, r *http.Request)
 
@@ -573,14 +1182,14 @@ func (siw *ServerInterfaceWrapper) BlockRulesDelete(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// FeedIgnoreWindowsList operation middleware
-func (siw *ServerInterfaceWrapper) FeedIgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
+// CategoriesList operation middleware
+func (siw *ServerInterfaceWrapper) CategoriesList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FeedIgnoreWindowsListParams
+	var params CategoriesListParams
 
 	// ------------- Optional query parameter "feedId" -------------
 
@@ -595,21 +1204,34 @@ func (siw *ServerInterfaceWrapper) FeedIgnoreWindowsList(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Optional query parameter "ignoreWindowId" -------------
+	// ------------- Optional query parameter "tagId" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "ignoreWindowId", r.URL.Query(), &params.IgnoreWindowId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ignoreWindowId"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ignoreWindowId", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		}
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FeedIgnoreWindowsList(w, r, params)
+		siw.Handler.CategoriesList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -619,11 +1241,38 @@ func (siw *ServerInterfaceWrapper) FeedIgnoreWindowsList(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// FeedIgnoreWindowsManage operation middleware
-func (siw *ServerInterfaceWrapper) FeedIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
+// CountsGet operation middleware
+func (siw *ServerInterfaceWrapper) CountsGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CountsGetParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "if-none-match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("if-none-match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "if-none-match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "if-none-match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "if-none-match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FeedIgnoreWindowsManage(w, r)
+		siw.Handler.CountsGet(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -633,14 +1282,14 @@ func (siw *ServerInterfaceWrapper) FeedIgnoreWindowsManage(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// FeedTagsList operation middleware
-func (siw *ServerInterfaceWrapper) FeedTagsList(w http.ResponseWriter, r *http.Request) {
+// FeedIgnoreWindowsList operation middleware
+func (siw *ServerInterfaceWrapper) FeedIgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FeedTagsListParams
+	var params FeedIgnoreWindowsListParams
 
 	// ------------- Optional query parameter "feedId" -------------
 
@@ -655,21 +1304,21 @@ func (siw *ServerInterfaceWrapper) FeedTagsList(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Optional query parameter "tagId" -------------
+	// ------------- Optional query parameter "ignoreWindowId" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "ignoreWindowId", r.URL.Query(), &params.IgnoreWindowId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ignoreWindowId"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ignoreWindowId", Err: err})
 		}
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FeedTagsList(w, r, params)
+		siw.Handler.FeedIgnoreWindowsList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -679,11 +1328,11 @@ func (siw *ServerInterfaceWrapper) FeedTagsList(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// FeedTagsManage operation middleware
-func (siw *ServerInterfaceWrapper) FeedTagsManage(w http.ResponseWriter, r *http.Request) {
+// FeedIgnoreWindowsManage operation middleware
+func (siw *ServerInterfaceWrapper) FeedIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FeedTagsManage(w, r)
+		siw.Handler.FeedIgnoreWindowsManage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -693,14 +1342,27 @@ func (siw *ServerInterfaceWrapper) FeedTagsManage(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// FeedsList operation middleware
-func (siw *ServerInterfaceWrapper) FeedsList(w http.ResponseWriter, r *http.Request) {
+// FeedTagsList operation middleware
+func (siw *ServerInterfaceWrapper) FeedTagsList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FeedsListParams
+	var params FeedTagsListParams
+
+	// ------------- Optional query parameter "feedId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "feedId", r.URL.Query(), &params.FeedId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "feedId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedId", Err: err})
+		}
+		return
+	}
 
 	// ------------- Optional query parameter "tagId" -------------
 
@@ -716,7 +1378,7 @@ func (siw *ServerInterfaceWrapper) FeedsList(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FeedsList(w, r, params)
+		siw.Handler.FeedTagsList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -726,11 +1388,11 @@ func (siw *ServerInterfaceWrapper) FeedsList(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// FeedsCreate operation middleware
-func (siw *ServerInterfaceWrapper) FeedsCreate(w http.ResponseWriter, r *http.Request) {
+// FeedTagsManage operation middleware
+func (siw *ServerInterfaceWrapper) FeedTagsManage(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FeedsCreate(w, r)
+		siw.Handler.FeedTagsManage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -740,8 +1402,115 @@ func (siw *ServerInterfaceWrapper) FeedsCreate(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// FeedsExportOpml operation middleware
-func (siw *ServerInterfaceWrapper) FeedsExportOpml(w http.ResponseWriter, r *http.Request) {
+// FeedTransportProfilesList operation middleware
+func (siw *ServerInterfaceWrapper) FeedTransportProfilesList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FeedTransportProfilesListParams
+
+	// ------------- Optional query parameter "feedId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "feedId", r.URL.Query(), &params.FeedId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "feedId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "transportProfileId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "transportProfileId", r.URL.Query(), &params.TransportProfileId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "transportProfileId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transportProfileId", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedTransportProfilesList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeedTransportProfilesAssign operation middleware
+func (siw *ServerInterfaceWrapper) FeedTransportProfilesAssign(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedTransportProfilesAssign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeedsList operation middleware
+func (siw *ServerInterfaceWrapper) FeedsList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FeedsListParams
+
+	// ------------- Optional query parameter "tagId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeedsCreate operation middleware
+func (siw *ServerInterfaceWrapper) FeedsCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeedsExportOpml operation middleware
+func (siw *ServerInterfaceWrapper) FeedsExportOpml(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.FeedsExportOpml(w, r)
@@ -754,6 +1523,20 @@ func (siw *ServerInterfaceWrapper) FeedsExportOpml(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// FeedsSetFullContent operation middleware
+func (siw *ServerInterfaceWrapper) FeedsSetFullContent(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsSetFullContent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // FeedsImportOpml operation middleware
 func (siw *ServerInterfaceWrapper) FeedsImportOpml(w http.ResponseWriter, r *http.Request) {
 
@@ -782,6 +1565,34 @@ func (siw *ServerInterfaceWrapper) FeedsRefresh(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// FeedsCreateScraped operation middleware
+func (siw *ServerInterfaceWrapper) FeedsCreateScraped(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsCreateScraped(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeedsPreviewScrape operation middleware
+func (siw *ServerInterfaceWrapper) FeedsPreviewScrape(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsPreviewScrape(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // FeedsSuspend operation middleware
 func (siw *ServerInterfaceWrapper) FeedsSuspend(w http.ResponseWriter, r *http.Request) {
 
@@ -796,6 +1607,20 @@ func (siw *ServerInterfaceWrapper) FeedsSuspend(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// FeedsSetUnreadOnRevision operation middleware
+func (siw *ServerInterfaceWrapper) FeedsSetUnreadOnRevision(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsSetUnreadOnRevision(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // FeedsDelete operation middleware
 func (siw *ServerInterfaceWrapper) FeedsDelete(w http.ResponseWriter, r *http.Request) {
 
@@ -822,25 +1647,23 @@ func (siw *ServerInterfaceWrapper) FeedsDelete(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// IgnoreWindowsList operation middleware
-func (siw *ServerInterfaceWrapper) IgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.IgnoreWindowsList(w, r)
-	}))
+// FeedsDeleteCredentials operation middleware
+func (siw *ServerInterfaceWrapper) FeedsDeleteCredentials(w http.ResponseWriter, r *http.Request) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	var err error
+	_ = err
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// IgnoreWindowsCreate operation middleware
-func (siw *ServerInterfaceWrapper) IgnoreWindowsCreate(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.IgnoreWindowsCreate(w, r)
+		siw.Handler.FeedsDeleteCredentials(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -850,8 +1673,8 @@ func (siw *ServerInterfaceWrapper) IgnoreWindowsCreate(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// IgnoreWindowsDelete operation middleware
-func (siw *ServerInterfaceWrapper) IgnoreWindowsDelete(w http.ResponseWriter, r *http.Request) {
+// FeedsGetCredentials operation middleware
+func (siw *ServerInterfaceWrapper) FeedsGetCredentials(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -866,7 +1689,7 @@ func (siw *ServerInterfaceWrapper) IgnoreWindowsDelete(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.IgnoreWindowsDelete(w, r, id)
+		siw.Handler.FeedsGetCredentials(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -876,8 +1699,8 @@ func (siw *ServerInterfaceWrapper) IgnoreWindowsDelete(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// IgnoreWindowsUpdate operation middleware
-func (siw *ServerInterfaceWrapper) IgnoreWindowsUpdate(w http.ResponseWriter, r *http.Request) {
+// FeedsSetCredentials operation middleware
+func (siw *ServerInterfaceWrapper) FeedsSetCredentials(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -892,7 +1715,7 @@ func (siw *ServerInterfaceWrapper) IgnoreWindowsUpdate(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.IgnoreWindowsUpdate(w, r, id)
+		siw.Handler.FeedsSetCredentials(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -902,56 +1725,49 @@ func (siw *ServerInterfaceWrapper) IgnoreWindowsUpdate(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ItemReadsList operation middleware
-func (siw *ServerInterfaceWrapper) ItemReadsList(w http.ResponseWriter, r *http.Request) {
+// FeedsGetIcon operation middleware
+func (siw *ServerInterfaceWrapper) FeedsGetIcon(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ItemReadsListParams
-
-	// ------------- Optional query parameter "since" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsGetIcon(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageSize", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
-	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		}
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "pageToken" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageToken", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+// FeedsGetScraper operation middleware
+func (siw *ServerInterfaceWrapper) FeedsGetScraper(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageToken"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemReadsList(w, r, params)
+		siw.Handler.FeedsGetScraper(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -961,95 +1777,65 @@ func (siw *ServerInterfaceWrapper) ItemReadsList(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ItemsList operation middleware
-func (siw *ServerInterfaceWrapper) ItemsList(w http.ResponseWriter, r *http.Request) {
+// FeedsSetScraper operation middleware
+func (siw *ServerInterfaceWrapper) FeedsSetScraper(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ItemsListParams
-
-	// ------------- Optional query parameter "feedId" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "feedId", r.URL.Query(), &params.FeedId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "feedId"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedId", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "isRead" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeedsSetScraper(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "isRead", r.URL.Query(), &params.IsRead, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
-	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "isRead"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isRead", Err: err})
-		}
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "tagId" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
-		}
-		return
-	}
+// FetchQueueGet operation middleware
+func (siw *ServerInterfaceWrapper) FetchQueueGet(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "since" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FetchQueueGet(w, r)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
-	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
-		}
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageSize", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
-	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		}
-		return
-	}
+// IgnoreWindowsList operation middleware
+func (siw *ServerInterfaceWrapper) IgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "pageToken" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IgnoreWindowsList(w, r)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageToken", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageToken"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
-		}
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// IgnoreWindowsCreate operation middleware
+func (siw *ServerInterfaceWrapper) IgnoreWindowsCreate(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsList(w, r, params)
+		siw.Handler.IgnoreWindowsCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1059,11 +1845,23 @@ func (siw *ServerInterfaceWrapper) ItemsList(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ItemsUpdateStatus operation middleware
-func (siw *ServerInterfaceWrapper) ItemsUpdateStatus(w http.ResponseWriter, r *http.Request) {
+// IgnoreWindowsDelete operation middleware
+func (siw *ServerInterfaceWrapper) IgnoreWindowsDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsUpdateStatus(w, r)
+		siw.Handler.IgnoreWindowsDelete(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1073,8 +1871,8 @@ func (siw *ServerInterfaceWrapper) ItemsUpdateStatus(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ItemsGet operation middleware
-func (siw *ServerInterfaceWrapper) ItemsGet(w http.ResponseWriter, r *http.Request) {
+// IgnoreWindowsUpdate operation middleware
+func (siw *ServerInterfaceWrapper) IgnoreWindowsUpdate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -1089,7 +1887,7 @@ func (siw *ServerInterfaceWrapper) ItemsGet(w http.ResponseWriter, r *http.Reque
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsGet(w, r, id)
+		siw.Handler.IgnoreWindowsUpdate(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1099,85 +1897,56 @@ func (siw *ServerInterfaceWrapper) ItemsGet(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// TagIgnoreWindowsList operation middleware
-func (siw *ServerInterfaceWrapper) TagIgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
+// ItemReadsList operation middleware
+func (siw *ServerInterfaceWrapper) ItemReadsList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TagIgnoreWindowsListParams
+	var params ItemReadsListParams
 
-	// ------------- Optional query parameter "tagId" -------------
+	// ------------- Optional query parameter "since" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
 		}
 		return
 	}
 
-	// ------------- Optional query parameter "ignoreWindowId" -------------
+	// ------------- Optional query parameter "pageSize" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", false, false, "ignoreWindowId", r.URL.Query(), &params.IgnoreWindowId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageSize", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ignoreWindowId"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ignoreWindowId", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
 		}
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TagIgnoreWindowsList(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// TagIgnoreWindowsManage operation middleware
-func (siw *ServerInterfaceWrapper) TagIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TagIgnoreWindowsManage(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// TagsList operation middleware
-func (siw *ServerInterfaceWrapper) TagsList(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TagsList(w, r)
-	}))
+	// ------------- Optional query parameter "pageToken" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageToken", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageToken"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		}
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// TagsCreate operation middleware
-func (siw *ServerInterfaceWrapper) TagsCreate(w http.ResponseWriter, r *http.Request) {
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TagsCreate(w, r)
+		siw.Handler.ItemReadsList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1187,51 +1956,56 @@ func (siw *ServerInterfaceWrapper) TagsCreate(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// TagsDelete operation middleware
-func (siw *ServerInterfaceWrapper) TagsDelete(w http.ResponseWriter, r *http.Request) {
+// ItemStarsList operation middleware
+func (siw *ServerInterfaceWrapper) ItemStarsList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemStarsListParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		}
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TagsDelete(w, r, id)
-	}))
+	// ------------- Optional query parameter "pageSize" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageSize", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		}
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// URLRulesList operation middleware
-func (siw *ServerInterfaceWrapper) URLRulesList(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.URLRulesList(w, r)
-	}))
+	// ------------- Optional query parameter "pageToken" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageToken", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageToken"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		}
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// URLRulesAdd operation middleware
-func (siw *ServerInterfaceWrapper) URLRulesAdd(w http.ResponseWriter, r *http.Request) {
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.URLRulesAdd(w, r)
+		siw.Handler.ItemStarsList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1241,196 +2015,3023 @@ func (siw *ServerInterfaceWrapper) URLRulesAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// URLRulesDelete operation middleware
-func (siw *ServerInterfaceWrapper) URLRulesDelete(w http.ResponseWriter, r *http.Request) {
+// ItemsList operation middleware
+func (siw *ServerInterfaceWrapper) ItemsList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsListParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	// ------------- Optional query parameter "feedId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "feedId", r.URL.Query(), &params.FeedId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "feedId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedId", Err: err})
+		}
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.URLRulesDelete(w, r, id)
-	}))
+	// ------------- Optional query parameter "feedIds" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "feedIds", r.URL.Query(), &params.FeedIds, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "feedIds"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedIds", Err: err})
+		}
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "isRead" -------------
 
-func (e *UnescapedCookieParamError) Error() string {
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "isRead", r.URL.Query(), &params.IsRead, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "isRead"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isRead", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "isStarred" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "isStarred", r.URL.Query(), &params.IsStarred, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "isStarred"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isStarred", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "tagId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "tagIds" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagIds", r.URL.Query(), &params.TagIds, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagIds"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagIds", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "until", r.URL.Query(), &params.Until, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "until"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "publishedSince" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "publishedSince", r.URL.Query(), &params.PublishedSince, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "publishedSince"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishedSince", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "publishedUntil" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "publishedUntil", r.URL.Query(), &params.PublishedUntil, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "publishedUntil"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishedUntil", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "author" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "author", r.URL.Query(), &params.Author, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "author"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "category", r.URL.Query(), &params.Category, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "category"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "hasImage" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "hasImage", r.URL.Query(), &params.HasImage, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "hasImage"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hasImage", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "hasEnclosure" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "hasEnclosure", r.URL.Query(), &params.HasEnclosure, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "hasEnclosure"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hasEnclosure", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "mediaType" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "mediaType", r.URL.Query(), &params.MediaType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "mediaType"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mediaType", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "savedSearchId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "savedSearchId", r.URL.Query(), &params.SavedSearchId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "savedSearchId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "savedSearchId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "includeBlocked" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "includeBlocked", r.URL.Query(), &params.IncludeBlocked, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "includeBlocked"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeBlocked", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageSize", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "pageToken" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageToken", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageToken"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsSearch operation middleware
+func (siw *ServerInterfaceWrapper) ItemsSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, true, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "feedId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "feedId", r.URL.Query(), &params.FeedId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "feedId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "isRead" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "isRead", r.URL.Query(), &params.IsRead, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "isRead"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isRead", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "tagId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageSize", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "pageToken" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "pageToken", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageToken"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsSearch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsUpdateStatus operation middleware
+func (siw *ServerInterfaceWrapper) ItemsUpdateStatus(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsUpdateStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsGet operation middleware
+func (siw *ServerInterfaceWrapper) ItemsGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsGetParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsGet(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsExtractContent operation middleware
+func (siw *ServerInterfaceWrapper) ItemsExtractContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsExtractContent(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsListRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ItemsListRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsListRevisions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsDiffRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ItemsDiffRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsDiffRevisionsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsDiffRevisions(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsUnstar operation middleware
+func (siw *ServerInterfaceWrapper) ItemsUnstar(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsUnstar(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ItemsStar operation middleware
+func (siw *ServerInterfaceWrapper) ItemsStar(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsStar(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SavedSearchesList operation middleware
+func (siw *ServerInterfaceWrapper) SavedSearchesList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SavedSearchesList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SavedSearchesCreate operation middleware
+func (siw *ServerInterfaceWrapper) SavedSearchesCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SavedSearchesCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SavedSearchesDelete operation middleware
+func (siw *ServerInterfaceWrapper) SavedSearchesDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SavedSearchesDelete(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SavedSearchesGet operation middleware
+func (siw *ServerInterfaceWrapper) SavedSearchesGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SavedSearchesGet(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SavedSearchesUpdate operation middleware
+func (siw *ServerInterfaceWrapper) SavedSearchesUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SavedSearchesUpdate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TagIgnoreWindowsList operation middleware
+func (siw *ServerInterfaceWrapper) TagIgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TagIgnoreWindowsListParams
+
+	// ------------- Optional query parameter "tagId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "ignoreWindowId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "ignoreWindowId", r.URL.Query(), &params.IgnoreWindowId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ignoreWindowId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ignoreWindowId", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TagIgnoreWindowsList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TagIgnoreWindowsManage operation middleware
+func (siw *ServerInterfaceWrapper) TagIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TagIgnoreWindowsManage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TagTransportProfilesList operation middleware
+func (siw *ServerInterfaceWrapper) TagTransportProfilesList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TagTransportProfilesListParams
+
+	// ------------- Optional query parameter "tagId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "tagId", r.URL.Query(), &params.TagId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "transportProfileId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "transportProfileId", r.URL.Query(), &params.TransportProfileId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "transportProfileId"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transportProfileId", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TagTransportProfilesList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TagTransportProfilesAssign operation middleware
+func (siw *ServerInterfaceWrapper) TagTransportProfilesAssign(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TagTransportProfilesAssign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TagsList operation middleware
+func (siw *ServerInterfaceWrapper) TagsList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TagsList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TagsCreate operation middleware
+func (siw *ServerInterfaceWrapper) TagsCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TagsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TagsDelete operation middleware
+func (siw *ServerInterfaceWrapper) TagsDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TagsDelete(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransportProfilesList operation middleware
+func (siw *ServerInterfaceWrapper) TransportProfilesList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransportProfilesList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransportProfilesCreate operation middleware
+func (siw *ServerInterfaceWrapper) TransportProfilesCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransportProfilesCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransportProfilesDelete operation middleware
+func (siw *ServerInterfaceWrapper) TransportProfilesDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransportProfilesDelete(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransportProfilesUpdate operation middleware
+func (siw *ServerInterfaceWrapper) TransportProfilesUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransportProfilesUpdate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// URLRulesList operation middleware
+func (siw *ServerInterfaceWrapper) URLRulesList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.URLRulesList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// URLRulesAdd operation middleware
+func (siw *ServerInterfaceWrapper) URLRulesAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.URLRulesAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// URLRulesDelete operation middleware
+func (siw *ServerInterfaceWrapper) URLRulesDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.URLRulesDelete(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
 	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of [http.ServeMux].
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	http.Handler
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/block-rules", wrapper.BlockRulesList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/block-rules", wrapper.BlockRulesAdd)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/block-rules/{id}", wrapper.BlockRulesDelete)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/categories", wrapper.CategoriesList)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/counts", wrapper.CountsGet)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feed-ignore-windows", wrapper.FeedIgnoreWindowsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feed-ignore-windows/manage", wrapper.FeedIgnoreWindowsManage)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feed-tags", wrapper.FeedTagsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feed-tags/manage", wrapper.FeedTagsManage)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feed-transport-profiles", wrapper.FeedTransportProfilesList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feed-transport-profiles/assign", wrapper.FeedTransportProfilesAssign)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feeds", wrapper.FeedsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds", wrapper.FeedsCreate)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/export-opml", wrapper.FeedsExportOpml)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/full-content", wrapper.FeedsSetFullContent)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/import-opml", wrapper.FeedsImportOpml)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/refresh", wrapper.FeedsRefresh)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/scrape", wrapper.FeedsCreateScraped)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/scrape/preview", wrapper.FeedsPreviewScrape)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/suspend", wrapper.FeedsSuspend)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/unread-on-revision", wrapper.FeedsSetUnreadOnRevision)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/feeds/{id}", wrapper.FeedsDelete)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/feeds/{id}/credentials", wrapper.FeedsDeleteCredentials)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feeds/{id}/credentials", wrapper.FeedsGetCredentials)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/feeds/{id}/credentials", wrapper.FeedsSetCredentials)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feeds/{id}/icon", wrapper.FeedsGetIcon)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feeds/{id}/scraper", wrapper.FeedsGetScraper)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/feeds/{id}/scraper", wrapper.FeedsSetScraper)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/fetch-queue", wrapper.FetchQueueGet)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/ignore-windows", wrapper.IgnoreWindowsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/ignore-windows", wrapper.IgnoreWindowsCreate)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/ignore-windows/{id}", wrapper.IgnoreWindowsDelete)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/ignore-windows/{id}", wrapper.IgnoreWindowsUpdate)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/item-reads", wrapper.ItemReadsList)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/item-stars", wrapper.ItemStarsList)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/items", wrapper.ItemsList)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/items/search", wrapper.ItemsSearch)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/items/status", wrapper.ItemsUpdateStatus)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/items/{id}", wrapper.ItemsGet)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/items/{id}/extract-content", wrapper.ItemsExtractContent)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/items/{id}/revisions", wrapper.ItemsListRevisions)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/items/{id}/revisions/diff", wrapper.ItemsDiffRevisions)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/items/{id}/star", wrapper.ItemsUnstar)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/items/{id}/star", wrapper.ItemsStar)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/saved-searches", wrapper.SavedSearchesList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/saved-searches", wrapper.SavedSearchesCreate)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/saved-searches/{id}", wrapper.SavedSearchesDelete)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/saved-searches/{id}", wrapper.SavedSearchesGet)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/saved-searches/{id}", wrapper.SavedSearchesUpdate)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/tag-ignore-windows", wrapper.TagIgnoreWindowsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tag-ignore-windows/manage", wrapper.TagIgnoreWindowsManage)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/tag-transport-profiles", wrapper.TagTransportProfilesList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tag-transport-profiles/assign", wrapper.TagTransportProfilesAssign)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/tags", wrapper.TagsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tags", wrapper.TagsCreate)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/tags/{id}", wrapper.TagsDelete)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/transport-profiles", wrapper.TransportProfilesList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/transport-profiles", wrapper.TransportProfilesCreate)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/transport-profiles/{id}", wrapper.TransportProfilesDelete)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/transport-profiles/{id}", wrapper.TransportProfilesUpdate)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/url-rules", wrapper.URLRulesList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/url-rules", wrapper.URLRulesAdd)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/url-rules/{id}", wrapper.URLRulesDelete)
+
+	return m
+}
+
+type BlockRulesListRequestObject struct {
+}
+
+type BlockRulesListResponseObject interface {
+	VisitBlockRulesListResponse(w http.ResponseWriter) error
+}
+
+type BlockRulesList200JSONResponse ListItemBlockRulesResponse
+
+func (response BlockRulesList200JSONResponse) VisitBlockRulesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type BlockRulesList500JSONResponse ApiError
+
+func (response BlockRulesList500JSONResponse) VisitBlockRulesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type BlockRulesAddRequestObject struct {
+	Body *BlockRulesAddJSONRequestBody
+}
+
+type BlockRulesAddResponseObject interface {
+	VisitBlockRulesAddResponse(w http.ResponseWriter) error
+}
+
+type BlockRulesAdd200Response struct {
+}
+
+func (response BlockRulesAdd200Response) VisitBlockRulesAddResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type BlockRulesAdd500JSONResponse ApiError
+
+func (response BlockRulesAdd500JSONResponse) VisitBlockRulesAddResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type BlockRulesDeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type BlockRulesDeleteResponseObject interface {
+	VisitBlockRulesDeleteResponse(w http.ResponseWriter) error
+}
+
+type BlockRulesDelete200Response struct {
+}
+
+func (response BlockRulesDelete200Response) VisitBlockRulesDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type BlockRulesDelete500JSONResponse ApiError
+
+func (response BlockRulesDelete500JSONResponse) VisitBlockRulesDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CategoriesListRequestObject struct {
+	Params CategoriesListParams
+}
+
+type CategoriesListResponseObject interface {
+	VisitCategoriesListResponse(w http.ResponseWriter) error
+}
+
+type CategoriesList200JSONResponse ListCategoriesResponse
+
+func (response CategoriesList200JSONResponse) VisitCategoriesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CategoriesList500JSONResponse ApiError
+
+func (response CategoriesList500JSONResponse) VisitCategoriesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CountsGetRequestObject struct {
+	Params CountsGetParams
+}
+
+type CountsGetResponseObject interface {
+	VisitCountsGetResponse(w http.ResponseWriter) error
+}
+
+type CountsGet200ResponseHeaders struct {
+	Etag string
+}
+
+type CountsGet200JSONResponse struct {
+	Body    CountsResponse
+	Headers CountsGet200ResponseHeaders
+}
+
+func (response CountsGet200JSONResponse) VisitCountsGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("etag", fmt.Sprint(response.Headers.Etag))
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CountsGet304ResponseHeaders struct {
+	Etag string
+}
+
+type CountsGet304Response struct {
+	Headers CountsGet304ResponseHeaders
+}
+
+func (response CountsGet304Response) VisitCountsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("etag", fmt.Sprint(response.Headers.Etag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type CountsGet500JSONResponse ApiError
+
+func (response CountsGet500JSONResponse) VisitCountsGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedIgnoreWindowsListRequestObject struct {
+	Params FeedIgnoreWindowsListParams
+}
+
+type FeedIgnoreWindowsListResponseObject interface {
+	VisitFeedIgnoreWindowsListResponse(w http.ResponseWriter) error
+}
+
+type FeedIgnoreWindowsList200JSONResponse ListFeedIgnoreWindowsResponse
+
+func (response FeedIgnoreWindowsList200JSONResponse) VisitFeedIgnoreWindowsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedIgnoreWindowsList500JSONResponse ApiError
+
+func (response FeedIgnoreWindowsList500JSONResponse) VisitFeedIgnoreWindowsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedIgnoreWindowsManageRequestObject struct {
+	Body *FeedIgnoreWindowsManageJSONRequestBody
+}
+
+type FeedIgnoreWindowsManageResponseObject interface {
+	VisitFeedIgnoreWindowsManageResponse(w http.ResponseWriter) error
+}
+
+type FeedIgnoreWindowsManage200Response struct {
+}
+
+func (response FeedIgnoreWindowsManage200Response) VisitFeedIgnoreWindowsManageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedIgnoreWindowsManage500JSONResponse ApiError
+
+func (response FeedIgnoreWindowsManage500JSONResponse) VisitFeedIgnoreWindowsManageResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedTagsListRequestObject struct {
+	Params FeedTagsListParams
+}
+
+type FeedTagsListResponseObject interface {
+	VisitFeedTagsListResponse(w http.ResponseWriter) error
+}
+
+type FeedTagsList200JSONResponse ListFeedTagsResponse
+
+func (response FeedTagsList200JSONResponse) VisitFeedTagsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedTagsList500JSONResponse ApiError
+
+func (response FeedTagsList500JSONResponse) VisitFeedTagsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedTagsManageRequestObject struct {
+	Body *FeedTagsManageJSONRequestBody
+}
+
+type FeedTagsManageResponseObject interface {
+	VisitFeedTagsManageResponse(w http.ResponseWriter) error
+}
+
+type FeedTagsManage200Response struct {
+}
+
+func (response FeedTagsManage200Response) VisitFeedTagsManageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedTagsManage500JSONResponse ApiError
+
+func (response FeedTagsManage500JSONResponse) VisitFeedTagsManageResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedTransportProfilesListRequestObject struct {
+	Params FeedTransportProfilesListParams
+}
+
+type FeedTransportProfilesListResponseObject interface {
+	VisitFeedTransportProfilesListResponse(w http.ResponseWriter) error
+}
+
+type FeedTransportProfilesList200JSONResponse ListFeedTransportProfilesResponse
+
+func (response FeedTransportProfilesList200JSONResponse) VisitFeedTransportProfilesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedTransportProfilesList500JSONResponse ApiError
+
+func (response FeedTransportProfilesList500JSONResponse) VisitFeedTransportProfilesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedTransportProfilesAssignRequestObject struct {
+	Body *FeedTransportProfilesAssignJSONRequestBody
+}
+
+type FeedTransportProfilesAssignResponseObject interface {
+	VisitFeedTransportProfilesAssignResponse(w http.ResponseWriter) error
+}
+
+type FeedTransportProfilesAssign200Response struct {
+}
+
+func (response FeedTransportProfilesAssign200Response) VisitFeedTransportProfilesAssignResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedTransportProfilesAssign500JSONResponse ApiError
+
+func (response FeedTransportProfilesAssign500JSONResponse) VisitFeedTransportProfilesAssignResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsListRequestObject struct {
+	Params FeedsListParams
+}
+
+type FeedsListResponseObject interface {
+	VisitFeedsListResponse(w http.ResponseWriter) error
+}
+
+type FeedsList200JSONResponse ListFeedsResponse
+
+func (response FeedsList200JSONResponse) VisitFeedsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsList500JSONResponse ApiError
+
+func (response FeedsList500JSONResponse) VisitFeedsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsCreateRequestObject struct {
+	Body *FeedsCreateJSONRequestBody
+}
+
+type FeedsCreateResponseObject interface {
+	VisitFeedsCreateResponse(w http.ResponseWriter) error
+}
+
+type FeedsCreate200JSONResponse CreateFeedResponse
+
+func (response FeedsCreate200JSONResponse) VisitFeedsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsCreate500JSONResponse ApiError
+
+func (response FeedsCreate500JSONResponse) VisitFeedsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsExportOpmlRequestObject struct {
+	Body *FeedsExportOpmlJSONRequestBody
+}
+
+type FeedsExportOpmlResponseObject interface {
+	VisitFeedsExportOpmlResponse(w http.ResponseWriter) error
+}
+
+type FeedsExportOpml200JSONResponse ExportOpmlResponse
+
+func (response FeedsExportOpml200JSONResponse) VisitFeedsExportOpmlResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsExportOpml500JSONResponse ApiError
+
+func (response FeedsExportOpml500JSONResponse) VisitFeedsExportOpmlResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsSetFullContentRequestObject struct {
+	Body *FeedsSetFullContentJSONRequestBody
+}
+
+type FeedsSetFullContentResponseObject interface {
+	VisitFeedsSetFullContentResponse(w http.ResponseWriter) error
+}
+
+type FeedsSetFullContent200Response struct {
+}
+
+func (response FeedsSetFullContent200Response) VisitFeedsSetFullContentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedsSetFullContent500JSONResponse ApiError
+
+func (response FeedsSetFullContent500JSONResponse) VisitFeedsSetFullContentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsImportOpmlRequestObject struct {
+	Body *FeedsImportOpmlJSONRequestBody
+}
+
+type FeedsImportOpmlResponseObject interface {
+	VisitFeedsImportOpmlResponse(w http.ResponseWriter) error
+}
+
+type FeedsImportOpml200JSONResponse ImportOpmlResponse
+
+func (response FeedsImportOpml200JSONResponse) VisitFeedsImportOpmlResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsImportOpml500JSONResponse ApiError
+
+func (response FeedsImportOpml500JSONResponse) VisitFeedsImportOpmlResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsRefreshRequestObject struct {
+	Body *FeedsRefreshJSONRequestBody
+}
+
+type FeedsRefreshResponseObject interface {
+	VisitFeedsRefreshResponse(w http.ResponseWriter) error
+}
+
+type FeedsRefresh200JSONResponse RefreshFeedsResponse
+
+func (response FeedsRefresh200JSONResponse) VisitFeedsRefreshResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsRefresh500JSONResponse ApiError
+
+func (response FeedsRefresh500JSONResponse) VisitFeedsRefreshResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsCreateScrapedRequestObject struct {
+	Body *FeedsCreateScrapedJSONRequestBody
+}
+
+type FeedsCreateScrapedResponseObject interface {
+	VisitFeedsCreateScrapedResponse(w http.ResponseWriter) error
+}
+
+type FeedsCreateScraped200JSONResponse CreateFeedResponse
+
+func (response FeedsCreateScraped200JSONResponse) VisitFeedsCreateScrapedResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsCreateScraped500JSONResponse ApiError
+
+func (response FeedsCreateScraped500JSONResponse) VisitFeedsCreateScrapedResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsPreviewScrapeRequestObject struct {
+	Body *FeedsPreviewScrapeJSONRequestBody
+}
+
+type FeedsPreviewScrapeResponseObject interface {
+	VisitFeedsPreviewScrapeResponse(w http.ResponseWriter) error
+}
+
+type FeedsPreviewScrape200JSONResponse PreviewScrapeResponse
+
+func (response FeedsPreviewScrape200JSONResponse) VisitFeedsPreviewScrapeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsPreviewScrape500JSONResponse ApiError
+
+func (response FeedsPreviewScrape500JSONResponse) VisitFeedsPreviewScrapeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsSuspendRequestObject struct {
+	Body *FeedsSuspendJSONRequestBody
+}
+
+type FeedsSuspendResponseObject interface {
+	VisitFeedsSuspendResponse(w http.ResponseWriter) error
+}
+
+type FeedsSuspend200Response struct {
+}
+
+func (response FeedsSuspend200Response) VisitFeedsSuspendResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedsSuspend500JSONResponse ApiError
+
+func (response FeedsSuspend500JSONResponse) VisitFeedsSuspendResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsSetUnreadOnRevisionRequestObject struct {
+	Body *FeedsSetUnreadOnRevisionJSONRequestBody
+}
+
+type FeedsSetUnreadOnRevisionResponseObject interface {
+	VisitFeedsSetUnreadOnRevisionResponse(w http.ResponseWriter) error
+}
+
+type FeedsSetUnreadOnRevision200Response struct {
+}
+
+func (response FeedsSetUnreadOnRevision200Response) VisitFeedsSetUnreadOnRevisionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedsSetUnreadOnRevision500JSONResponse ApiError
+
+func (response FeedsSetUnreadOnRevision500JSONResponse) VisitFeedsSetUnreadOnRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsDeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type FeedsDeleteResponseObject interface {
+	VisitFeedsDeleteResponse(w http.ResponseWriter) error
+}
+
+type FeedsDelete200Response struct {
+}
+
+func (response FeedsDelete200Response) VisitFeedsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedsDelete500JSONResponse ApiError
+
+func (response FeedsDelete500JSONResponse) VisitFeedsDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsDeleteCredentialsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type FeedsDeleteCredentialsResponseObject interface {
+	VisitFeedsDeleteCredentialsResponse(w http.ResponseWriter) error
+}
+
+type FeedsDeleteCredentials200Response struct {
+}
+
+func (response FeedsDeleteCredentials200Response) VisitFeedsDeleteCredentialsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedsDeleteCredentials500JSONResponse ApiError
+
+func (response FeedsDeleteCredentials500JSONResponse) VisitFeedsDeleteCredentialsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsGetCredentialsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type FeedsGetCredentialsResponseObject interface {
+	VisitFeedsGetCredentialsResponse(w http.ResponseWriter) error
+}
+
+type FeedsGetCredentials200JSONResponse GetFeedCredentialsResponse
+
+func (response FeedsGetCredentials200JSONResponse) VisitFeedsGetCredentialsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsGetCredentials500JSONResponse ApiError
+
+func (response FeedsGetCredentials500JSONResponse) VisitFeedsGetCredentialsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsSetCredentialsRequestObject struct {
+	Id   string `json:"id"`
+	Body *FeedsSetCredentialsJSONRequestBody
+}
+
+type FeedsSetCredentialsResponseObject interface {
+	VisitFeedsSetCredentialsResponse(w http.ResponseWriter) error
+}
+
+type FeedsSetCredentials200Response struct {
+}
+
+func (response FeedsSetCredentials200Response) VisitFeedsSetCredentialsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedsSetCredentials500JSONResponse ApiError
+
+func (response FeedsSetCredentials500JSONResponse) VisitFeedsSetCredentialsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsGetIconRequestObject struct {
+	Id string `json:"id"`
+}
+
+type FeedsGetIconResponseObject interface {
+	VisitFeedsGetIconResponse(w http.ResponseWriter) error
+}
+
+type FeedsGetIcon200ResponseHeaders struct {
+	CacheControl string
+}
+
+type FeedsGetIcon200ImageResponse struct {
+	Body          io.Reader
+	Headers       FeedsGetIcon200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response FeedsGetIcon200ImageResponse) VisitFeedsGetIconResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type FeedsGetIcon404JSONResponse ApiError
+
+func (response FeedsGetIcon404JSONResponse) VisitFeedsGetIconResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsGetIcon500JSONResponse ApiError
+
+func (response FeedsGetIcon500JSONResponse) VisitFeedsGetIconResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsGetScraperRequestObject struct {
+	Id string `json:"id"`
+}
+
+type FeedsGetScraperResponseObject interface {
+	VisitFeedsGetScraperResponse(w http.ResponseWriter) error
+}
+
+type FeedsGetScraper200JSONResponse GetFeedScraperResponse
+
+func (response FeedsGetScraper200JSONResponse) VisitFeedsGetScraperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsGetScraper500JSONResponse ApiError
+
+func (response FeedsGetScraper500JSONResponse) VisitFeedsGetScraperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FeedsSetScraperRequestObject struct {
+	Id   string `json:"id"`
+	Body *FeedsSetScraperJSONRequestBody
+}
+
+type FeedsSetScraperResponseObject interface {
+	VisitFeedsSetScraperResponse(w http.ResponseWriter) error
+}
+
+type FeedsSetScraper200Response struct {
+}
+
+func (response FeedsSetScraper200Response) VisitFeedsSetScraperResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type FeedsSetScraper500JSONResponse ApiError
+
+func (response FeedsSetScraper500JSONResponse) VisitFeedsSetScraperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FetchQueueGetRequestObject struct {
+}
+
+type FetchQueueGetResponseObject interface {
+	VisitFetchQueueGetResponse(w http.ResponseWriter) error
+}
+
+type FetchQueueGet200JSONResponse GetFetchQueueResponse
+
+func (response FetchQueueGet200JSONResponse) VisitFetchQueueGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FetchQueueGet500JSONResponse ApiError
+
+func (response FetchQueueGet500JSONResponse) VisitFetchQueueGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type IgnoreWindowsListRequestObject struct {
+}
+
+type IgnoreWindowsListResponseObject interface {
+	VisitIgnoreWindowsListResponse(w http.ResponseWriter) error
+}
+
+type IgnoreWindowsList200JSONResponse ListIgnoreWindowsResponse
+
+func (response IgnoreWindowsList200JSONResponse) VisitIgnoreWindowsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type IgnoreWindowsList500JSONResponse ApiError
+
+func (response IgnoreWindowsList500JSONResponse) VisitIgnoreWindowsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type IgnoreWindowsCreateRequestObject struct {
+	Body *IgnoreWindowsCreateJSONRequestBody
+}
+
+type IgnoreWindowsCreateResponseObject interface {
+	VisitIgnoreWindowsCreateResponse(w http.ResponseWriter) error
+}
+
+type IgnoreWindowsCreate200JSONResponse CreateIgnoreWindowResponse
+
+func (response IgnoreWindowsCreate200JSONResponse) VisitIgnoreWindowsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type IgnoreWindowsCreate500JSONResponse ApiError
+
+func (response IgnoreWindowsCreate500JSONResponse) VisitIgnoreWindowsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type IgnoreWindowsDeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type IgnoreWindowsDeleteResponseObject interface {
+	VisitIgnoreWindowsDeleteResponse(w http.ResponseWriter) error
+}
+
+type IgnoreWindowsDelete200Response struct {
+}
+
+func (response IgnoreWindowsDelete200Response) VisitIgnoreWindowsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type IgnoreWindowsDelete500JSONResponse ApiError
+
+func (response IgnoreWindowsDelete500JSONResponse) VisitIgnoreWindowsDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type IgnoreWindowsUpdateRequestObject struct {
+	Id   string `json:"id"`
+	Body *IgnoreWindowsUpdateJSONRequestBody
+}
+
+type IgnoreWindowsUpdateResponseObject interface {
+	VisitIgnoreWindowsUpdateResponse(w http.ResponseWriter) error
+}
+
+type IgnoreWindowsUpdate200JSONResponse UpdateIgnoreWindowResponse
+
+func (response IgnoreWindowsUpdate200JSONResponse) VisitIgnoreWindowsUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type IgnoreWindowsUpdate500JSONResponse ApiError
+
+func (response IgnoreWindowsUpdate500JSONResponse) VisitIgnoreWindowsUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemReadsListRequestObject struct {
+	Params ItemReadsListParams
+}
+
+type ItemReadsListResponseObject interface {
+	VisitItemReadsListResponse(w http.ResponseWriter) error
+}
+
+type ItemReadsList200JSONResponse ListItemReadResponse
+
+func (response ItemReadsList200JSONResponse) VisitItemReadsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemReadsList500JSONResponse ApiError
+
+func (response ItemReadsList500JSONResponse) VisitItemReadsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemStarsListRequestObject struct {
+	Params ItemStarsListParams
+}
+
+type ItemStarsListResponseObject interface {
+	VisitItemStarsListResponse(w http.ResponseWriter) error
+}
+
+type ItemStarsList200JSONResponse ListItemStarsResponse
+
+func (response ItemStarsList200JSONResponse) VisitItemStarsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemStarsList500JSONResponse ApiError
+
+func (response ItemStarsList500JSONResponse) VisitItemStarsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsListRequestObject struct {
+	Params ItemsListParams
+}
+
+type ItemsListResponseObject interface {
+	VisitItemsListResponse(w http.ResponseWriter) error
+}
+
+type ItemsList200JSONResponse ListItemsResponse
+
+func (response ItemsList200JSONResponse) VisitItemsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsList500JSONResponse ApiError
+
+func (response ItemsList500JSONResponse) VisitItemsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsSearchRequestObject struct {
+	Params ItemsSearchParams
+}
+
+type ItemsSearchResponseObject interface {
+	VisitItemsSearchResponse(w http.ResponseWriter) error
+}
+
+type ItemsSearch200JSONResponse SearchItemsResponse
+
+func (response ItemsSearch200JSONResponse) VisitItemsSearchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsSearch500JSONResponse ApiError
+
+func (response ItemsSearch500JSONResponse) VisitItemsSearchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsUpdateStatusRequestObject struct {
+	Body *ItemsUpdateStatusJSONRequestBody
+}
+
+type ItemsUpdateStatusResponseObject interface {
+	VisitItemsUpdateStatusResponse(w http.ResponseWriter) error
+}
+
+type ItemsUpdateStatus200Response struct {
+}
+
+func (response ItemsUpdateStatus200Response) VisitItemsUpdateStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ItemsUpdateStatus500JSONResponse ApiError
+
+func (response ItemsUpdateStatus500JSONResponse) VisitItemsUpdateStatusResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsGetRequestObject struct {
+	Id     string `json:"id"`
+	Params ItemsGetParams
+}
+
+type ItemsGetResponseObject interface {
+	VisitItemsGetResponse(w http.ResponseWriter) error
+}
+
+type ItemsGet200JSONResponse GetItemResponse
+
+func (response ItemsGet200JSONResponse) VisitItemsGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsGet500JSONResponse ApiError
+
+func (response ItemsGet500JSONResponse) VisitItemsGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsExtractContentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ItemsExtractContentResponseObject interface {
+	VisitItemsExtractContentResponse(w http.ResponseWriter) error
+}
+
+type ItemsExtractContent200JSONResponse ExtractItemContentResponse
+
+func (response ItemsExtractContent200JSONResponse) VisitItemsExtractContentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsExtractContent500JSONResponse ApiError
+
+func (response ItemsExtractContent500JSONResponse) VisitItemsExtractContentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsListRevisionsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ItemsListRevisionsResponseObject interface {
+	VisitItemsListRevisionsResponse(w http.ResponseWriter) error
+}
+
+type ItemsListRevisions200JSONResponse ListItemRevisionsResponse
+
+func (response ItemsListRevisions200JSONResponse) VisitItemsListRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsListRevisions500JSONResponse ApiError
+
+func (response ItemsListRevisions500JSONResponse) VisitItemsListRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsDiffRevisionsRequestObject struct {
+	Id     string `json:"id"`
+	Params ItemsDiffRevisionsParams
+}
+
+type ItemsDiffRevisionsResponseObject interface {
+	VisitItemsDiffRevisionsResponse(w http.ResponseWriter) error
+}
+
+type ItemsDiffRevisions200JSONResponse DiffItemRevisionsResponse
+
+func (response ItemsDiffRevisions200JSONResponse) VisitItemsDiffRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsDiffRevisions500JSONResponse ApiError
+
+func (response ItemsDiffRevisions500JSONResponse) VisitItemsDiffRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsUnstarRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ItemsUnstarResponseObject interface {
+	VisitItemsUnstarResponse(w http.ResponseWriter) error
+}
+
+type ItemsUnstar200Response struct {
+}
+
+func (response ItemsUnstar200Response) VisitItemsUnstarResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ItemsUnstar500JSONResponse ApiError
+
+func (response ItemsUnstar500JSONResponse) VisitItemsUnstarResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsStarRequestObject struct {
+	Id   string `json:"id"`
+	Body *ItemsStarJSONRequestBody
+}
+
+type ItemsStarResponseObject interface {
+	VisitItemsStarResponse(w http.ResponseWriter) error
+}
+
+type ItemsStar200JSONResponse StarItemResponse
+
+func (response ItemsStar200JSONResponse) VisitItemsStarResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ItemsStar500JSONResponse ApiError
+
+func (response ItemsStar500JSONResponse) VisitItemsStarResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SavedSearchesListRequestObject struct {
+}
+
+type SavedSearchesListResponseObject interface {
+	VisitSavedSearchesListResponse(w http.ResponseWriter) error
+}
+
+type SavedSearchesList200JSONResponse ListSavedSearchesResponse
+
+func (response SavedSearchesList200JSONResponse) VisitSavedSearchesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SavedSearchesList500JSONResponse ApiError
+
+func (response SavedSearchesList500JSONResponse) VisitSavedSearchesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SavedSearchesCreateRequestObject struct {
+	Body *SavedSearchesCreateJSONRequestBody
+}
+
+type SavedSearchesCreateResponseObject interface {
+	VisitSavedSearchesCreateResponse(w http.ResponseWriter) error
+}
+
+type SavedSearchesCreate200JSONResponse CreateSavedSearchResponse
+
+func (response SavedSearchesCreate200JSONResponse) VisitSavedSearchesCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SavedSearchesCreate500JSONResponse ApiError
+
+func (response SavedSearchesCreate500JSONResponse) VisitSavedSearchesCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SavedSearchesDeleteRequestObject struct {
+	Id string `json:"id"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type SavedSearchesDeleteResponseObject interface {
+	VisitSavedSearchesDeleteResponse(w http.ResponseWriter) error
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type SavedSearchesDelete200Response struct {
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+func (response SavedSearchesDelete200Response) VisitSavedSearchesDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-type RequiredParamError struct {
-	ParamName string
+type SavedSearchesDelete500JSONResponse ApiError
+
+func (response SavedSearchesDelete500JSONResponse) VisitSavedSearchesDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type SavedSearchesGetRequestObject struct {
+	Id string `json:"id"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type SavedSearchesGetResponseObject interface {
+	VisitSavedSearchesGetResponse(w http.ResponseWriter) error
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type SavedSearchesGet200JSONResponse GetSavedSearchResponse
+
+func (response SavedSearchesGet200JSONResponse) VisitSavedSearchesGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type SavedSearchesGet500JSONResponse ApiError
+
+func (response SavedSearchesGet500JSONResponse) VisitSavedSearchesGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type SavedSearchesUpdateRequestObject struct {
+	Id   string `json:"id"`
+	Body *SavedSearchesUpdateJSONRequestBody
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type SavedSearchesUpdateResponseObject interface {
+	VisitSavedSearchesUpdateResponse(w http.ResponseWriter) error
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+type SavedSearchesUpdate200JSONResponse UpdateSavedSearchResponse
+
+func (response SavedSearchesUpdate200JSONResponse) VisitSavedSearchesUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type SavedSearchesUpdate500JSONResponse ApiError
+
+func (response SavedSearchesUpdate500JSONResponse) VisitSavedSearchesUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type TagIgnoreWindowsListRequestObject struct {
+	Params TagIgnoreWindowsListParams
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+type TagIgnoreWindowsListResponseObject interface {
+	VisitTagIgnoreWindowsListResponse(w http.ResponseWriter) error
 }
 
-// ServeMux is an abstraction of [http.ServeMux].
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	http.Handler
+type TagIgnoreWindowsList200JSONResponse ListTagIgnoreWindowsResponse
+
+func (response TagIgnoreWindowsList200JSONResponse) VisitTagIgnoreWindowsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type TagIgnoreWindowsList500JSONResponse ApiError
+
+func (response TagIgnoreWindowsList500JSONResponse) VisitTagIgnoreWindowsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+type TagIgnoreWindowsManageRequestObject struct {
+	Body *TagIgnoreWindowsManageJSONRequestBody
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+type TagIgnoreWindowsManageResponseObject interface {
+	VisitTagIgnoreWindowsManageResponse(w http.ResponseWriter) error
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+type TagIgnoreWindowsManage200Response struct {
+}
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+func (response TagIgnoreWindowsManage200Response) VisitTagIgnoreWindowsManageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+type TagIgnoreWindowsManage500JSONResponse ApiError
 
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/block-rules", wrapper.BlockRulesList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/block-rules", wrapper.BlockRulesAdd)
-	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/block-rules/{id}", wrapper.BlockRulesDelete)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feed-ignore-windows", wrapper.FeedIgnoreWindowsList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feed-ignore-windows/manage", wrapper.FeedIgnoreWindowsManage)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feed-tags", wrapper.FeedTagsList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feed-tags/manage", wrapper.FeedTagsManage)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/feeds", wrapper.FeedsList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds", wrapper.FeedsCreate)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/export-opml", wrapper.FeedsExportOpml)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/import-opml", wrapper.FeedsImportOpml)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/refresh", wrapper.FeedsRefresh)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/feeds/suspend", wrapper.FeedsSuspend)
-	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/feeds/{id}", wrapper.FeedsDelete)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/ignore-windows", wrapper.IgnoreWindowsList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/ignore-windows", wrapper.IgnoreWindowsCreate)
-	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/ignore-windows/{id}", wrapper.IgnoreWindowsDelete)
-	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/ignore-windows/{id}", wrapper.IgnoreWindowsUpdate)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/item-reads", wrapper.ItemReadsList)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/items", wrapper.ItemsList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/items/status", wrapper.ItemsUpdateStatus)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/items/{id}", wrapper.ItemsGet)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/tag-ignore-windows", wrapper.TagIgnoreWindowsList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tag-ignore-windows/manage", wrapper.TagIgnoreWindowsManage)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/tags", wrapper.TagsList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tags", wrapper.TagsCreate)
-	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/tags/{id}", wrapper.TagsDelete)
-	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/url-rules", wrapper.URLRulesList)
-	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/url-rules", wrapper.URLRulesAdd)
-	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/url-rules/{id}", wrapper.URLRulesDelete)
+func (response TagIgnoreWindowsManage500JSONResponse) VisitTagIgnoreWindowsManageResponse(w http.ResponseWriter) error {
 
-	return m
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type BlockRulesListRequestObject struct {
+type TagTransportProfilesListRequestObject struct {
+	Params TagTransportProfilesListParams
 }
 
-type BlockRulesListResponseObject interface {
-	VisitBlockRulesListResponse(w http.ResponseWriter) error
+type TagTransportProfilesListResponseObject interface {
+	VisitTagTransportProfilesListResponse(w http.ResponseWriter) error
 }
 
-type BlockRulesList200JSONResponse ListItemBlockRulesResponse
+type TagTransportProfilesList200JSONResponse ListTagTransportProfilesResponse
 
-func (response BlockRulesList200JSONResponse) VisitBlockRulesListResponse(w http.ResponseWriter) error {
+func (response TagTransportProfilesList200JSONResponse) VisitTagTransportProfilesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1442,9 +5043,9 @@ func (response BlockRulesList200JSONResponse) VisitBlockRulesListResponse(w http
 	return err
 }
 
-type BlockRulesList500JSONResponse ApiError
+type TagTransportProfilesList500JSONResponse ApiError
 
-func (response BlockRulesList500JSONResponse) VisitBlockRulesListResponse(w http.ResponseWriter) error {
+func (response TagTransportProfilesList500JSONResponse) VisitTagTransportProfilesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1456,25 +5057,60 @@ func (response BlockRulesList500JSONResponse) VisitBlockRulesListResponse(w http
 	return err
 }
 
-type BlockRulesAddRequestObject struct {
-	Body *BlockRulesAddJSONRequestBody
+type TagTransportProfilesAssignRequestObject struct {
+	Body *TagTransportProfilesAssignJSONRequestBody
 }
 
-type BlockRulesAddResponseObject interface {
-	VisitBlockRulesAddResponse(w http.ResponseWriter) error
+type TagTransportProfilesAssignResponseObject interface {
+	VisitTagTransportProfilesAssignResponse(w http.ResponseWriter) error
 }
 
-type BlockRulesAdd200Response struct {
+type TagTransportProfilesAssign200Response struct {
 }
 
-func (response BlockRulesAdd200Response) VisitBlockRulesAddResponse(w http.ResponseWriter) error {
+func (response TagTransportProfilesAssign200Response) VisitTagTransportProfilesAssignResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type BlockRulesAdd500JSONResponse ApiError
+type TagTransportProfilesAssign500JSONResponse ApiError
+
+func (response TagTransportProfilesAssign500JSONResponse) VisitTagTransportProfilesAssignResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TagsListRequestObject struct {
+}
+
+type TagsListResponseObject interface {
+	VisitTagsListResponse(w http.ResponseWriter) error
+}
+
+type TagsList200JSONResponse ListTagsResponse
+
+func (response TagsList200JSONResponse) VisitTagsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TagsList500JSONResponse ApiError
 
-func (response BlockRulesAdd500JSONResponse) VisitBlockRulesAddResponse(w http.ResponseWriter) error {
+func (response TagsList500JSONResponse) VisitTagsListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1486,25 +5122,31 @@ func (response BlockRulesAdd500JSONResponse) VisitBlockRulesAddResponse(w http.R
 	return err
 }
 
-type BlockRulesDeleteRequestObject struct {
-	Id string `json:"id"`
+type TagsCreateRequestObject struct {
+	Body *TagsCreateJSONRequestBody
 }
 
-type BlockRulesDeleteResponseObject interface {
-	VisitBlockRulesDeleteResponse(w http.ResponseWriter) error
+type TagsCreateResponseObject interface {
+	VisitTagsCreateResponse(w http.ResponseWriter) error
 }
 
-type BlockRulesDelete200Response struct {
-}
+type TagsCreate200JSONResponse CreateTagResponse
 
-func (response BlockRulesDelete200Response) VisitBlockRulesDeleteResponse(w http.ResponseWriter) error {
+func (response TagsCreate200JSONResponse) VisitTagsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type BlockRulesDelete500JSONResponse ApiError
+type TagsCreate500JSONResponse ApiError
 
-func (response BlockRulesDelete500JSONResponse) VisitBlockRulesDeleteResponse(w http.ResponseWriter) error {
+func (response TagsCreate500JSONResponse) VisitTagsCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1516,31 +5158,25 @@ func (response BlockRulesDelete500JSONResponse) VisitBlockRulesDeleteResponse(w
 	return err
 }
 
-type FeedIgnoreWindowsListRequestObject struct {
-	Params FeedIgnoreWindowsListParams
+type TagsDeleteRequestObject struct {
+	Id string `json:"id"`
 }
 
-type FeedIgnoreWindowsListResponseObject interface {
-	VisitFeedIgnoreWindowsListResponse(w http.ResponseWriter) error
+type TagsDeleteResponseObject interface {
+	VisitTagsDeleteResponse(w http.ResponseWriter) error
 }
 
-type FeedIgnoreWindowsList200JSONResponse ListFeedIgnoreWindowsResponse
-
-func (response FeedIgnoreWindowsList200JSONResponse) VisitFeedIgnoreWindowsListResponse(w http.ResponseWriter) error {
+type TagsDelete200Response struct {
+}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
+func (response TagsDelete200Response) VisitTagsDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
+	return nil
 }
 
-type FeedIgnoreWindowsList500JSONResponse ApiError
+type TagsDelete500JSONResponse ApiError
 
-func (response FeedIgnoreWindowsList500JSONResponse) VisitFeedIgnoreWindowsListResponse(w http.ResponseWriter) error {
+func (response TagsDelete500JSONResponse) VisitTagsDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1552,25 +5188,30 @@ func (response FeedIgnoreWindowsList500JSONResponse) VisitFeedIgnoreWindowsListR
 	return err
 }
 
-type FeedIgnoreWindowsManageRequestObject struct {
-	Body *FeedIgnoreWindowsManageJSONRequestBody
+type TransportProfilesListRequestObject struct {
 }
 
-type FeedIgnoreWindowsManageResponseObject interface {
-	VisitFeedIgnoreWindowsManageResponse(w http.ResponseWriter) error
+type TransportProfilesListResponseObject interface {
+	VisitTransportProfilesListResponse(w http.ResponseWriter) error
 }
 
-type FeedIgnoreWindowsManage200Response struct {
-}
+type TransportProfilesList200JSONResponse ListTransportProfilesResponse
 
-func (response FeedIgnoreWindowsManage200Response) VisitFeedIgnoreWindowsManageResponse(w http.ResponseWriter) error {
+func (response TransportProfilesList200JSONResponse) VisitTransportProfilesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type FeedIgnoreWindowsManage500JSONResponse ApiError
+type TransportProfilesList500JSONResponse ApiError
 
-func (response FeedIgnoreWindowsManage500JSONResponse) VisitFeedIgnoreWindowsManageResponse(w http.ResponseWriter) error {
+func (response TransportProfilesList500JSONResponse) VisitTransportProfilesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1582,17 +5223,17 @@ func (response FeedIgnoreWindowsManage500JSONResponse) VisitFeedIgnoreWindowsMan
 	return err
 }
 
-type FeedTagsListRequestObject struct {
-	Params FeedTagsListParams
+type TransportProfilesCreateRequestObject struct {
+	Body *TransportProfilesCreateJSONRequestBody
 }
 
-type FeedTagsListResponseObject interface {
-	VisitFeedTagsListResponse(w http.ResponseWriter) error
+type TransportProfilesCreateResponseObject interface {
+	VisitTransportProfilesCreateResponse(w http.ResponseWriter) error
 }
 
-type FeedTagsList200JSONResponse ListFeedTagsResponse
+type TransportProfilesCreate200JSONResponse CreateTransportProfileResponse
 
-func (response FeedTagsList200JSONResponse) VisitFeedTagsListResponse(w http.ResponseWriter) error {
+func (response TransportProfilesCreate200JSONResponse) VisitTransportProfilesCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1604,9 +5245,9 @@ func (response FeedTagsList200JSONResponse) VisitFeedTagsListResponse(w http.Res
 	return err
 }
 
-type FeedTagsList500JSONResponse ApiError
+type TransportProfilesCreate500JSONResponse ApiError
 
-func (response FeedTagsList500JSONResponse) VisitFeedTagsListResponse(w http.ResponseWriter) error {
+func (response TransportProfilesCreate500JSONResponse) VisitTransportProfilesCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1618,25 +5259,25 @@ func (response FeedTagsList500JSONResponse) VisitFeedTagsListResponse(w http.Res
 	return err
 }
 
-type FeedTagsManageRequestObject struct {
-	Body *FeedTagsManageJSONRequestBody
+type TransportProfilesDeleteRequestObject struct {
+	Id string `json:"id"`
 }
 
-type FeedTagsManageResponseObject interface {
-	VisitFeedTagsManageResponse(w http.ResponseWriter) error
+type TransportProfilesDeleteResponseObject interface {
+	VisitTransportProfilesDeleteResponse(w http.ResponseWriter) error
 }
 
-type FeedTagsManage200Response struct {
+type TransportProfilesDelete200Response struct {
 }
 
-func (response FeedTagsManage200Response) VisitFeedTagsManageResponse(w http.ResponseWriter) error {
+func (response TransportProfilesDelete200Response) VisitTransportProfilesDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type FeedTagsManage500JSONResponse ApiError
+type TransportProfilesDelete500JSONResponse ApiError
 
-func (response FeedTagsManage500JSONResponse) VisitFeedTagsManageResponse(w http.ResponseWriter) error {
+func (response TransportProfilesDelete500JSONResponse) VisitTransportProfilesDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1648,17 +5289,18 @@ func (response FeedTagsManage500JSONResponse) VisitFeedTagsManageResponse(w http
 	return err
 }
 
-type FeedsListRequestObject struct {
-	Params FeedsListParams
+type TransportProfilesUpdateRequestObject struct {
+	Id   string `json:"id"`
+	Body *TransportProfilesUpdateJSONRequestBody
 }
 
-type FeedsListResponseObject interface {
-	VisitFeedsListResponse(w http.ResponseWriter) error
+type TransportProfilesUpdateResponseObject interface {
+	VisitTransportProfilesUpdateResponse(w http.ResponseWriter) error
 }
 
-type FeedsList200JSONResponse ListFeedsResponse
+type TransportProfilesUpdate200JSONResponse UpdateTransportProfileResponse
 
-func (response FeedsList200JSONResponse) VisitFeedsListResponse(w http.ResponseWriter) error {
+func (response TransportProfilesUpdate200JSONResponse) VisitTransportProfilesUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1670,9 +5312,9 @@ func (response FeedsList200JSONResponse) VisitFeedsListResponse(w http.ResponseW
 	return err
 }
 
-type FeedsList500JSONResponse ApiError
+type TransportProfilesUpdate500JSONResponse ApiError
 
-func (response FeedsList500JSONResponse) VisitFeedsListResponse(w http.ResponseWriter) error {
+func (response TransportProfilesUpdate500JSONResponse) VisitTransportProfilesUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1684,17 +5326,16 @@ func (response FeedsList500JSONResponse) VisitFeedsListResponse(w http.ResponseW
 	return err
 }
 
-type FeedsCreateRequestObject struct {
-	Body *FeedsCreateJSONRequestBody
+type URLRulesListRequestObject struct {
 }
 
-type FeedsCreateResponseObject interface {
-	VisitFeedsCreateResponse(w http.ResponseWriter) error
+type URLRulesListResponseObject interface {
+	VisitURLRulesListResponse(w http.ResponseWriter) error
 }
 
-type FeedsCreate200JSONResponse CreateFeedResponse
+type URLRulesList200JSONResponse ListURLParsingRulesResponse
 
-func (response FeedsCreate200JSONResponse) VisitFeedsCreateResponse(w http.ResponseWriter) error {
+func (response URLRulesList200JSONResponse) VisitURLRulesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1706,9 +5347,9 @@ func (response FeedsCreate200JSONResponse) VisitFeedsCreateResponse(w http.Respo
 	return err
 }
 
-type FeedsCreate500JSONResponse ApiError
+type URLRulesList500JSONResponse ApiError
 
-func (response FeedsCreate500JSONResponse) VisitFeedsCreateResponse(w http.ResponseWriter) error {
+func (response URLRulesList500JSONResponse) VisitURLRulesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1720,17 +5361,17 @@ func (response FeedsCreate500JSONResponse) VisitFeedsCreateResponse(w http.Respo
 	return err
 }
 
-type FeedsExportOpmlRequestObject struct {
-	Body *FeedsExportOpmlJSONRequestBody
+type URLRulesAddRequestObject struct {
+	Body *URLRulesAddJSONRequestBody
 }
 
-type FeedsExportOpmlResponseObject interface {
-	VisitFeedsExportOpmlResponse(w http.ResponseWriter) error
+type URLRulesAddResponseObject interface {
+	VisitURLRulesAddResponse(w http.ResponseWriter) error
 }
 
-type FeedsExportOpml200JSONResponse ExportOpmlResponse
+type URLRulesAdd200JSONResponse AddURLParsingRuleResponse
 
-func (response FeedsExportOpml200JSONResponse) VisitFeedsExportOpmlResponse(w http.ResponseWriter) error {
+func (response URLRulesAdd200JSONResponse) VisitURLRulesAddResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1742,9 +5383,9 @@ func (response FeedsExportOpml200JSONResponse) VisitFeedsExportOpmlResponse(w ht
 	return err
 }
 
-type FeedsExportOpml500JSONResponse ApiError
+type URLRulesAdd500JSONResponse ApiError
 
-func (response FeedsExportOpml500JSONResponse) VisitFeedsExportOpmlResponse(w http.ResponseWriter) error {
+func (response URLRulesAdd500JSONResponse) VisitURLRulesAddResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1756,31 +5397,25 @@ func (response FeedsExportOpml500JSONResponse) VisitFeedsExportOpmlResponse(w ht
 	return err
 }
 
-type FeedsImportOpmlRequestObject struct {
-	Body *FeedsImportOpmlJSONRequestBody
+type URLRulesDeleteRequestObject struct {
+	Id string `json:"id"`
 }
 
-type FeedsImportOpmlResponseObject interface {
-	VisitFeedsImportOpmlResponse(w http.ResponseWriter) error
+type URLRulesDeleteResponseObject interface {
+	VisitURLRulesDeleteResponse(w http.ResponseWriter) error
 }
 
-type FeedsImportOpml200JSONResponse ImportOpmlResponse
-
-func (response FeedsImportOpml200JSONResponse) VisitFeedsImportOpmlResponse(w http.ResponseWriter) error {
+type URLRulesDelete200Response struct {
+}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
+func (response URLRulesDelete200Response) VisitURLRulesDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
+	return nil
 }
 
-type FeedsImportOpml500JSONResponse ApiError
+type URLRulesDelete500JSONResponse ApiError
 
-func (response FeedsImportOpml500JSONResponse) VisitFeedsImportOpmlResponse(w http.ResponseWriter) error {
+func (response URLRulesDelete500JSONResponse) VisitURLRulesDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1792,786 +5427,1131 @@ func (response FeedsImportOpml500JSONResponse) VisitFeedsImportOpmlResponse(w ht
 	return err
 }
 
-type FeedsRefreshRequestObject struct {
-	Body *FeedsRefreshJSONRequestBody
-}
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
 
-type FeedsRefreshResponseObject interface {
-	VisitFeedsRefreshResponse(w http.ResponseWriter) error
-}
+	// (GET /block-rules)
+	BlockRulesList(ctx context.Context, request BlockRulesListRequestObject) (BlockRulesListResponseObject, error)
 
-type FeedsRefresh200JSONResponse RefreshFeedsResponse
+	// (POST /block-rules)
+	BlockRulesAdd(ctx context.Context, request BlockRulesAddRequestObject) (BlockRulesAddResponseObject, error)
+
+	// (DELETE /block-rules/{id})
+	BlockRulesDelete(ctx context.Context, request BlockRulesDeleteRequestObject) (BlockRulesDeleteResponseObject, error)
+
+	// (GET /categories)
+	CategoriesList(ctx context.Context, request CategoriesListRequestObject) (CategoriesListResponseObject, error)
+
+	// (GET /counts)
+	CountsGet(ctx context.Context, request CountsGetRequestObject) (CountsGetResponseObject, error)
+
+	// (GET /feed-ignore-windows)
+	FeedIgnoreWindowsList(ctx context.Context, request FeedIgnoreWindowsListRequestObject) (FeedIgnoreWindowsListResponseObject, error)
+
+	// (POST /feed-ignore-windows/manage)
+	FeedIgnoreWindowsManage(ctx context.Context, request FeedIgnoreWindowsManageRequestObject) (FeedIgnoreWindowsManageResponseObject, error)
+
+	// (GET /feed-tags)
+	FeedTagsList(ctx context.Context, request FeedTagsListRequestObject) (FeedTagsListResponseObject, error)
+
+	// (POST /feed-tags/manage)
+	FeedTagsManage(ctx context.Context, request FeedTagsManageRequestObject) (FeedTagsManageResponseObject, error)
+
+	// (GET /feed-transport-profiles)
+	FeedTransportProfilesList(ctx context.Context, request FeedTransportProfilesListRequestObject) (FeedTransportProfilesListResponseObject, error)
+
+	// (POST /feed-transport-profiles/assign)
+	FeedTransportProfilesAssign(ctx context.Context, request FeedTransportProfilesAssignRequestObject) (FeedTransportProfilesAssignResponseObject, error)
+
+	// (GET /feeds)
+	FeedsList(ctx context.Context, request FeedsListRequestObject) (FeedsListResponseObject, error)
+
+	// (POST /feeds)
+	FeedsCreate(ctx context.Context, request FeedsCreateRequestObject) (FeedsCreateResponseObject, error)
+
+	// (POST /feeds/export-opml)
+	FeedsExportOpml(ctx context.Context, request FeedsExportOpmlRequestObject) (FeedsExportOpmlResponseObject, error)
+
+	// (POST /feeds/full-content)
+	FeedsSetFullContent(ctx context.Context, request FeedsSetFullContentRequestObject) (FeedsSetFullContentResponseObject, error)
+
+	// (POST /feeds/import-opml)
+	FeedsImportOpml(ctx context.Context, request FeedsImportOpmlRequestObject) (FeedsImportOpmlResponseObject, error)
+
+	// (POST /feeds/refresh)
+	FeedsRefresh(ctx context.Context, request FeedsRefreshRequestObject) (FeedsRefreshResponseObject, error)
+
+	// (POST /feeds/scrape)
+	FeedsCreateScraped(ctx context.Context, request FeedsCreateScrapedRequestObject) (FeedsCreateScrapedResponseObject, error)
+
+	// (POST /feeds/scrape/preview)
+	FeedsPreviewScrape(ctx context.Context, request FeedsPreviewScrapeRequestObject) (FeedsPreviewScrapeResponseObject, error)
+
+	// (POST /feeds/suspend)
+	FeedsSuspend(ctx context.Context, request FeedsSuspendRequestObject) (FeedsSuspendResponseObject, error)
+
+	// (POST /feeds/unread-on-revision)
+	FeedsSetUnreadOnRevision(ctx context.Context, request FeedsSetUnreadOnRevisionRequestObject) (FeedsSetUnreadOnRevisionResponseObject, error)
+
+	// (DELETE /feeds/{id})
+	FeedsDelete(ctx context.Context, request FeedsDeleteRequestObject) (FeedsDeleteResponseObject, error)
+
+	// (DELETE /feeds/{id}/credentials)
+	FeedsDeleteCredentials(ctx context.Context, request FeedsDeleteCredentialsRequestObject) (FeedsDeleteCredentialsResponseObject, error)
+
+	// (GET /feeds/{id}/credentials)
+	FeedsGetCredentials(ctx context.Context, request FeedsGetCredentialsRequestObject) (FeedsGetCredentialsResponseObject, error)
+
+	// (PUT /feeds/{id}/credentials)
+	FeedsSetCredentials(ctx context.Context, request FeedsSetCredentialsRequestObject) (FeedsSetCredentialsResponseObject, error)
+
+	// (GET /feeds/{id}/icon)
+	FeedsGetIcon(ctx context.Context, request FeedsGetIconRequestObject) (FeedsGetIconResponseObject, error)
+
+	// (GET /feeds/{id}/scraper)
+	FeedsGetScraper(ctx context.Context, request FeedsGetScraperRequestObject) (FeedsGetScraperResponseObject, error)
+
+	// (PUT /feeds/{id}/scraper)
+	FeedsSetScraper(ctx context.Context, request FeedsSetScraperRequestObject) (FeedsSetScraperResponseObject, error)
+
+	// (GET /fetch-queue)
+	FetchQueueGet(ctx context.Context, request FetchQueueGetRequestObject) (FetchQueueGetResponseObject, error)
+
+	// (GET /ignore-windows)
+	IgnoreWindowsList(ctx context.Context, request IgnoreWindowsListRequestObject) (IgnoreWindowsListResponseObject, error)
+
+	// (POST /ignore-windows)
+	IgnoreWindowsCreate(ctx context.Context, request IgnoreWindowsCreateRequestObject) (IgnoreWindowsCreateResponseObject, error)
+
+	// (DELETE /ignore-windows/{id})
+	IgnoreWindowsDelete(ctx context.Context, request IgnoreWindowsDeleteRequestObject) (IgnoreWindowsDeleteResponseObject, error)
+
+	// (PUT /ignore-windows/{id})
+	IgnoreWindowsUpdate(ctx context.Context, request IgnoreWindowsUpdateRequestObject) (IgnoreWindowsUpdateResponseObject, error)
+
+	// (GET /item-reads)
+	ItemReadsList(ctx context.Context, request ItemReadsListRequestObject) (ItemReadsListResponseObject, error)
+
+	// (GET /item-stars)
+	ItemStarsList(ctx context.Context, request ItemStarsListRequestObject) (ItemStarsListResponseObject, error)
+
+	// (GET /items)
+	ItemsList(ctx context.Context, request ItemsListRequestObject) (ItemsListResponseObject, error)
+
+	// (GET /items/search)
+	ItemsSearch(ctx context.Context, request ItemsSearchRequestObject) (ItemsSearchResponseObject, error)
+
+	// (POST /items/status)
+	ItemsUpdateStatus(ctx context.Context, request ItemsUpdateStatusRequestObject) (ItemsUpdateStatusResponseObject, error)
+
+	// (GET /items/{id})
+	ItemsGet(ctx context.Context, request ItemsGetRequestObject) (ItemsGetResponseObject, error)
+
+	// (POST /items/{id}/extract-content)
+	ItemsExtractContent(ctx context.Context, request ItemsExtractContentRequestObject) (ItemsExtractContentResponseObject, error)
+
+	// (GET /items/{id}/revisions)
+	ItemsListRevisions(ctx context.Context, request ItemsListRevisionsRequestObject) (ItemsListRevisionsResponseObject, error)
+
+	// (GET /items/{id}/revisions/diff)
+	ItemsDiffRevisions(ctx context.Context, request ItemsDiffRevisionsRequestObject) (ItemsDiffRevisionsResponseObject, error)
+
+	// (DELETE /items/{id}/star)
+	ItemsUnstar(ctx context.Context, request ItemsUnstarRequestObject) (ItemsUnstarResponseObject, error)
+
+	// (PUT /items/{id}/star)
+	ItemsStar(ctx context.Context, request ItemsStarRequestObject) (ItemsStarResponseObject, error)
+
+	// (GET /saved-searches)
+	SavedSearchesList(ctx context.Context, request SavedSearchesListRequestObject) (SavedSearchesListResponseObject, error)
+
+	// (POST /saved-searches)
+	SavedSearchesCreate(ctx context.Context, request SavedSearchesCreateRequestObject) (SavedSearchesCreateResponseObject, error)
+
+	// (DELETE /saved-searches/{id})
+	SavedSearchesDelete(ctx context.Context, request SavedSearchesDeleteRequestObject) (SavedSearchesDeleteResponseObject, error)
 
-func (response FeedsRefresh200JSONResponse) VisitFeedsRefreshResponse(w http.ResponseWriter) error {
+	// (GET /saved-searches/{id})
+	SavedSearchesGet(ctx context.Context, request SavedSearchesGetRequestObject) (SavedSearchesGetResponseObject, error)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	// (PUT /saved-searches/{id})
+	SavedSearchesUpdate(ctx context.Context, request SavedSearchesUpdateRequestObject) (SavedSearchesUpdateResponseObject, error)
 
-type FeedsRefresh500JSONResponse ApiError
+	// (GET /tag-ignore-windows)
+	TagIgnoreWindowsList(ctx context.Context, request TagIgnoreWindowsListRequestObject) (TagIgnoreWindowsListResponseObject, error)
 
-func (response FeedsRefresh500JSONResponse) VisitFeedsRefreshResponse(w http.ResponseWriter) error {
+	// (POST /tag-ignore-windows/manage)
+	TagIgnoreWindowsManage(ctx context.Context, request TagIgnoreWindowsManageRequestObject) (TagIgnoreWindowsManageResponseObject, error)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	// (GET /tag-transport-profiles)
+	TagTransportProfilesList(ctx context.Context, request TagTransportProfilesListRequestObject) (TagTransportProfilesListResponseObject, error)
 
-type FeedsSuspendRequestObject struct {
-	Body *FeedsSuspendJSONRequestBody
-}
+	// (POST /tag-transport-profiles/assign)
+	TagTransportProfilesAssign(ctx context.Context, request TagTransportProfilesAssignRequestObject) (TagTransportProfilesAssignResponseObject, error)
 
-type FeedsSuspendResponseObject interface {
-	VisitFeedsSuspendResponse(w http.ResponseWriter) error
-}
+	// (GET /tags)
+	TagsList(ctx context.Context, request TagsListRequestObject) (TagsListResponseObject, error)
 
-type FeedsSuspend200Response struct {
-}
+	// (POST /tags)
+	TagsCreate(ctx context.Context, request TagsCreateRequestObject) (TagsCreateResponseObject, error)
 
-func (response FeedsSuspend200Response) VisitFeedsSuspendResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// (DELETE /tags/{id})
+	TagsDelete(ctx context.Context, request TagsDeleteRequestObject) (TagsDeleteResponseObject, error)
 
-type FeedsSuspend500JSONResponse ApiError
+	// (GET /transport-profiles)
+	TransportProfilesList(ctx context.Context, request TransportProfilesListRequestObject) (TransportProfilesListResponseObject, error)
 
-func (response FeedsSuspend500JSONResponse) VisitFeedsSuspendResponse(w http.ResponseWriter) error {
+	// (POST /transport-profiles)
+	TransportProfilesCreate(ctx context.Context, request TransportProfilesCreateRequestObject) (TransportProfilesCreateResponseObject, error)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	// (DELETE /transport-profiles/{id})
+	TransportProfilesDelete(ctx context.Context, request TransportProfilesDeleteRequestObject) (TransportProfilesDeleteResponseObject, error)
 
-type FeedsDeleteRequestObject struct {
-	Id string `json:"id"`
-}
+	// (PUT /transport-profiles/{id})
+	TransportProfilesUpdate(ctx context.Context, request TransportProfilesUpdateRequestObject) (TransportProfilesUpdateResponseObject, error)
 
-type FeedsDeleteResponseObject interface {
-	VisitFeedsDeleteResponse(w http.ResponseWriter) error
-}
+	// (GET /url-rules)
+	URLRulesList(ctx context.Context, request URLRulesListRequestObject) (URLRulesListResponseObject, error)
 
-type FeedsDelete200Response struct {
-}
+	// (POST /url-rules)
+	URLRulesAdd(ctx context.Context, request URLRulesAddRequestObject) (URLRulesAddResponseObject, error)
 
-func (response FeedsDelete200Response) VisitFeedsDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// (DELETE /url-rules/{id})
+	URLRulesDelete(ctx context.Context, request URLRulesDeleteRequestObject) (URLRulesDeleteResponseObject, error)
 }
 
-type FeedsDelete500JSONResponse ApiError
-
-func (response FeedsDelete500JSONResponse) VisitFeedsDeleteResponse(w http.ResponseWriter) error {
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error)
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type IgnoreWindowsListRequestObject struct {
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-type IgnoreWindowsListResponseObject interface {
-	VisitIgnoreWindowsListResponse(w http.ResponseWriter) error
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type IgnoreWindowsList200JSONResponse ListIgnoreWindowsResponse
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-func (response IgnoreWindowsList200JSONResponse) VisitIgnoreWindowsListResponse(w http.ResponseWriter) error {
+// BlockRulesList operation middleware
+func (sh *strictHandler) BlockRulesList(w http.ResponseWriter, r *http.Request) {
+	var request BlockRulesListRequestObject
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BlockRulesList(ctx, request.(BlockRulesListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BlockRulesList")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type IgnoreWindowsList500JSONResponse ApiError
 
-func (response IgnoreWindowsList500JSONResponse) VisitIgnoreWindowsListResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BlockRulesListResponseObject); ok {
+		if err := validResponse.VisitBlockRulesListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type IgnoreWindowsCreateRequestObject struct {
-	Body *IgnoreWindowsCreateJSONRequestBody
-}
+// BlockRulesAdd operation middleware
+func (sh *strictHandler) BlockRulesAdd(w http.ResponseWriter, r *http.Request) {
+	var request BlockRulesAddRequestObject
 
-type IgnoreWindowsCreateResponseObject interface {
-	VisitIgnoreWindowsCreateResponse(w http.ResponseWriter) error
-}
+	var body BlockRulesAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type IgnoreWindowsCreate200JSONResponse CreateIgnoreWindowResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BlockRulesAdd(ctx, request.(BlockRulesAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BlockRulesAdd")
+	}
 
-func (response IgnoreWindowsCreate200JSONResponse) VisitIgnoreWindowsCreateResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BlockRulesAddResponseObject); ok {
+		if err := validResponse.VisitBlockRulesAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type IgnoreWindowsCreate500JSONResponse ApiError
+// BlockRulesDelete operation middleware
+func (sh *strictHandler) BlockRulesDelete(w http.ResponseWriter, r *http.Request, id string) {
+	var request BlockRulesDeleteRequestObject
 
-func (response IgnoreWindowsCreate500JSONResponse) VisitIgnoreWindowsCreateResponse(w http.ResponseWriter) error {
+	request.Id = id
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BlockRulesDelete(ctx, request.(BlockRulesDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BlockRulesDelete")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type IgnoreWindowsDeleteRequestObject struct {
-	Id string `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type IgnoreWindowsDeleteResponseObject interface {
-	VisitIgnoreWindowsDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BlockRulesDeleteResponseObject); ok {
+		if err := validResponse.VisitBlockRulesDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type IgnoreWindowsDelete200Response struct {
-}
+// CategoriesList operation middleware
+func (sh *strictHandler) CategoriesList(w http.ResponseWriter, r *http.Request, params CategoriesListParams) {
+	var request CategoriesListRequestObject
 
-func (response IgnoreWindowsDelete200Response) VisitIgnoreWindowsDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.Params = params
 
-type IgnoreWindowsDelete500JSONResponse ApiError
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CategoriesList(ctx, request.(CategoriesListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CategoriesList")
+	}
 
-func (response IgnoreWindowsDelete500JSONResponse) VisitIgnoreWindowsDeleteResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CategoriesListResponseObject); ok {
+		if err := validResponse.VisitCategoriesListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type IgnoreWindowsUpdateRequestObject struct {
-	Id   string `json:"id"`
-	Body *IgnoreWindowsUpdateJSONRequestBody
-}
+// CountsGet operation middleware
+func (sh *strictHandler) CountsGet(w http.ResponseWriter, r *http.Request, params CountsGetParams) {
+	var request CountsGetRequestObject
 
-type IgnoreWindowsUpdateResponseObject interface {
-	VisitIgnoreWindowsUpdateResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type IgnoreWindowsUpdate200JSONResponse UpdateIgnoreWindowResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CountsGet(ctx, request.(CountsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CountsGet")
+	}
 
-func (response IgnoreWindowsUpdate200JSONResponse) VisitIgnoreWindowsUpdateResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CountsGetResponseObject); ok {
+		if err := validResponse.VisitCountsGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type IgnoreWindowsUpdate500JSONResponse ApiError
+// FeedIgnoreWindowsList operation middleware
+func (sh *strictHandler) FeedIgnoreWindowsList(w http.ResponseWriter, r *http.Request, params FeedIgnoreWindowsListParams) {
+	var request FeedIgnoreWindowsListRequestObject
 
-func (response IgnoreWindowsUpdate500JSONResponse) VisitIgnoreWindowsUpdateResponse(w http.ResponseWriter) error {
+	request.Params = params
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedIgnoreWindowsList(ctx, request.(FeedIgnoreWindowsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedIgnoreWindowsList")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type ItemReadsListRequestObject struct {
-	Params ItemReadsListParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ItemReadsListResponseObject interface {
-	VisitItemReadsListResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedIgnoreWindowsListResponseObject); ok {
+		if err := validResponse.VisitFeedIgnoreWindowsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ItemReadsList200JSONResponse ListItemReadResponse
-
-func (response ItemReadsList200JSONResponse) VisitItemReadsListResponse(w http.ResponseWriter) error {
+// FeedIgnoreWindowsManage operation middleware
+func (sh *strictHandler) FeedIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
+	var request FeedIgnoreWindowsManageRequestObject
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	var body FeedIgnoreWindowsManageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	request.Body = &body
 
-type ItemReadsList500JSONResponse ApiError
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedIgnoreWindowsManage(ctx, request.(FeedIgnoreWindowsManageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedIgnoreWindowsManage")
+	}
 
-func (response ItemReadsList500JSONResponse) VisitItemReadsListResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedIgnoreWindowsManageResponseObject); ok {
+		if err := validResponse.VisitFeedIgnoreWindowsManageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type ItemsListRequestObject struct {
-	Params ItemsListParams
-}
+// FeedTagsList operation middleware
+func (sh *strictHandler) FeedTagsList(w http.ResponseWriter, r *http.Request, params FeedTagsListParams) {
+	var request FeedTagsListRequestObject
 
-type ItemsListResponseObject interface {
-	VisitItemsListResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type ItemsList200JSONResponse ListItemsResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedTagsList(ctx, request.(FeedTagsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedTagsList")
+	}
 
-func (response ItemsList200JSONResponse) VisitItemsListResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedTagsListResponseObject); ok {
+		if err := validResponse.VisitFeedTagsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type ItemsList500JSONResponse ApiError
+// FeedTagsManage operation middleware
+func (sh *strictHandler) FeedTagsManage(w http.ResponseWriter, r *http.Request) {
+	var request FeedTagsManageRequestObject
 
-func (response ItemsList500JSONResponse) VisitItemsListResponse(w http.ResponseWriter) error {
+	var body FeedTagsManageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedTagsManage(ctx, request.(FeedTagsManageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedTagsManage")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type ItemsUpdateStatusRequestObject struct {
-	Body *ItemsUpdateStatusJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ItemsUpdateStatusResponseObject interface {
-	VisitItemsUpdateStatusResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedTagsManageResponseObject); ok {
+		if err := validResponse.VisitFeedTagsManageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ItemsUpdateStatus200Response struct {
-}
+// FeedTransportProfilesList operation middleware
+func (sh *strictHandler) FeedTransportProfilesList(w http.ResponseWriter, r *http.Request, params FeedTransportProfilesListParams) {
+	var request FeedTransportProfilesListRequestObject
 
-func (response ItemsUpdateStatus200Response) VisitItemsUpdateStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.Params = params
 
-type ItemsUpdateStatus500JSONResponse ApiError
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedTransportProfilesList(ctx, request.(FeedTransportProfilesListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedTransportProfilesList")
+	}
 
-func (response ItemsUpdateStatus500JSONResponse) VisitItemsUpdateStatusResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedTransportProfilesListResponseObject); ok {
+		if err := validResponse.VisitFeedTransportProfilesListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type ItemsGetRequestObject struct {
-	Id string `json:"id"`
-}
+// FeedTransportProfilesAssign operation middleware
+func (sh *strictHandler) FeedTransportProfilesAssign(w http.ResponseWriter, r *http.Request) {
+	var request FeedTransportProfilesAssignRequestObject
 
-type ItemsGetResponseObject interface {
-	VisitItemsGetResponse(w http.ResponseWriter) error
-}
+	var body FeedTransportProfilesAssignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ItemsGet200JSONResponse GetItemResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedTransportProfilesAssign(ctx, request.(FeedTransportProfilesAssignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedTransportProfilesAssign")
+	}
 
-func (response ItemsGet200JSONResponse) VisitItemsGetResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedTransportProfilesAssignResponseObject); ok {
+		if err := validResponse.VisitFeedTransportProfilesAssignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type ItemsGet500JSONResponse ApiError
+// FeedsList operation middleware
+func (sh *strictHandler) FeedsList(w http.ResponseWriter, r *http.Request, params FeedsListParams) {
+	var request FeedsListRequestObject
 
-func (response ItemsGet500JSONResponse) VisitItemsGetResponse(w http.ResponseWriter) error {
+	request.Params = params
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsList(ctx, request.(FeedsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsList")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type TagIgnoreWindowsListRequestObject struct {
-	Params TagIgnoreWindowsListParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type TagIgnoreWindowsListResponseObject interface {
-	VisitTagIgnoreWindowsListResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsListResponseObject); ok {
+		if err := validResponse.VisitFeedsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TagIgnoreWindowsList200JSONResponse ListTagIgnoreWindowsResponse
-
-func (response TagIgnoreWindowsList200JSONResponse) VisitTagIgnoreWindowsListResponse(w http.ResponseWriter) error {
+// FeedsCreate operation middleware
+func (sh *strictHandler) FeedsCreate(w http.ResponseWriter, r *http.Request) {
+	var request FeedsCreateRequestObject
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	var body FeedsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	request.Body = &body
 
-type TagIgnoreWindowsList500JSONResponse ApiError
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsCreate(ctx, request.(FeedsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsCreate")
+	}
 
-func (response TagIgnoreWindowsList500JSONResponse) VisitTagIgnoreWindowsListResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsCreateResponseObject); ok {
+		if err := validResponse.VisitFeedsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type TagIgnoreWindowsManageRequestObject struct {
-	Body *TagIgnoreWindowsManageJSONRequestBody
-}
+// FeedsExportOpml operation middleware
+func (sh *strictHandler) FeedsExportOpml(w http.ResponseWriter, r *http.Request) {
+	var request FeedsExportOpmlRequestObject
 
-type TagIgnoreWindowsManageResponseObject interface {
-	VisitTagIgnoreWindowsManageResponse(w http.ResponseWriter) error
-}
+	var body FeedsExportOpmlJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type TagIgnoreWindowsManage200Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsExportOpml(ctx, request.(FeedsExportOpmlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsExportOpml")
+	}
 
-func (response TagIgnoreWindowsManage200Response) VisitTagIgnoreWindowsManageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsExportOpmlResponseObject); ok {
+		if err := validResponse.VisitFeedsExportOpmlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TagIgnoreWindowsManage500JSONResponse ApiError
+// FeedsSetFullContent operation middleware
+func (sh *strictHandler) FeedsSetFullContent(w http.ResponseWriter, r *http.Request) {
+	var request FeedsSetFullContentRequestObject
 
-func (response TagIgnoreWindowsManage500JSONResponse) VisitTagIgnoreWindowsManageResponse(w http.ResponseWriter) error {
+	var body FeedsSetFullContentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsSetFullContent(ctx, request.(FeedsSetFullContentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsSetFullContent")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type TagsListRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type TagsListResponseObject interface {
-	VisitTagsListResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsSetFullContentResponseObject); ok {
+		if err := validResponse.VisitFeedsSetFullContentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TagsList200JSONResponse ListTagsResponse
-
-func (response TagsList200JSONResponse) VisitTagsListResponse(w http.ResponseWriter) error {
+// FeedsImportOpml operation middleware
+func (sh *strictHandler) FeedsImportOpml(w http.ResponseWriter, r *http.Request) {
+	var request FeedsImportOpmlRequestObject
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	var body FeedsImportOpmlJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	request.Body = &body
 
-type TagsList500JSONResponse ApiError
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsImportOpml(ctx, request.(FeedsImportOpmlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsImportOpml")
+	}
 
-func (response TagsList500JSONResponse) VisitTagsListResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsImportOpmlResponseObject); ok {
+		if err := validResponse.VisitFeedsImportOpmlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type TagsCreateRequestObject struct {
-	Body *TagsCreateJSONRequestBody
-}
+// FeedsRefresh operation middleware
+func (sh *strictHandler) FeedsRefresh(w http.ResponseWriter, r *http.Request) {
+	var request FeedsRefreshRequestObject
 
-type TagsCreateResponseObject interface {
-	VisitTagsCreateResponse(w http.ResponseWriter) error
-}
+	var body FeedsRefreshJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type TagsCreate200JSONResponse CreateTagResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsRefresh(ctx, request.(FeedsRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsRefresh")
+	}
 
-func (response TagsCreate200JSONResponse) VisitTagsCreateResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsRefreshResponseObject); ok {
+		if err := validResponse.VisitFeedsRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type TagsCreate500JSONResponse ApiError
+// FeedsCreateScraped operation middleware
+func (sh *strictHandler) FeedsCreateScraped(w http.ResponseWriter, r *http.Request) {
+	var request FeedsCreateScrapedRequestObject
 
-func (response TagsCreate500JSONResponse) VisitTagsCreateResponse(w http.ResponseWriter) error {
+	var body FeedsCreateScrapedJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsCreateScraped(ctx, request.(FeedsCreateScrapedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsCreateScraped")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type TagsDeleteRequestObject struct {
-	Id string `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type TagsDeleteResponseObject interface {
-	VisitTagsDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsCreateScrapedResponseObject); ok {
+		if err := validResponse.VisitFeedsCreateScrapedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TagsDelete200Response struct {
-}
+// FeedsPreviewScrape operation middleware
+func (sh *strictHandler) FeedsPreviewScrape(w http.ResponseWriter, r *http.Request) {
+	var request FeedsPreviewScrapeRequestObject
 
-func (response TagsDelete200Response) VisitTagsDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	var body FeedsPreviewScrapeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type TagsDelete500JSONResponse ApiError
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsPreviewScrape(ctx, request.(FeedsPreviewScrapeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsPreviewScrape")
+	}
 
-func (response TagsDelete500JSONResponse) VisitTagsDeleteResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsPreviewScrapeResponseObject); ok {
+		if err := validResponse.VisitFeedsPreviewScrapeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type URLRulesListRequestObject struct {
-}
+// FeedsSuspend operation middleware
+func (sh *strictHandler) FeedsSuspend(w http.ResponseWriter, r *http.Request) {
+	var request FeedsSuspendRequestObject
 
-type URLRulesListResponseObject interface {
-	VisitURLRulesListResponse(w http.ResponseWriter) error
-}
+	var body FeedsSuspendJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type URLRulesList200JSONResponse ListURLParsingRulesResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsSuspend(ctx, request.(FeedsSuspendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsSuspend")
+	}
 
-func (response URLRulesList200JSONResponse) VisitURLRulesListResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsSuspendResponseObject); ok {
+		if err := validResponse.VisitFeedsSuspendResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type URLRulesList500JSONResponse ApiError
+// FeedsSetUnreadOnRevision operation middleware
+func (sh *strictHandler) FeedsSetUnreadOnRevision(w http.ResponseWriter, r *http.Request) {
+	var request FeedsSetUnreadOnRevisionRequestObject
 
-func (response URLRulesList500JSONResponse) VisitURLRulesListResponse(w http.ResponseWriter) error {
+	var body FeedsSetUnreadOnRevisionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsSetUnreadOnRevision(ctx, request.(FeedsSetUnreadOnRevisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsSetUnreadOnRevision")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type URLRulesAddRequestObject struct {
-	Body *URLRulesAddJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type URLRulesAddResponseObject interface {
-	VisitURLRulesAddResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsSetUnreadOnRevisionResponseObject); ok {
+		if err := validResponse.VisitFeedsSetUnreadOnRevisionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type URLRulesAdd200JSONResponse AddURLParsingRuleResponse
+// FeedsDelete operation middleware
+func (sh *strictHandler) FeedsDelete(w http.ResponseWriter, r *http.Request, id string) {
+	var request FeedsDeleteRequestObject
 
-func (response URLRulesAdd200JSONResponse) VisitURLRulesAddResponse(w http.ResponseWriter) error {
+	request.Id = id
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsDelete(ctx, request.(FeedsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsDeleteResponseObject); ok {
+		if err := validResponse.VisitFeedsDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-type URLRulesAdd500JSONResponse ApiError
+// FeedsDeleteCredentials operation middleware
+func (sh *strictHandler) FeedsDeleteCredentials(w http.ResponseWriter, r *http.Request, id string) {
+	var request FeedsDeleteCredentialsRequestObject
 
-func (response URLRulesAdd500JSONResponse) VisitURLRulesAddResponse(w http.ResponseWriter) error {
+	request.Id = id
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsDeleteCredentials(ctx, request.(FeedsDeleteCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsDeleteCredentials")
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type URLRulesDeleteRequestObject struct {
-	Id string `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type URLRulesDeleteResponseObject interface {
-	VisitURLRulesDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsDeleteCredentialsResponseObject); ok {
+		if err := validResponse.VisitFeedsDeleteCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type URLRulesDelete200Response struct {
-}
+// FeedsGetCredentials operation middleware
+func (sh *strictHandler) FeedsGetCredentials(w http.ResponseWriter, r *http.Request, id string) {
+	var request FeedsGetCredentialsRequestObject
 
-func (response URLRulesDelete200Response) VisitURLRulesDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.Id = id
 
-type URLRulesDelete500JSONResponse ApiError
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsGetCredentials(ctx, request.(FeedsGetCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsGetCredentials")
+	}
 
-func (response URLRulesDelete500JSONResponse) VisitURLRulesDeleteResponse(w http.ResponseWriter) error {
+	response, err := handler(r.Context(), w, r, request)
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsGetCredentialsResponseObject); ok {
+		if err := validResponse.VisitFeedsGetCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-
-	// (GET /block-rules)
-	BlockRulesList(ctx context.Context, request BlockRulesListRequestObject) (BlockRulesListResponseObject, error)
+// FeedsSetCredentials operation middleware
+func (sh *strictHandler) FeedsSetCredentials(w http.ResponseWriter, r *http.Request, id string) {
+	var request FeedsSetCredentialsRequestObject
 
-	// (POST /block-rules)
-	BlockRulesAdd(ctx context.Context, request BlockRulesAddRequestObject) (BlockRulesAddResponseObject, error)
+	request.Id = id
 
-	// (DELETE /block-rules/{id})
-	BlockRulesDelete(ctx context.Context, request BlockRulesDeleteRequestObject) (BlockRulesDeleteResponseObject, error)
+	var body FeedsSetCredentialsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /feed-ignore-windows)
-	FeedIgnoreWindowsList(ctx context.Context, request FeedIgnoreWindowsListRequestObject) (FeedIgnoreWindowsListResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsSetCredentials(ctx, request.(FeedsSetCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsSetCredentials")
+	}
 
-	// (POST /feed-ignore-windows/manage)
-	FeedIgnoreWindowsManage(ctx context.Context, request FeedIgnoreWindowsManageRequestObject) (FeedIgnoreWindowsManageResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /feed-tags)
-	FeedTagsList(ctx context.Context, request FeedTagsListRequestObject) (FeedTagsListResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsSetCredentialsResponseObject); ok {
+		if err := validResponse.VisitFeedsSetCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /feed-tags/manage)
-	FeedTagsManage(ctx context.Context, request FeedTagsManageRequestObject) (FeedTagsManageResponseObject, error)
+// FeedsGetIcon operation middleware
+func (sh *strictHandler) FeedsGetIcon(w http.ResponseWriter, r *http.Request, id string) {
+	var request FeedsGetIconRequestObject
 
-	// (GET /feeds)
-	FeedsList(ctx context.Context, request FeedsListRequestObject) (FeedsListResponseObject, error)
+	request.Id = id
 
-	// (POST /feeds)
-	FeedsCreate(ctx context.Context, request FeedsCreateRequestObject) (FeedsCreateResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsGetIcon(ctx, request.(FeedsGetIconRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsGetIcon")
+	}
 
-	// (POST /feeds/export-opml)
-	FeedsExportOpml(ctx context.Context, request FeedsExportOpmlRequestObject) (FeedsExportOpmlResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /feeds/import-opml)
-	FeedsImportOpml(ctx context.Context, request FeedsImportOpmlRequestObject) (FeedsImportOpmlResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsGetIconResponseObject); ok {
+		if err := validResponse.VisitFeedsGetIconResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /feeds/refresh)
-	FeedsRefresh(ctx context.Context, request FeedsRefreshRequestObject) (FeedsRefreshResponseObject, error)
+// FeedsGetScraper operation middleware
+func (sh *strictHandler) FeedsGetScraper(w http.ResponseWriter, r *http.Request, id string) {
+	var request FeedsGetScraperRequestObject
 
-	// (POST /feeds/suspend)
-	FeedsSuspend(ctx context.Context, request FeedsSuspendRequestObject) (FeedsSuspendResponseObject, error)
+	request.Id = id
 
-	// (DELETE /feeds/{id})
-	FeedsDelete(ctx context.Context, request FeedsDeleteRequestObject) (FeedsDeleteResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsGetScraper(ctx, request.(FeedsGetScraperRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsGetScraper")
+	}
 
-	// (GET /ignore-windows)
-	IgnoreWindowsList(ctx context.Context, request IgnoreWindowsListRequestObject) (IgnoreWindowsListResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /ignore-windows)
-	IgnoreWindowsCreate(ctx context.Context, request IgnoreWindowsCreateRequestObject) (IgnoreWindowsCreateResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsGetScraperResponseObject); ok {
+		if err := validResponse.VisitFeedsGetScraperResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /ignore-windows/{id})
-	IgnoreWindowsDelete(ctx context.Context, request IgnoreWindowsDeleteRequestObject) (IgnoreWindowsDeleteResponseObject, error)
+// FeedsSetScraper operation middleware
+func (sh *strictHandler) FeedsSetScraper(w http.ResponseWriter, r *http.Request, id string) {
+	var request FeedsSetScraperRequestObject
 
-	// (PUT /ignore-windows/{id})
-	IgnoreWindowsUpdate(ctx context.Context, request IgnoreWindowsUpdateRequestObject) (IgnoreWindowsUpdateResponseObject, error)
+	request.Id = id
 
-	// (GET /item-reads)
-	ItemReadsList(ctx context.Context, request ItemReadsListRequestObject) (ItemReadsListResponseObject, error)
+	var body FeedsSetScraperJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /items)
-	ItemsList(ctx context.Context, request ItemsListRequestObject) (ItemsListResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeedsSetScraper(ctx, request.(FeedsSetScraperRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeedsSetScraper")
+	}
 
-	// (POST /items/status)
-	ItemsUpdateStatus(ctx context.Context, request ItemsUpdateStatusRequestObject) (ItemsUpdateStatusResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /items/{id})
-	ItemsGet(ctx context.Context, request ItemsGetRequestObject) (ItemsGetResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeedsSetScraperResponseObject); ok {
+		if err := validResponse.VisitFeedsSetScraperResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /tag-ignore-windows)
-	TagIgnoreWindowsList(ctx context.Context, request TagIgnoreWindowsListRequestObject) (TagIgnoreWindowsListResponseObject, error)
+// FetchQueueGet operation middleware
+func (sh *strictHandler) FetchQueueGet(w http.ResponseWriter, r *http.Request) {
+	var request FetchQueueGetRequestObject
 
-	// (POST /tag-ignore-windows/manage)
-	TagIgnoreWindowsManage(ctx context.Context, request TagIgnoreWindowsManageRequestObject) (TagIgnoreWindowsManageResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FetchQueueGet(ctx, request.(FetchQueueGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FetchQueueGet")
+	}
 
-	// (GET /tags)
-	TagsList(ctx context.Context, request TagsListRequestObject) (TagsListResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /tags)
-	TagsCreate(ctx context.Context, request TagsCreateRequestObject) (TagsCreateResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FetchQueueGetResponseObject); ok {
+		if err := validResponse.VisitFetchQueueGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /tags/{id})
-	TagsDelete(ctx context.Context, request TagsDeleteRequestObject) (TagsDeleteResponseObject, error)
+// IgnoreWindowsList operation middleware
+func (sh *strictHandler) IgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
+	var request IgnoreWindowsListRequestObject
 
-	// (GET /url-rules)
-	URLRulesList(ctx context.Context, request URLRulesListRequestObject) (URLRulesListResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IgnoreWindowsList(ctx, request.(IgnoreWindowsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IgnoreWindowsList")
+	}
 
-	// (POST /url-rules)
-	URLRulesAdd(ctx context.Context, request URLRulesAddRequestObject) (URLRulesAddResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (DELETE /url-rules/{id})
-	URLRulesDelete(ctx context.Context, request URLRulesDeleteRequestObject) (URLRulesDeleteResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IgnoreWindowsListResponseObject); ok {
+		if err := validResponse.VisitIgnoreWindowsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error)
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+// IgnoreWindowsCreate operation middleware
+func (sh *strictHandler) IgnoreWindowsCreate(w http.ResponseWriter, r *http.Request) {
+	var request IgnoreWindowsCreateRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body IgnoreWindowsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IgnoreWindowsCreate(ctx, request.(IgnoreWindowsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IgnoreWindowsCreate")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IgnoreWindowsCreateResponseObject); ok {
+		if err := validResponse.VisitIgnoreWindowsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// BlockRulesList operation middleware
-func (sh *strictHandler) BlockRulesList(w http.ResponseWriter, r *http.Request) {
-	var request BlockRulesListRequestObject
+// IgnoreWindowsDelete operation middleware
+func (sh *strictHandler) IgnoreWindowsDelete(w http.ResponseWriter, r *http.Request, id string) {
+	var request IgnoreWindowsDeleteRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.BlockRulesList(ctx, request.(BlockRulesListRequestObject))
+		return sh.ssi.IgnoreWindowsDelete(ctx, request.(IgnoreWindowsDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BlockRulesList")
+		handler = middleware(handler, "IgnoreWindowsDelete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(BlockRulesListResponseObject); ok {
-		if err := validResponse.VisitBlockRulesListResponse(w); err != nil {
+	} else if validResponse, ok := response.(IgnoreWindowsDeleteResponseObject); ok {
+		if err := validResponse.VisitIgnoreWindowsDeleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2579,11 +6559,13 @@ func (sh *strictHandler) BlockRulesList(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// BlockRulesAdd operation middleware
-func (sh *strictHandler) BlockRulesAdd(w http.ResponseWriter, r *http.Request) {
-	var request BlockRulesAddRequestObject
+// IgnoreWindowsUpdate operation middleware
+func (sh *strictHandler) IgnoreWindowsUpdate(w http.ResponseWriter, r *http.Request, id string) {
+	var request IgnoreWindowsUpdateRequestObject
 
-	var body BlockRulesAddJSONRequestBody
+	request.Id = id
+
+	var body IgnoreWindowsUpdateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2591,18 +6573,18 @@ func (sh *strictHandler) BlockRulesAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.BlockRulesAdd(ctx, request.(BlockRulesAddRequestObject))
+		return sh.ssi.IgnoreWindowsUpdate(ctx, request.(IgnoreWindowsUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BlockRulesAdd")
+		handler = middleware(handler, "IgnoreWindowsUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(BlockRulesAddResponseObject); ok {
-		if err := validResponse.VisitBlockRulesAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(IgnoreWindowsUpdateResponseObject); ok {
+		if err := validResponse.VisitIgnoreWindowsUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2610,25 +6592,25 @@ func (sh *strictHandler) BlockRulesAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// BlockRulesDelete operation middleware
-func (sh *strictHandler) BlockRulesDelete(w http.ResponseWriter, r *http.Request, id string) {
-	var request BlockRulesDeleteRequestObject
+// ItemReadsList operation middleware
+func (sh *strictHandler) ItemReadsList(w http.ResponseWriter, r *http.Request, params ItemReadsListParams) {
+	var request ItemReadsListRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.BlockRulesDelete(ctx, request.(BlockRulesDeleteRequestObject))
+		return sh.ssi.ItemReadsList(ctx, request.(ItemReadsListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BlockRulesDelete")
+		handler = middleware(handler, "ItemReadsList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(BlockRulesDeleteResponseObject); ok {
-		if err := validResponse.VisitBlockRulesDeleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemReadsListResponseObject); ok {
+		if err := validResponse.VisitItemReadsListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2636,25 +6618,25 @@ func (sh *strictHandler) BlockRulesDelete(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// FeedIgnoreWindowsList operation middleware
-func (sh *strictHandler) FeedIgnoreWindowsList(w http.ResponseWriter, r *http.Request, params FeedIgnoreWindowsListParams) {
-	var request FeedIgnoreWindowsListRequestObject
+// ItemStarsList operation middleware
+func (sh *strictHandler) ItemStarsList(w http.ResponseWriter, r *http.Request, params ItemStarsListParams) {
+	var request ItemStarsListRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedIgnoreWindowsList(ctx, request.(FeedIgnoreWindowsListRequestObject))
+		return sh.ssi.ItemStarsList(ctx, request.(ItemStarsListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedIgnoreWindowsList")
+		handler = middleware(handler, "ItemStarsList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedIgnoreWindowsListResponseObject); ok {
-		if err := validResponse.VisitFeedIgnoreWindowsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemStarsListResponseObject); ok {
+		if err := validResponse.VisitItemStarsListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2662,30 +6644,25 @@ func (sh *strictHandler) FeedIgnoreWindowsList(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// FeedIgnoreWindowsManage operation middleware
-func (sh *strictHandler) FeedIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
-	var request FeedIgnoreWindowsManageRequestObject
+// ItemsList operation middleware
+func (sh *strictHandler) ItemsList(w http.ResponseWriter, r *http.Request, params ItemsListParams) {
+	var request ItemsListRequestObject
 
-	var body FeedIgnoreWindowsManageJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedIgnoreWindowsManage(ctx, request.(FeedIgnoreWindowsManageRequestObject))
+		return sh.ssi.ItemsList(ctx, request.(ItemsListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedIgnoreWindowsManage")
+		handler = middleware(handler, "ItemsList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedIgnoreWindowsManageResponseObject); ok {
-		if err := validResponse.VisitFeedIgnoreWindowsManageResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemsListResponseObject); ok {
+		if err := validResponse.VisitItemsListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2693,25 +6670,25 @@ func (sh *strictHandler) FeedIgnoreWindowsManage(w http.ResponseWriter, r *http.
 	}
 }
 
-// FeedTagsList operation middleware
-func (sh *strictHandler) FeedTagsList(w http.ResponseWriter, r *http.Request, params FeedTagsListParams) {
-	var request FeedTagsListRequestObject
+// ItemsSearch operation middleware
+func (sh *strictHandler) ItemsSearch(w http.ResponseWriter, r *http.Request, params ItemsSearchParams) {
+	var request ItemsSearchRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedTagsList(ctx, request.(FeedTagsListRequestObject))
+		return sh.ssi.ItemsSearch(ctx, request.(ItemsSearchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedTagsList")
+		handler = middleware(handler, "ItemsSearch")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedTagsListResponseObject); ok {
-		if err := validResponse.VisitFeedTagsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemsSearchResponseObject); ok {
+		if err := validResponse.VisitItemsSearchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2719,11 +6696,11 @@ func (sh *strictHandler) FeedTagsList(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// FeedTagsManage operation middleware
-func (sh *strictHandler) FeedTagsManage(w http.ResponseWriter, r *http.Request) {
-	var request FeedTagsManageRequestObject
+// ItemsUpdateStatus operation middleware
+func (sh *strictHandler) ItemsUpdateStatus(w http.ResponseWriter, r *http.Request) {
+	var request ItemsUpdateStatusRequestObject
 
-	var body FeedTagsManageJSONRequestBody
+	var body ItemsUpdateStatusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2731,18 +6708,18 @@ func (sh *strictHandler) FeedTagsManage(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedTagsManage(ctx, request.(FeedTagsManageRequestObject))
+		return sh.ssi.ItemsUpdateStatus(ctx, request.(ItemsUpdateStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedTagsManage")
+		handler = middleware(handler, "ItemsUpdateStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedTagsManageResponseObject); ok {
-		if err := validResponse.VisitFeedTagsManageResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemsUpdateStatusResponseObject); ok {
+		if err := validResponse.VisitItemsUpdateStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2750,25 +6727,78 @@ func (sh *strictHandler) FeedTagsManage(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// FeedsList operation middleware
-func (sh *strictHandler) FeedsList(w http.ResponseWriter, r *http.Request, params FeedsListParams) {
-	var request FeedsListRequestObject
+// ItemsGet operation middleware
+func (sh *strictHandler) ItemsGet(w http.ResponseWriter, r *http.Request, id string, params ItemsGetParams) {
+	var request ItemsGetRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedsList(ctx, request.(FeedsListRequestObject))
+		return sh.ssi.ItemsGet(ctx, request.(ItemsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemsGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ItemsGetResponseObject); ok {
+		if err := validResponse.VisitItemsGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ItemsExtractContent operation middleware
+func (sh *strictHandler) ItemsExtractContent(w http.ResponseWriter, r *http.Request, id string) {
+	var request ItemsExtractContentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemsExtractContent(ctx, request.(ItemsExtractContentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemsExtractContent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ItemsExtractContentResponseObject); ok {
+		if err := validResponse.VisitItemsExtractContentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ItemsListRevisions operation middleware
+func (sh *strictHandler) ItemsListRevisions(w http.ResponseWriter, r *http.Request, id string) {
+	var request ItemsListRevisionsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemsListRevisions(ctx, request.(ItemsListRevisionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedsList")
+		handler = middleware(handler, "ItemsListRevisions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedsListResponseObject); ok {
-		if err := validResponse.VisitFeedsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemsListRevisionsResponseObject); ok {
+		if err := validResponse.VisitItemsListRevisionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2776,30 +6806,26 @@ func (sh *strictHandler) FeedsList(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// FeedsCreate operation middleware
-func (sh *strictHandler) FeedsCreate(w http.ResponseWriter, r *http.Request) {
-	var request FeedsCreateRequestObject
+// ItemsDiffRevisions operation middleware
+func (sh *strictHandler) ItemsDiffRevisions(w http.ResponseWriter, r *http.Request, id string, params ItemsDiffRevisionsParams) {
+	var request ItemsDiffRevisionsRequestObject
 
-	var body FeedsCreateJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedsCreate(ctx, request.(FeedsCreateRequestObject))
+		return sh.ssi.ItemsDiffRevisions(ctx, request.(ItemsDiffRevisionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedsCreate")
+		handler = middleware(handler, "ItemsDiffRevisions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedsCreateResponseObject); ok {
-		if err := validResponse.VisitFeedsCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemsDiffRevisionsResponseObject); ok {
+		if err := validResponse.VisitItemsDiffRevisionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2807,30 +6833,25 @@ func (sh *strictHandler) FeedsCreate(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// FeedsExportOpml operation middleware
-func (sh *strictHandler) FeedsExportOpml(w http.ResponseWriter, r *http.Request) {
-	var request FeedsExportOpmlRequestObject
+// ItemsUnstar operation middleware
+func (sh *strictHandler) ItemsUnstar(w http.ResponseWriter, r *http.Request, id string) {
+	var request ItemsUnstarRequestObject
 
-	var body FeedsExportOpmlJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedsExportOpml(ctx, request.(FeedsExportOpmlRequestObject))
+		return sh.ssi.ItemsUnstar(ctx, request.(ItemsUnstarRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedsExportOpml")
+		handler = middleware(handler, "ItemsUnstar")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedsExportOpmlResponseObject); ok {
-		if err := validResponse.VisitFeedsExportOpmlResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemsUnstarResponseObject); ok {
+		if err := validResponse.VisitItemsUnstarResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2838,11 +6859,13 @@ func (sh *strictHandler) FeedsExportOpml(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// FeedsImportOpml operation middleware
-func (sh *strictHandler) FeedsImportOpml(w http.ResponseWriter, r *http.Request) {
-	var request FeedsImportOpmlRequestObject
+// ItemsStar operation middleware
+func (sh *strictHandler) ItemsStar(w http.ResponseWriter, r *http.Request, id string) {
+	var request ItemsStarRequestObject
 
-	var body FeedsImportOpmlJSONRequestBody
+	request.Id = id
+
+	var body ItemsStarJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2850,18 +6873,18 @@ func (sh *strictHandler) FeedsImportOpml(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedsImportOpml(ctx, request.(FeedsImportOpmlRequestObject))
+		return sh.ssi.ItemsStar(ctx, request.(ItemsStarRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedsImportOpml")
+		handler = middleware(handler, "ItemsStar")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedsImportOpmlResponseObject); ok {
-		if err := validResponse.VisitFeedsImportOpmlResponse(w); err != nil {
+	} else if validResponse, ok := response.(ItemsStarResponseObject); ok {
+		if err := validResponse.VisitItemsStarResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2869,30 +6892,23 @@ func (sh *strictHandler) FeedsImportOpml(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// FeedsRefresh operation middleware
-func (sh *strictHandler) FeedsRefresh(w http.ResponseWriter, r *http.Request) {
-	var request FeedsRefreshRequestObject
-
-	var body FeedsRefreshJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// SavedSearchesList operation middleware
+func (sh *strictHandler) SavedSearchesList(w http.ResponseWriter, r *http.Request) {
+	var request SavedSearchesListRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedsRefresh(ctx, request.(FeedsRefreshRequestObject))
+		return sh.ssi.SavedSearchesList(ctx, request.(SavedSearchesListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedsRefresh")
+		handler = middleware(handler, "SavedSearchesList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedsRefreshResponseObject); ok {
-		if err := validResponse.VisitFeedsRefreshResponse(w); err != nil {
+	} else if validResponse, ok := response.(SavedSearchesListResponseObject); ok {
+		if err := validResponse.VisitSavedSearchesListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2900,11 +6916,11 @@ func (sh *strictHandler) FeedsRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// FeedsSuspend operation middleware
-func (sh *strictHandler) FeedsSuspend(w http.ResponseWriter, r *http.Request) {
-	var request FeedsSuspendRequestObject
+// SavedSearchesCreate operation middleware
+func (sh *strictHandler) SavedSearchesCreate(w http.ResponseWriter, r *http.Request) {
+	var request SavedSearchesCreateRequestObject
 
-	var body FeedsSuspendJSONRequestBody
+	var body SavedSearchesCreateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2912,18 +6928,18 @@ func (sh *strictHandler) FeedsSuspend(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedsSuspend(ctx, request.(FeedsSuspendRequestObject))
+		return sh.ssi.SavedSearchesCreate(ctx, request.(SavedSearchesCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedsSuspend")
+		handler = middleware(handler, "SavedSearchesCreate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedsSuspendResponseObject); ok {
-		if err := validResponse.VisitFeedsSuspendResponse(w); err != nil {
+	} else if validResponse, ok := response.(SavedSearchesCreateResponseObject); ok {
+		if err := validResponse.VisitSavedSearchesCreateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2931,25 +6947,25 @@ func (sh *strictHandler) FeedsSuspend(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// FeedsDelete operation middleware
-func (sh *strictHandler) FeedsDelete(w http.ResponseWriter, r *http.Request, id string) {
-	var request FeedsDeleteRequestObject
+// SavedSearchesDelete operation middleware
+func (sh *strictHandler) SavedSearchesDelete(w http.ResponseWriter, r *http.Request, id string) {
+	var request SavedSearchesDeleteRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FeedsDelete(ctx, request.(FeedsDeleteRequestObject))
+		return sh.ssi.SavedSearchesDelete(ctx, request.(SavedSearchesDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FeedsDelete")
+		handler = middleware(handler, "SavedSearchesDelete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FeedsDeleteResponseObject); ok {
-		if err := validResponse.VisitFeedsDeleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(SavedSearchesDeleteResponseObject); ok {
+		if err := validResponse.VisitSavedSearchesDeleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2957,23 +6973,25 @@ func (sh *strictHandler) FeedsDelete(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// IgnoreWindowsList operation middleware
-func (sh *strictHandler) IgnoreWindowsList(w http.ResponseWriter, r *http.Request) {
-	var request IgnoreWindowsListRequestObject
+// SavedSearchesGet operation middleware
+func (sh *strictHandler) SavedSearchesGet(w http.ResponseWriter, r *http.Request, id string) {
+	var request SavedSearchesGetRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.IgnoreWindowsList(ctx, request.(IgnoreWindowsListRequestObject))
+		return sh.ssi.SavedSearchesGet(ctx, request.(SavedSearchesGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "IgnoreWindowsList")
+		handler = middleware(handler, "SavedSearchesGet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(IgnoreWindowsListResponseObject); ok {
-		if err := validResponse.VisitIgnoreWindowsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(SavedSearchesGetResponseObject); ok {
+		if err := validResponse.VisitSavedSearchesGetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2981,11 +6999,13 @@ func (sh *strictHandler) IgnoreWindowsList(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// IgnoreWindowsCreate operation middleware
-func (sh *strictHandler) IgnoreWindowsCreate(w http.ResponseWriter, r *http.Request) {
-	var request IgnoreWindowsCreateRequestObject
+// SavedSearchesUpdate operation middleware
+func (sh *strictHandler) SavedSearchesUpdate(w http.ResponseWriter, r *http.Request, id string) {
+	var request SavedSearchesUpdateRequestObject
 
-	var body IgnoreWindowsCreateJSONRequestBody
+	request.Id = id
+
+	var body SavedSearchesUpdateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2993,18 +7013,18 @@ func (sh *strictHandler) IgnoreWindowsCreate(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.IgnoreWindowsCreate(ctx, request.(IgnoreWindowsCreateRequestObject))
+		return sh.ssi.SavedSearchesUpdate(ctx, request.(SavedSearchesUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "IgnoreWindowsCreate")
+		handler = middleware(handler, "SavedSearchesUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(IgnoreWindowsCreateResponseObject); ok {
-		if err := validResponse.VisitIgnoreWindowsCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(SavedSearchesUpdateResponseObject); ok {
+		if err := validResponse.VisitSavedSearchesUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3012,25 +7032,25 @@ func (sh *strictHandler) IgnoreWindowsCreate(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// IgnoreWindowsDelete operation middleware
-func (sh *strictHandler) IgnoreWindowsDelete(w http.ResponseWriter, r *http.Request, id string) {
-	var request IgnoreWindowsDeleteRequestObject
+// TagIgnoreWindowsList operation middleware
+func (sh *strictHandler) TagIgnoreWindowsList(w http.ResponseWriter, r *http.Request, params TagIgnoreWindowsListParams) {
+	var request TagIgnoreWindowsListRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.IgnoreWindowsDelete(ctx, request.(IgnoreWindowsDeleteRequestObject))
+		return sh.ssi.TagIgnoreWindowsList(ctx, request.(TagIgnoreWindowsListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "IgnoreWindowsDelete")
+		handler = middleware(handler, "TagIgnoreWindowsList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(IgnoreWindowsDeleteResponseObject); ok {
-		if err := validResponse.VisitIgnoreWindowsDeleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(TagIgnoreWindowsListResponseObject); ok {
+		if err := validResponse.VisitTagIgnoreWindowsListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3038,13 +7058,11 @@ func (sh *strictHandler) IgnoreWindowsDelete(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// IgnoreWindowsUpdate operation middleware
-func (sh *strictHandler) IgnoreWindowsUpdate(w http.ResponseWriter, r *http.Request, id string) {
-	var request IgnoreWindowsUpdateRequestObject
-
-	request.Id = id
+// TagIgnoreWindowsManage operation middleware
+func (sh *strictHandler) TagIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
+	var request TagIgnoreWindowsManageRequestObject
 
-	var body IgnoreWindowsUpdateJSONRequestBody
+	var body TagIgnoreWindowsManageJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3052,18 +7070,18 @@ func (sh *strictHandler) IgnoreWindowsUpdate(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.IgnoreWindowsUpdate(ctx, request.(IgnoreWindowsUpdateRequestObject))
+		return sh.ssi.TagIgnoreWindowsManage(ctx, request.(TagIgnoreWindowsManageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "IgnoreWindowsUpdate")
+		handler = middleware(handler, "TagIgnoreWindowsManage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(IgnoreWindowsUpdateResponseObject); ok {
-		if err := validResponse.VisitIgnoreWindowsUpdateResponse(w); err != nil {
+	} else if validResponse, ok := response.(TagIgnoreWindowsManageResponseObject); ok {
+		if err := validResponse.VisitTagIgnoreWindowsManageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3071,25 +7089,25 @@ func (sh *strictHandler) IgnoreWindowsUpdate(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// ItemReadsList operation middleware
-func (sh *strictHandler) ItemReadsList(w http.ResponseWriter, r *http.Request, params ItemReadsListParams) {
-	var request ItemReadsListRequestObject
+// TagTransportProfilesList operation middleware
+func (sh *strictHandler) TagTransportProfilesList(w http.ResponseWriter, r *http.Request, params TagTransportProfilesListParams) {
+	var request TagTransportProfilesListRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemReadsList(ctx, request.(ItemReadsListRequestObject))
+		return sh.ssi.TagTransportProfilesList(ctx, request.(TagTransportProfilesListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemReadsList")
+		handler = middleware(handler, "TagTransportProfilesList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ItemReadsListResponseObject); ok {
-		if err := validResponse.VisitItemReadsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(TagTransportProfilesListResponseObject); ok {
+		if err := validResponse.VisitTagTransportProfilesListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3097,25 +7115,30 @@ func (sh *strictHandler) ItemReadsList(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// ItemsList operation middleware
-func (sh *strictHandler) ItemsList(w http.ResponseWriter, r *http.Request, params ItemsListParams) {
-	var request ItemsListRequestObject
+// TagTransportProfilesAssign operation middleware
+func (sh *strictHandler) TagTransportProfilesAssign(w http.ResponseWriter, r *http.Request) {
+	var request TagTransportProfilesAssignRequestObject
 
-	request.Params = params
+	var body TagTransportProfilesAssignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemsList(ctx, request.(ItemsListRequestObject))
+		return sh.ssi.TagTransportProfilesAssign(ctx, request.(TagTransportProfilesAssignRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemsList")
+		handler = middleware(handler, "TagTransportProfilesAssign")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ItemsListResponseObject); ok {
-		if err := validResponse.VisitItemsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(TagTransportProfilesAssignResponseObject); ok {
+		if err := validResponse.VisitTagTransportProfilesAssignResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3123,30 +7146,23 @@ func (sh *strictHandler) ItemsList(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// ItemsUpdateStatus operation middleware
-func (sh *strictHandler) ItemsUpdateStatus(w http.ResponseWriter, r *http.Request) {
-	var request ItemsUpdateStatusRequestObject
-
-	var body ItemsUpdateStatusJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// TagsList operation middleware
+func (sh *strictHandler) TagsList(w http.ResponseWriter, r *http.Request) {
+	var request TagsListRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemsUpdateStatus(ctx, request.(ItemsUpdateStatusRequestObject))
+		return sh.ssi.TagsList(ctx, request.(TagsListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemsUpdateStatus")
+		handler = middleware(handler, "TagsList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ItemsUpdateStatusResponseObject); ok {
-		if err := validResponse.VisitItemsUpdateStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(TagsListResponseObject); ok {
+		if err := validResponse.VisitTagsListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3154,25 +7170,30 @@ func (sh *strictHandler) ItemsUpdateStatus(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// ItemsGet operation middleware
-func (sh *strictHandler) ItemsGet(w http.ResponseWriter, r *http.Request, id string) {
-	var request ItemsGetRequestObject
+// TagsCreate operation middleware
+func (sh *strictHandler) TagsCreate(w http.ResponseWriter, r *http.Request) {
+	var request TagsCreateRequestObject
 
-	request.Id = id
+	var body TagsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemsGet(ctx, request.(ItemsGetRequestObject))
+		return sh.ssi.TagsCreate(ctx, request.(TagsCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemsGet")
+		handler = middleware(handler, "TagsCreate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ItemsGetResponseObject); ok {
-		if err := validResponse.VisitItemsGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(TagsCreateResponseObject); ok {
+		if err := validResponse.VisitTagsCreateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3180,25 +7201,25 @@ func (sh *strictHandler) ItemsGet(w http.ResponseWriter, r *http.Request, id str
 	}
 }
 
-// TagIgnoreWindowsList operation middleware
-func (sh *strictHandler) TagIgnoreWindowsList(w http.ResponseWriter, r *http.Request, params TagIgnoreWindowsListParams) {
-	var request TagIgnoreWindowsListRequestObject
+// TagsDelete operation middleware
+func (sh *strictHandler) TagsDelete(w http.ResponseWriter, r *http.Request, id string) {
+	var request TagsDeleteRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.TagIgnoreWindowsList(ctx, request.(TagIgnoreWindowsListRequestObject))
+		return sh.ssi.TagsDelete(ctx, request.(TagsDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TagIgnoreWindowsList")
+		handler = middleware(handler, "TagsDelete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(TagIgnoreWindowsListResponseObject); ok {
-		if err := validResponse.VisitTagIgnoreWindowsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(TagsDeleteResponseObject); ok {
+		if err := validResponse.VisitTagsDeleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3206,30 +7227,23 @@ func (sh *strictHandler) TagIgnoreWindowsList(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// TagIgnoreWindowsManage operation middleware
-func (sh *strictHandler) TagIgnoreWindowsManage(w http.ResponseWriter, r *http.Request) {
-	var request TagIgnoreWindowsManageRequestObject
-
-	var body TagIgnoreWindowsManageJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// TransportProfilesList operation middleware
+func (sh *strictHandler) TransportProfilesList(w http.ResponseWriter, r *http.Request) {
+	var request TransportProfilesListRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.TagIgnoreWindowsManage(ctx, request.(TagIgnoreWindowsManageRequestObject))
+		return sh.ssi.TransportProfilesList(ctx, request.(TransportProfilesListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TagIgnoreWindowsManage")
+		handler = middleware(handler, "TransportProfilesList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(TagIgnoreWindowsManageResponseObject); ok {
-		if err := validResponse.VisitTagIgnoreWindowsManageResponse(w); err != nil {
+	} else if validResponse, ok := response.(TransportProfilesListResponseObject); ok {
+		if err := validResponse.VisitTransportProfilesListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3237,23 +7251,30 @@ func (sh *strictHandler) TagIgnoreWindowsManage(w http.ResponseWriter, r *http.R
 	}
 }
 
-// TagsList operation middleware
-func (sh *strictHandler) TagsList(w http.ResponseWriter, r *http.Request) {
-	var request TagsListRequestObject
+// TransportProfilesCreate operation middleware
+func (sh *strictHandler) TransportProfilesCreate(w http.ResponseWriter, r *http.Request) {
+	var request TransportProfilesCreateRequestObject
+
+	var body TransportProfilesCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.TagsList(ctx, request.(TagsListRequestObject))
+		return sh.ssi.TransportProfilesCreate(ctx, request.(TransportProfilesCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TagsList")
+		handler = middleware(handler, "TransportProfilesCreate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(TagsListResponseObject); ok {
-		if err := validResponse.VisitTagsListResponse(w); err != nil {
+	} else if validResponse, ok := response.(TransportProfilesCreateResponseObject); ok {
+		if err := validResponse.VisitTransportProfilesCreateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3261,30 +7282,25 @@ func (sh *strictHandler) TagsList(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// TagsCreate operation middleware
-func (sh *strictHandler) TagsCreate(w http.ResponseWriter, r *http.Request) {
-	var request TagsCreateRequestObject
+// TransportProfilesDelete operation middleware
+func (sh *strictHandler) TransportProfilesDelete(w http.ResponseWriter, r *http.Request, id string) {
+	var request TransportProfilesDeleteRequestObject
 
-	var body TagsCreateJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.TagsCreate(ctx, request.(TagsCreateRequestObject))
+		return sh.ssi.TransportProfilesDelete(ctx, request.(TransportProfilesDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TagsCreate")
+		handler = middleware(handler, "TransportProfilesDelete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(TagsCreateResponseObject); ok {
-		if err := validResponse.VisitTagsCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(TransportProfilesDeleteResponseObject); ok {
+		if err := validResponse.VisitTransportProfilesDeleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3292,25 +7308,32 @@ func (sh *strictHandler) TagsCreate(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// TagsDelete operation middleware
-func (sh *strictHandler) TagsDelete(w http.ResponseWriter, r *http.Request, id string) {
-	var request TagsDeleteRequestObject
+// TransportProfilesUpdate operation middleware
+func (sh *strictHandler) TransportProfilesUpdate(w http.ResponseWriter, r *http.Request, id string) {
+	var request TransportProfilesUpdateRequestObject
 
 	request.Id = id
 
+	var body TransportProfilesUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.TagsDelete(ctx, request.(TagsDeleteRequestObject))
+		return sh.ssi.TransportProfilesUpdate(ctx, request.(TransportProfilesUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TagsDelete")
+		handler = middleware(handler, "TransportProfilesUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(TagsDeleteResponseObject); ok {
-		if err := validResponse.VisitTagsDeleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(TransportProfilesUpdateResponseObject); ok {
+		if err := validResponse.VisitTransportProfilesUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
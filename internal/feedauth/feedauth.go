@@ -0,0 +1,173 @@
+// Package feedauth holds per-feed request credentials and encrypts them at rest.
+package feedauth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/http/httpguts"
+)
+
+const (
+	AuthTypeNone   = "none"
+	AuthTypeBasic  = "basic"
+	AuthTypeBearer = "bearer"
+)
+
+// KeySize is the length of the AES-256 key used to encrypt credentials.
+const KeySize = 32
+
+// ErrNoKey is returned when credentials are stored but no key is configured.
+var ErrNoKey = errors.New("feed credentials key is not configured")
+
+// reservedHeaders are managed by net/http and cannot be overridden per feed.
+var reservedHeaders = map[string]struct{}{
+	"Host":              {},
+	"Content-Length":    {},
+	"Transfer-Encoding": {},
+	"Connection":        {},
+}
+
+// Credentials are sent with every request for a feed.
+type Credentials struct {
+	AuthType string            `json:"authType"`
+	Username string            `json:"username,omitempty"`
+	Password string            `json:"password,omitempty"`
+	Token    string            `json:"token,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+}
+
+// Validate checks that the credentials are complete for their auth type and
+// that the extra headers can be sent.
+func (c *Credentials) Validate() error {
+	switch c.AuthType {
+	case AuthTypeNone:
+	case AuthTypeBasic:
+		if c.Username == "" {
+			return errors.New("username is required for basic auth")
+		}
+	case AuthTypeBearer:
+		if c.Token == "" {
+			return errors.New("token is required for bearer auth")
+		}
+	default:
+		return fmt.Errorf("unsupported auth type %q", c.AuthType)
+	}
+	for name, value := range c.Headers {
+		if !httpguts.ValidHeaderFieldName(name) {
+			return fmt.Errorf("invalid header name %q", name)
+		}
+		if !httpguts.ValidHeaderFieldValue(value) {
+			return fmt.Errorf("invalid value for header %q", name)
+		}
+		if _, ok := reservedHeaders[http.CanonicalHeaderKey(name)]; ok {
+			return fmt.Errorf("header %q cannot be set", name)
+		}
+		if c.AuthType != AuthTypeNone && strings.EqualFold(name, "Authorization") {
+			return fmt.Errorf("header %q conflicts with %s auth", name, c.AuthType)
+		}
+	}
+	return nil
+}
+
+// Apply sets the extra headers and the Authorization header on req.
+func (c *Credentials) Apply(req *http.Request) {
+	for name, value := range c.Headers {
+		req.Header.Set(name, value)
+	}
+	switch c.AuthType {
+	case AuthTypeBasic:
+		req.SetBasicAuth(c.Username, c.Password)
+	case AuthTypeBearer:
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+}
+
+// ParseKey decodes a base64 encoded key of KeySize bytes.
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", KeySize, len(key))
+	}
+	return key, nil
+}
+
+// Cipher encrypts credentials with AES-GCM.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a Cipher from a KeySize byte key.
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt returns the base64 encoded nonce and ciphertext of creds.
+func (c *Cipher) Encrypt(creds Credentials) (string, error) {
+	plaintext, err := json.Marshal(creds)
+	if err != nil {
+		return "", err
+	}
+	return c.Seal(plaintext)
+}
+
+// Decrypt reverses Encrypt.
+func (c *Cipher) Decrypt(data string) (*Credentials, error) {
+	plaintext, err := c.Open(data)
+	if err != nil {
+		return nil, err
+	}
+	var creds Credentials
+	if err := json.Unmarshal(plaintext, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials: %w", err)
+	}
+	return &creds, nil
+}
+
+// Seal encrypts an arbitrary secret and returns the base64 encoded nonce and ciphertext.
+func (c *Cipher) Seal(plaintext []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open reverses Seal.
+func (c *Cipher) Open(data string) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode credentials: %w", err)
+	}
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.New("encrypted credentials are too short")
+	}
+	plaintext, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credentials: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,105 @@
+package feedauth_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"gotest.tools/v3/assert"
+)
+
+func newTestCipher(t *testing.T, fill byte) *feedauth.Cipher {
+	t.Helper()
+	c, err := feedauth.NewCipher(bytes.Repeat([]byte{fill}, feedauth.KeySize))
+	assert.NilError(t, err)
+	return c
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	c := newTestCipher(t, 1)
+	creds := feedauth.Credentials{
+		AuthType: feedauth.AuthTypeBasic,
+		Username: "alice",
+		Password: "s3cret",
+		Headers:  map[string]string{"Cookie": "session=abc"},
+	}
+
+	encrypted, err := c.Encrypt(creds)
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(encrypted, "s3cret"))
+	assert.Assert(t, !strings.Contains(encrypted, "session=abc"))
+
+	decrypted, err := c.Decrypt(encrypted)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, *decrypted, creds)
+
+	_, err = newTestCipher(t, 2).Decrypt(encrypted)
+	assert.ErrorContains(t, err, "failed to decrypt credentials")
+}
+
+func TestParseKey(t *testing.T) {
+	key, err := feedauth.ParseKey(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, 32)))
+	assert.NilError(t, err)
+	assert.Equal(t, len(key), feedauth.KeySize)
+
+	_, err = feedauth.ParseKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.ErrorContains(t, err, "key must be 32 bytes")
+
+	_, err = feedauth.ParseKey("not base64!")
+	assert.ErrorContains(t, err, "failed to decode key")
+}
+
+func TestCredentialsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		creds   feedauth.Credentials
+		wantErr string
+	}{
+		{name: "headers only", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeNone, Headers: map[string]string{"Cookie": "a=b"}}},
+		{name: "basic", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeBasic, Username: "u"}},
+		{name: "bearer", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeBearer, Token: "t"}},
+		{name: "basic without username", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeBasic}, wantErr: "username is required"},
+		{name: "bearer without token", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeBearer}, wantErr: "token is required"},
+		{name: "unknown type", creds: feedauth.Credentials{AuthType: "digest"}, wantErr: `unsupported auth type "digest"`},
+		{name: "invalid header name", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeNone, Headers: map[string]string{"Bad Header": "x"}}, wantErr: "invalid header name"},
+		{name: "invalid header value", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeNone, Headers: map[string]string{"X-Test": "a\nb"}}, wantErr: "invalid value"},
+		{name: "reserved header", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeNone, Headers: map[string]string{"host": "x"}}, wantErr: "cannot be set"},
+		{name: "authorization with auth type", creds: feedauth.Credentials{AuthType: feedauth.AuthTypeBearer, Token: "t", Headers: map[string]string{"Authorization": "x"}}, wantErr: "conflicts with bearer auth"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.creds.Validate()
+			if tt.wantErr == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCredentialsApply(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "https://example.com/feed", nil)
+		assert.NilError(t, err)
+		creds := feedauth.Credentials{AuthType: feedauth.AuthTypeBasic, Username: "u", Password: "p", Headers: map[string]string{"X-Api-Key": "k"}}
+		creds.Apply(req)
+
+		username, password, ok := req.BasicAuth()
+		assert.Assert(t, ok)
+		assert.Equal(t, username, "u")
+		assert.Equal(t, password, "p")
+		assert.Equal(t, req.Header.Get("X-Api-Key"), "k")
+	})
+
+	t.Run("bearer", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "https://example.com/feed", nil)
+		assert.NilError(t, err)
+		creds := feedauth.Credentials{AuthType: feedauth.AuthTypeBearer, Token: "tok"}
+		creds.Apply(req)
+		assert.Equal(t, req.Header.Get("Authorization"), "Bearer tok")
+	})
+}
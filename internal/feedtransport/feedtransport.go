@@ -0,0 +1,86 @@
+// Package feedtransport configures how feeds are reached: proxies, extra CAs,
+// client certificates and per-profile timeouts and retries.
+package feedtransport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Profile is a named set of transport settings assigned to feeds or tags.
+type Profile struct {
+	ProxyURL           string
+	CABundle           string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+	// Timeout bounds a whole fetch including retries. Zero keeps the default.
+	Timeout time.Duration
+	// RetryMax overrides the number of retries when set.
+	RetryMax *int
+}
+
+var proxySchemes = map[string]struct{}{
+	"http":    {},
+	"https":   {},
+	"socks5":  {},
+	"socks5h": {},
+}
+
+// Validate reports whether the profile can be turned into a transport.
+func (p *Profile) Validate() error {
+	if p.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	if p.RetryMax != nil && *p.RetryMax < 0 {
+		return errors.New("retryMax must not be negative")
+	}
+	return p.Configure(&http.Transport{})
+}
+
+// Configure applies the proxy and TLS settings of the profile to t.
+func (p *Profile) Configure(t *http.Transport) error {
+	if p.ProxyURL != "" {
+		proxyURL, err := url.Parse(p.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if _, ok := proxySchemes[proxyURL.Scheme]; !ok || proxyURL.Host == "" {
+			return fmt.Errorf("proxy URL must be http, https, socks5 or socks5h with a host, got %q", p.ProxyURL)
+		}
+		t.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if p.CABundle == "" && p.ClientCert == "" && p.ClientKey == "" && !p.InsecureSkipVerify {
+		return nil
+	}
+	tlsConfig := &tls.Config{}
+	if t.TLSClientConfig != nil {
+		tlsConfig = t.TLSClientConfig.Clone()
+	}
+	if p.CABundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(p.CABundle)) {
+			return errors.New("CA bundle contains no PEM certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if p.ClientCert != "" || p.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(p.ClientCert), []byte(p.ClientKey))
+		if err != nil {
+			return fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	tlsConfig.InsecureSkipVerify = p.InsecureSkipVerify
+	t.TLSClientConfig = tlsConfig
+	return nil
+}
@@ -0,0 +1,37 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+func (h *OpenAPIHandler) CategoriesList(ctx context.Context, request openapi.CategoriesListRequestObject) (openapi.CategoriesListResponseObject, error) {
+	limit := int64(100)
+	if request.Params.Limit != nil {
+		if *request.Params.Limit <= 0 || *request.Params.Limit > 1000 {
+			return openapi.CategoriesList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("limit must be between 1 and 1000, got %d", *request.Params.Limit)}, nil
+		}
+		limit = int64(*request.Params.Limit)
+	}
+	rows, err := h.store.ListCategoryFacets(ctx, store.CategoryFacetsParams{
+		FeedID: valueOrEmpty(request.Params.FeedId),
+		TagID:  valueOrEmpty(request.Params.TagId),
+		Limit:  limit,
+	})
+	if err != nil {
+		return openapi.CategoriesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	categories := make([]openapi.CategoryFacet, len(rows))
+	for i, row := range rows {
+		categories[i] = openapi.CategoryFacet{
+			Name:        row.Name,
+			ItemCount:   strconv.FormatInt(row.ItemCount, 10),
+			UnreadCount: strconv.FormatInt(row.UnreadCount, 10),
+		}
+	}
+	return openapi.CategoriesList200JSONResponse(openapi.ListCategoriesResponse{Categories: categories}), nil
+}
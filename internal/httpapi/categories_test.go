@@ -0,0 +1,73 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPICategoriesList(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	for _, id := range []string{"feed-1", "feed-2"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: "https://example.com/" + id + ".xml"})
+		assert.NilError(t, err)
+	}
+	for _, item := range []struct{ feedID, url, categories string }{
+		{"feed-1", "https://example.com/1", `["Go","Databases"]`},
+		{"feed-1", "https://example.com/2", `["go"]`},
+		{"feed-2", "https://example.com/3", `["Rust"]`},
+	} {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: item.feedID, Url: item.url, Categories: &item.categories}))
+	}
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	get := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/categories?"+query, nil))
+		return rec
+	}
+
+	t.Run("Facets are ordered by item count", func(t *testing.T) {
+		rec := get(t, "feedId=feed-1")
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListCategoriesResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.DeepEqual(t, body.Categories, []openapi.CategoryFacet{
+			{Name: "Go", ItemCount: "2", UnreadCount: "2"},
+			{Name: "Databases", ItemCount: "1", UnreadCount: "1"},
+		})
+	})
+
+	t.Run("Category block rules are accepted", func(t *testing.T) {
+		var reader bytes.Buffer
+		assert.NilError(t, json.NewEncoder(&reader).Encode(openapi.AddItemBlockRulesRequest{
+			Rules: []openapi.AddItemBlockRuleInput{{RuleType: "category", Value: "Rust"}},
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/block-rules", &reader)
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	})
+
+	t.Run("Invalid limit", func(t *testing.T) {
+		rec := get(t, "limit=0")
+		var apiErr openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+		assert.Equal(t, apiErr.Code, "invalid_argument")
+	})
+}
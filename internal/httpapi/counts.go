@@ -0,0 +1,131 @@
+package httpapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+// countsCache remembers the ETag of the latest counts and how long they stay
+// the same, so that clients holding them are answered without counting.
+type countsCache struct {
+	mu        sync.Mutex
+	version   int64
+	expiresAt time.Time
+	etag      string
+}
+
+// get returns the ETag of the counts at version, if they are known and
+// current at now. Counts without a version are never known.
+func (c *countsCache) get(version int64, now time.Time) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.etag == "" || version == 0 || c.version != version || (!c.expiresAt.IsZero() && !now.Before(c.expiresAt)) {
+		return "", false
+	}
+	return c.etag, true
+}
+
+func (c *countsCache) set(counts store.AllItemCounts, etag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.version, c.expiresAt, c.etag = counts.Version, counts.ExpiresAt, etag
+}
+
+func (h *OpenAPIHandler) CountsGet(ctx context.Context, request openapi.CountsGetRequestObject) (openapi.CountsGetResponseObject, error) {
+	if request.Params.IfNoneMatch != nil {
+		version, err := h.store.ItemCountsVersion(ctx)
+		if err != nil {
+			return openapi.CountsGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		if etag, ok := h.counts.get(version, time.Now()); ok && etagMatches(*request.Params.IfNoneMatch, etag) {
+			return openapi.CountsGet304Response{Headers: openapi.CountsGet304ResponseHeaders{Etag: etag}}, nil
+		}
+	}
+
+	counts, err := h.store.CountAllItems(ctx)
+	if err != nil {
+		return openapi.CountsGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	body := itemCountsToOpenAPI(counts)
+	// The ETag is a hash of the counts themselves, so that it changes
+	// exactly when they do, including when saved searches with maxAgeDays
+	// drop items as time passes.
+	b, err := json.Marshal(body)
+	if err != nil {
+		return openapi.CountsGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	sum := sha256.Sum256(b)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	h.counts.set(counts, etag)
+	if request.Params.IfNoneMatch != nil && etagMatches(*request.Params.IfNoneMatch, etag) {
+		return openapi.CountsGet304Response{Headers: openapi.CountsGet304ResponseHeaders{Etag: etag}}, nil
+	}
+	return openapi.CountsGet200JSONResponse{Body: body, Headers: openapi.CountsGet200ResponseHeaders{Etag: etag}}, nil
+}
+
+// etagMatches reports whether an If-None-Match header value lists etag,
+// comparing weakly as RFC 9110 requires for GET.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func itemCountsToOpenAPI(counts store.AllItemCounts) openapi.CountsResponse {
+	format := func(value int64) string {
+		return strconv.FormatInt(value, 10)
+	}
+	body := openapi.CountsResponse{
+		Total: openapi.ItemCounts{
+			TotalCount:   format(counts.Total.TotalCount),
+			UnreadCount:  format(counts.Total.UnreadCount),
+			StarredCount: format(counts.Total.StarredCount),
+		},
+		Feeds:         make([]openapi.FeedItemCounts, 0, len(counts.Feeds)),
+		Tags:          make([]openapi.TagItemCounts, 0, len(counts.Tags)),
+		SavedSearches: make([]openapi.SavedSearchItemCounts, 0, len(counts.SavedSearches)),
+	}
+	for _, id := range slices.Sorted(maps.Keys(counts.Feeds)) {
+		c := counts.Feeds[id]
+		body.Feeds = append(body.Feeds, openapi.FeedItemCounts{
+			FeedId:       id,
+			TotalCount:   format(c.TotalCount),
+			UnreadCount:  format(c.UnreadCount),
+			StarredCount: format(c.StarredCount),
+		})
+	}
+	for _, id := range slices.Sorted(maps.Keys(counts.Tags)) {
+		c := counts.Tags[id]
+		body.Tags = append(body.Tags, openapi.TagItemCounts{
+			TagId:        id,
+			TotalCount:   format(c.TotalCount),
+			UnreadCount:  format(c.UnreadCount),
+			StarredCount: format(c.StarredCount),
+		})
+	}
+	for _, id := range slices.Sorted(maps.Keys(counts.SavedSearches)) {
+		c := counts.SavedSearches[id]
+		body.SavedSearches = append(body.SavedSearches, openapi.SavedSearchItemCounts{
+			SavedSearchId: id,
+			TotalCount:    format(c.TotalCount),
+			UnreadCount:   format(c.UnreadCount),
+			StarredCount:  format(c.StarredCount),
+		})
+	}
+	return body
+}
@@ -0,0 +1,79 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPICountsGet(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	// The migration seeds the version.
+	_, err := s.DB.ExecContext(ctx, "INSERT INTO item_counts_version (id, version) VALUES (1, 1)")
+	assert.NilError(t, err)
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	for _, url := range []string{"https://example.com/1", "https://example.com/2"} {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-1", Url: url}))
+	}
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	get := func(t *testing.T, ifNoneMatch string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/counts", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get(t, "")
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	var body openapi.CountsResponse
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.DeepEqual(t, body, openapi.CountsResponse{
+		Total:         openapi.ItemCounts{TotalCount: "2", UnreadCount: "2", StarredCount: "0"},
+		Feeds:         []openapi.FeedItemCounts{{FeedId: "feed-1", TotalCount: "2", UnreadCount: "2", StarredCount: "0"}},
+		Tags:          []openapi.TagItemCounts{},
+		SavedSearches: []openapi.SavedSearchItemCounts{},
+	})
+	etag := rec.Header().Get("ETag")
+	assert.Assert(t, etag != "")
+
+	t.Run("Unchanged counts are not modified", func(t *testing.T) {
+		for _, ifNoneMatch := range []string{etag, `"other", W/` + etag, "*"} {
+			rec := get(t, ifNoneMatch)
+			assert.Equal(t, rec.Code, http.StatusNotModified, ifNoneMatch)
+			assert.Equal(t, rec.Header().Get("ETag"), etag)
+			assert.Equal(t, rec.Body.Len(), 0)
+		}
+	})
+
+	t.Run("Changed counts get a new ETag", func(t *testing.T) {
+		rows, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 1})
+		assert.NilError(t, err)
+		_, err = s.SetItemRead(ctx, store.SetItemReadParams{ItemID: rows[0].ID, IsRead: 1})
+		assert.NilError(t, err)
+
+		rec := get(t, etag)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		assert.Assert(t, rec.Header().Get("ETag") != etag)
+		var body openapi.CountsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Total.UnreadCount, "1")
+	})
+}
@@ -2,9 +2,15 @@ package httpapi
 
 import (
 	"context"
+	"fmt"
 	"io/fs"
+	"net/http"
+	"time"
 
 	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
+	"github.com/nakatanakatana/feed-reader/internal/scraper"
 	"github.com/nakatanakatana/feed-reader/store"
 )
 
@@ -21,8 +27,29 @@ type Dependencies struct {
 	// AllowedMethods is written to Access-Control-Allow-Methods.
 	// Empty defaults to primary methods: GET, POST, OPTIONS, PUT, DELETE.
 	AllowedMethods string
+	// WebSubHandler receives WebSub hub callbacks at WebSubCallbackPath.
+	// It is only set on the primary server.
+	WebSubHandler http.Handler
+	// CredentialCipher encrypts per-feed credentials and transport profile
+	// client keys. Neither can be managed when it is nil.
+	CredentialCipher *feedauth.Cipher
+	// FetchQueue reports the per-host fetch queue. It is only set on the
+	// primary server.
+	FetchQueue FetchQueue
+	// ContentExtractor re-extracts the full content of items on demand. It
+	// is only set on the primary server.
+	ContentExtractor ContentExtractor
+	// Scraper extracts items from HTML pages with CSS selectors. It is only
+	// set on the primary server.
+	Scraper PageScraper
+	// MediaProxy serves the images of items at mediaproxy.PathPrefix. When
+	// set, item content is rewritten to load images through it.
+	MediaProxy *mediaproxy.Proxy
 }
 
+// WebSubCallbackPath is the route prefix of WebSub hub callbacks; the feed ID follows it.
+const WebSubCallbackPath = "/websub/callback/"
+
 // FeedFetcher fetches RSS/Atom feeds.
 type FeedFetcher interface {
 	Fetch(ctx context.Context, feedID string, url string) (*gofeed.Feed, error)
@@ -43,6 +70,32 @@ type ItemFetcher interface {
 	FetchFeedsByIDsSync(ctx context.Context, ids []string) ([]FeedFetchResult, error)
 }
 
+// FetchQueueHost is the state of the fetch queue for a single host.
+type FetchQueueHost struct {
+	Host   string
+	Queued int
+	Active int
+	// DeferredUntil is set while the host is held back by the politeness
+	// delay or by Retry-After.
+	DeferredUntil *time.Time
+}
+
+// FetchQueue reports queued fetches per host for debugging.
+type FetchQueue interface {
+	HostStats() []FetchQueueHost
+}
+
+// ContentExtractor extracts the full article of an item from its page.
+type ContentExtractor interface {
+	ExtractItem(ctx context.Context, itemID string) error
+}
+
+// PageScraper turns an HTML page into a feed using CSS selectors. feedID may
+// be empty when previewing a page that is not subscribed yet.
+type PageScraper interface {
+	Scrape(ctx context.Context, feedID, pageURL string, selectors scraper.Selectors) (*gofeed.Feed, error)
+}
+
 // ImportFailedFeed describes a single OPML import failure.
 type ImportFailedFeed struct {
 	URL          string
@@ -61,3 +114,24 @@ type ImportResults struct {
 type OPMLImporter interface {
 	ImportSync(ctx context.Context, opmlContent []byte) (*ImportResults, error)
 }
+
+// FeedCandidate is a feed advertised by, or found next to, an HTML page.
+type FeedCandidate struct {
+	URL   string
+	Title string
+	Type  string
+}
+
+// FeedDiscoveryError is returned by FeedFetcher.Fetch when the URL points to
+// an HTML page instead of a feed. Candidates are ordered best match first.
+type FeedDiscoveryError struct {
+	PageURL    string
+	Candidates []FeedCandidate
+}
+
+func (e *FeedDiscoveryError) Error() string {
+	if len(e.Candidates) == 0 {
+		return fmt.Sprintf("no feeds found at %s", e.PageURL)
+	}
+	return fmt.Sprintf("%s is an HTML page with %d feed candidates", e.PageURL, len(e.Candidates))
+}
@@ -0,0 +1,34 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+)
+
+// iconCacheControl lets browsers keep an icon for as long as the fetcher
+// keeps it before looking it up again.
+const iconCacheControl = "public, max-age=604800"
+
+func (h *OpenAPIHandler) FeedsGetIcon(ctx context.Context, request openapi.FeedsGetIconRequestObject) (openapi.FeedsGetIconResponseObject, error) {
+	icon, err := h.store.GetFeedIcon(ctx, request.Id)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && len(icon.Data) == 0) {
+		return openapi.FeedsGetIcon404JSONResponse{Code: "not_found", Message: "feed has no icon"}, nil
+	}
+	if err != nil {
+		return openapi.FeedsGetIcon500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	contentType := "application/octet-stream"
+	if icon.MimeType != nil {
+		contentType = *icon.MimeType
+	}
+	return openapi.FeedsGetIcon200ImageResponse{
+		Body:          bytes.NewReader(icon.Data),
+		Headers:       openapi.FeedsGetIcon200ResponseHeaders{CacheControl: iconCacheControl},
+		ContentType:   contentType,
+		ContentLength: int64(len(icon.Data)),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package httpapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIFeedIcon(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	for _, id := range []string{"feed-icon", "feed-no-icon", "feed-icon-error"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: "https://example.com/" + id})
+		assert.NilError(t, err)
+	}
+	png := []byte("\x89PNG\r\n\x1a\n")
+	mimeType, sourceURL := "image/png", "https://example.com/favicon.png"
+	assert.NilError(t, s.UpsertFeedIcon(ctx, store.UpsertFeedIconParams{
+		FeedID:    "feed-icon",
+		SourceUrl: &sourceURL,
+		MimeType:  &mimeType,
+		Data:      png,
+		FetchedAt: "2026-01-01T00:00:00Z",
+	}))
+	message := "no icon found"
+	assert.NilError(t, s.UpsertFeedIcon(ctx, store.UpsertFeedIconParams{
+		FeedID:    "feed-icon-error",
+		Error:     &message,
+		FetchedAt: "2026-01-01T00:00:00Z",
+	}))
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	get := func(id string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/feeds/"+id+"/icon", nil))
+		return rec
+	}
+
+	rec := get("feed-icon")
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	assert.Equal(t, rec.Header().Get("Content-Type"), "image/png")
+	assert.Equal(t, rec.Header().Get("Cache-Control"), "public, max-age=604800")
+	assert.DeepEqual(t, rec.Body.Bytes(), png)
+
+	assert.Equal(t, get("feed-no-icon").Code, http.StatusNotFound)
+	assert.Equal(t, get("feed-icon-error").Code, http.StatusNotFound)
+}
@@ -0,0 +1,164 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/scraper"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+func (h *OpenAPIHandler) FeedsPreviewScrape(ctx context.Context, request openapi.FeedsPreviewScrapeRequestObject) (openapi.FeedsPreviewScrapeResponseObject, error) {
+	if request.Body == nil {
+		return openapi.FeedsPreviewScrape500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	if request.Body.Url == "" {
+		return openapi.FeedsPreviewScrape500JSONResponse{Code: "invalid_argument", Message: "url is required"}, nil
+	}
+	selectors := scraperSelectorsFromOpenAPI(request.Body.Selectors)
+	if err := selectors.Validate(); err != nil {
+		return openapi.FeedsPreviewScrape500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	if h.scraper == nil {
+		return openapi.FeedsPreviewScrape500JSONResponse{Code: "internal", Message: "scraper is not configured"}, nil
+	}
+
+	feed, err := h.scraper.Scrape(ctx, "", request.Body.Url, selectors)
+	if errors.Is(err, scraper.ErrNoItems) {
+		return openapi.FeedsPreviewScrape500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	if err != nil {
+		return openapi.FeedsPreviewScrape500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	items := make([]openapi.ScrapedItem, 0, len(feed.Items))
+	for _, item := range feed.Items {
+		items = append(items, openapi.ScrapedItem{
+			Title:       item.Title,
+			Url:         item.Link,
+			PublishedAt: item.PublishedParsed,
+			Content:     item.Content,
+		})
+	}
+	return openapi.FeedsPreviewScrape200JSONResponse(openapi.PreviewScrapeResponse{
+		Title: feed.Title,
+		Items: items,
+	}), nil
+}
+
+func (h *OpenAPIHandler) FeedsCreateScraped(ctx context.Context, request openapi.FeedsCreateScrapedRequestObject) (openapi.FeedsCreateScrapedResponseObject, error) {
+	if request.Body == nil {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	if request.Body.Url == "" {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "invalid_argument", Message: "url is required"}, nil
+	}
+	selectors := scraperSelectorsFromOpenAPI(request.Body.Selectors)
+	if err := selectors.Validate(); err != nil {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	if h.scraper == nil {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "internal", Message: "scraper is not configured"}, nil
+	}
+
+	fetchedFeed, err := h.scraper.Scrape(ctx, "", request.Body.Url, selectors)
+	if errors.Is(err, scraper.ErrNoItems) {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	if err != nil {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	feed, err := h.createFetchedFeed(ctx, request.Body.Url, fetchedFeed, request.Body.Title, request.Body.TagIds, func(qtx *store.Queries, feedID string) error {
+		return qtx.UpsertFeedScraper(ctx, feedScraperParams(feedID, selectors))
+	})
+	if err != nil {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	converted, err := h.fullFeedToOpenAPI(ctx, *feed)
+	if err != nil {
+		return openapi.FeedsCreateScraped500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	return openapi.FeedsCreateScraped200JSONResponse(openapi.CreateFeedResponse{
+		Feed: &converted,
+	}), nil
+}
+
+func (h *OpenAPIHandler) FeedsGetScraper(ctx context.Context, request openapi.FeedsGetScraperRequestObject) (openapi.FeedsGetScraperResponseObject, error) {
+	row, err := h.store.GetFeedScraper(ctx, request.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return openapi.FeedsGetScraper200JSONResponse(openapi.GetFeedScraperResponse{}), nil
+	}
+	if err != nil {
+		return openapi.FeedsGetScraper500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	selectors := openapi.ScraperSelectors{
+		Item:    row.ItemSelector,
+		Title:   row.TitleSelector,
+		Link:    row.LinkSelector,
+		Date:    row.DateSelector,
+		Content: row.ContentSelector,
+	}
+	return openapi.FeedsGetScraper200JSONResponse(openapi.GetFeedScraperResponse{
+		Selectors: &selectors,
+	}), nil
+}
+
+func (h *OpenAPIHandler) FeedsSetScraper(ctx context.Context, request openapi.FeedsSetScraperRequestObject) (openapi.FeedsSetScraperResponseObject, error) {
+	if request.Body == nil {
+		return openapi.FeedsSetScraper500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	selectors := scraperSelectorsFromOpenAPI(request.Body.Selectors)
+	if err := selectors.Validate(); err != nil {
+		return openapi.FeedsSetScraper500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	if _, err := h.store.GetFeed(ctx, request.Id); errors.Is(err, sql.ErrNoRows) {
+		return openapi.FeedsSetScraper500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("feed %s not found", request.Id)}, nil
+	} else if err != nil {
+		return openapi.FeedsSetScraper500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	if err := h.store.UpsertFeedScraper(ctx, feedScraperParams(request.Id, selectors)); err != nil {
+		return openapi.FeedsSetScraper500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.FeedsSetScraper200Response{}, nil
+}
+
+func scraperSelectorsFromOpenAPI(s openapi.ScraperSelectors) scraper.Selectors {
+	value := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return strings.TrimSpace(*v)
+	}
+	return scraper.Selectors{
+		Item:    strings.TrimSpace(s.Item),
+		Title:   value(s.Title),
+		Link:    value(s.Link),
+		Date:    value(s.Date),
+		Content: value(s.Content),
+	}
+}
+
+func feedScraperParams(feedID string, s scraper.Selectors) store.UpsertFeedScraperParams {
+	optional := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	return store.UpsertFeedScraperParams{
+		FeedID:          feedID,
+		ItemSelector:    s.Item,
+		TitleSelector:   optional(s.Title),
+		LinkSelector:    optional(s.Link),
+		DateSelector:    optional(s.Date),
+		ContentSelector: optional(s.Content),
+	}
+}
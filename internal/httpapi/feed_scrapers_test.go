@@ -0,0 +1,137 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/internal/scraper"
+	"gotest.tools/v3/assert"
+)
+
+type stubPageScraper struct {
+	selectors []scraper.Selectors
+}
+
+func (s *stubPageScraper) Scrape(ctx context.Context, feedID, pageURL string, selectors scraper.Selectors) (*gofeed.Feed, error) {
+	s.selectors = append(s.selectors, selectors)
+	if selectors.Item != "li" {
+		return nil, scraper.ErrNoItems
+	}
+	published := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	return &gofeed.Feed{
+		Title: "Releases",
+		Link:  pageURL,
+		Items: []*gofeed.Item{
+			{Title: "v2.0", Link: pageURL + "/2", PublishedParsed: &published, Content: "Second"},
+			{Title: "v1.0", Link: pageURL + "/1"},
+		},
+	}, nil
+}
+
+func TestOpenAPIFeedScrapers(t *testing.T) {
+	s := setupTestDB(t)
+	scrapers := &stubPageScraper{}
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s, Scraper: scrapers}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	do := func(t *testing.T, method, path, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("previews the scraped items", func(t *testing.T) {
+		rec := do(t, http.MethodPost, "/api/v2/feeds/scrape/preview", `{"url":"https://example.com/releases","selectors":{"item":"li"}}`)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.PreviewScrapeResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Title, "Releases")
+		assert.Equal(t, len(body.Items), 2)
+		assert.Equal(t, body.Items[0].Url, "https://example.com/releases/2")
+		assert.Equal(t, body.Items[0].Content, "Second")
+		assert.Assert(t, body.Items[0].PublishedAt != nil)
+		assert.Assert(t, body.Items[1].PublishedAt == nil)
+	})
+
+	t.Run("rejects selectors matching nothing", func(t *testing.T) {
+		rec := do(t, http.MethodPost, "/api/v2/feeds/scrape/preview", `{"url":"https://example.com/releases","selectors":{"item":"article"}}`)
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+		var body openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Code, "invalid_argument")
+	})
+
+	t.Run("rejects invalid selectors before fetching", func(t *testing.T) {
+		calls := len(scrapers.selectors)
+		rec := do(t, http.MethodPost, "/api/v2/feeds/scrape/preview", `{"url":"https://example.com/releases","selectors":{"item":"li","title":"h2["}}`)
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+		var body openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Code, "invalid_argument")
+		assert.Equal(t, len(scrapers.selectors), calls)
+	})
+
+	t.Run("creates a scraped feed and stores its selectors", func(t *testing.T) {
+		rec := do(t, http.MethodPost, "/api/v2/feeds/scrape", `{"url":"https://example.com/releases","title":"Example releases","selectors":{"item":"li","date":" time "},"tagIds":[]}`)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var created openapi.CreateFeedResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+		assert.Assert(t, created.Feed != nil)
+		assert.Equal(t, created.Feed.Url, "https://example.com/releases")
+		assert.Equal(t, created.Feed.Title, "Example releases")
+
+		rec = do(t, http.MethodGet, "/api/v2/feeds/"+created.Feed.Id+"/scraper", "")
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var got openapi.GetFeedScraperResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Assert(t, got.Selectors != nil)
+		assert.Equal(t, got.Selectors.Item, "li")
+		assert.Equal(t, *got.Selectors.Date, "time")
+		assert.Assert(t, got.Selectors.Title == nil)
+
+		rec = do(t, http.MethodPut, "/api/v2/feeds/"+created.Feed.Id+"/scraper", `{"selectors":{"item":"li.release"}}`)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		rec = do(t, http.MethodGet, "/api/v2/feeds/"+created.Feed.Id+"/scraper", "")
+		var updated openapi.GetFeedScraperResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+		assert.Equal(t, updated.Selectors.Item, "li.release")
+		assert.Assert(t, updated.Selectors.Date == nil)
+	})
+
+	t.Run("rejects selectors for unknown feeds", func(t *testing.T) {
+		rec := do(t, http.MethodPut, "/api/v2/feeds/unknown/scraper", `{"selectors":{"item":"li"}}`)
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+		var body openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Code, "invalid_argument")
+	})
+
+	t.Run("returns no selectors for regular feeds", func(t *testing.T) {
+		rec := do(t, http.MethodGet, "/api/v2/feeds/unknown/scraper", "")
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var got openapi.GetFeedScraperResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Assert(t, got.Selectors == nil)
+	})
+}
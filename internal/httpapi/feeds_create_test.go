@@ -0,0 +1,113 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"gotest.tools/v3/assert"
+)
+
+type stubFeedFetcher struct {
+	feeds map[string]*gofeed.Feed
+	errs  map[string]error
+}
+
+func (s *stubFeedFetcher) Fetch(ctx context.Context, feedID string, url string) (*gofeed.Feed, error) {
+	if err, ok := s.errs[url]; ok {
+		return nil, err
+	}
+	if feed, ok := s.feeds[url]; ok {
+		return feed, nil
+	}
+	return &gofeed.Feed{}, nil
+}
+
+func TestOpenAPICreateFeedDiscovery(t *testing.T) {
+	pageURL := "https://example.com/blog"
+	candidates := []httpapi.FeedCandidate{
+		{URL: "https://example.com/blog/atom.xml", Title: "Atom", Type: "application/atom+xml"},
+		{URL: "https://example.com/blog/rss.xml", Title: "RSS", Type: "application/rss+xml"},
+	}
+	newHandler := func(t *testing.T, candidates []httpapi.FeedCandidate) http.Handler {
+		t.Helper()
+		s := setupTestDB(t)
+		fetcher := &stubFeedFetcher{
+			feeds: map[string]*gofeed.Feed{
+				"https://example.com/blog/atom.xml": {Title: "Blog (Atom)"},
+				"https://example.com/blog/rss.xml":  {Title: "Blog (RSS)"},
+			},
+			errs: map[string]error{
+				pageURL: &httpapi.FeedDiscoveryError{PageURL: pageURL, Candidates: candidates},
+			},
+		}
+		return openapi.HandlerFromMuxWithBaseURL(
+			openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s, Fetcher: fetcher}), nil),
+			http.NewServeMux(),
+			"/api/v2",
+		)
+	}
+	createFeed := func(t *testing.T, handler http.Handler, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/feeds", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("returns candidates when the page advertises several feeds", func(t *testing.T) {
+		handler := newHandler(t, candidates)
+		rec := createFeed(t, handler, `{"url":"https://example.com/blog","tagIds":[]}`)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.CreateFeedResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Assert(t, body.Feed == nil)
+		assert.Assert(t, body.Candidates != nil)
+		assert.DeepEqual(t, *body.Candidates, []openapi.FeedCandidate{
+			{Url: "https://example.com/blog/atom.xml", Title: "Atom", Type: "application/atom+xml"},
+			{Url: "https://example.com/blog/rss.xml", Title: "RSS", Type: "application/rss+xml"},
+		})
+	})
+
+	t.Run("subscribes to the best match when autoSelect is set", func(t *testing.T) {
+		handler := newHandler(t, candidates)
+		rec := createFeed(t, handler, `{"url":"https://example.com/blog","tagIds":[],"autoSelect":true}`)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.CreateFeedResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Assert(t, body.Feed != nil)
+		assert.Equal(t, body.Feed.Url, "https://example.com/blog/atom.xml")
+		assert.Equal(t, body.Feed.Title, "Blog (Atom)")
+	})
+
+	t.Run("subscribes to the only candidate", func(t *testing.T) {
+		handler := newHandler(t, candidates[1:])
+		rec := createFeed(t, handler, `{"url":"https://example.com/blog","tagIds":[]}`)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.CreateFeedResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Assert(t, body.Feed != nil)
+		assert.Equal(t, body.Feed.Url, "https://example.com/blog/rss.xml")
+	})
+
+	t.Run("rejects pages without feeds", func(t *testing.T) {
+		handler := newHandler(t, nil)
+		rec := createFeed(t, handler, `{"url":"https://example.com/blog","tagIds":[]}`)
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+		var body openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Code, "invalid_argument")
+		assert.Equal(t, body.Message, "no feeds found at https://example.com/blog")
+	})
+}
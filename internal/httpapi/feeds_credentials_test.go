@@ -0,0 +1,125 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIFeedCredentials(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/private.xml"})
+	assert.NilError(t, err)
+
+	c, err := feedauth.NewCipher(bytes.Repeat([]byte{1}, feedauth.KeySize))
+	assert.NilError(t, err)
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s, CredentialCipher: c}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	do := func(t *testing.T, method, path, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(t, http.MethodGet, "/api/v2/feeds/feed-1/credentials", "")
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	var getBody openapi.GetFeedCredentialsResponse
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &getBody))
+	assert.Assert(t, getBody.Credentials == nil)
+
+	rec = do(t, http.MethodPut, "/api/v2/feeds/feed-1/credentials",
+		`{"authType":"basic","username":"alice","password":"s3cret","headers":{"Cookie":"session=abc","X-Api-Key":"key-123"}}`)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+	stored, err := s.GetFeedCredential(ctx, "feed-1")
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(stored.EncryptedData, "s3cret"))
+
+	t.Run("get returns only the shape of the credentials", func(t *testing.T) {
+		rec := do(t, http.MethodGet, "/api/v2/feeds/feed-1/credentials", "")
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		for _, secret := range []string{"alice", "s3cret", "session=abc", "key-123"} {
+			assert.Assert(t, !strings.Contains(rec.Body.String(), secret), "response leaks %q", secret)
+		}
+
+		var body openapi.GetFeedCredentialsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Assert(t, body.Credentials != nil)
+		assert.Equal(t, body.Credentials.AuthType, "basic")
+		assert.DeepEqual(t, body.Credentials.HeaderNames, []string{"Cookie", "X-Api-Key"})
+	})
+
+	t.Run("feed list and OPML export do not echo secrets", func(t *testing.T) {
+		list := do(t, http.MethodGet, "/api/v2/feeds", "")
+		assert.Equal(t, list.Code, http.StatusOK, list.Body.String())
+		export := do(t, http.MethodPost, "/api/v2/feeds/export-opml", `{"ids":["feed-1"]}`)
+		assert.Equal(t, export.Code, http.StatusOK, export.Body.String())
+		var exportBody openapi.ExportOpmlResponse
+		assert.NilError(t, json.Unmarshal(export.Body.Bytes(), &exportBody))
+
+		for _, secret := range []string{"alice", "s3cret", "session=abc", "key-123"} {
+			assert.Assert(t, !strings.Contains(list.Body.String(), secret), "feed list leaks %q", secret)
+			assert.Assert(t, !strings.Contains(string(exportBody.OpmlContent), secret), "OPML export leaks %q", secret)
+		}
+	})
+
+	t.Run("rejects invalid credentials", func(t *testing.T) {
+		rec := do(t, http.MethodPut, "/api/v2/feeds/feed-1/credentials", `{"authType":"bearer"}`)
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+		var body openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Code, "invalid_argument")
+		assert.Equal(t, body.Message, "token is required for bearer auth")
+	})
+
+	t.Run("delete removes the credentials", func(t *testing.T) {
+		rec := do(t, http.MethodDelete, "/api/v2/feeds/feed-1/credentials", "")
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		rec = do(t, http.MethodGet, "/api/v2/feeds/feed-1/credentials", "")
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.GetFeedCredentialsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Assert(t, body.Credentials == nil)
+	})
+}
+
+func TestOpenAPIFeedCredentialsRequiresKey(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/private.xml"})
+	assert.NilError(t, err)
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v2/feeds/feed-1/credentials", strings.NewReader(`{"authType":"bearer","token":"t"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+	var body openapi.ApiError
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, body.Code, "invalid_argument")
+	assert.Equal(t, body.Message, feedauth.ErrNoKey.Error())
+}
@@ -41,3 +41,70 @@ func TestFeedsList(t *testing.T) {
 	assert.Equal(t, body.Feeds[0].Id, "feed-1")
 	assert.Equal(t, body.Feeds[0].Url, "https://example.com/feed.xml")
 }
+
+func TestFeedsListFetchHealth(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	for _, id := range []string{"healthy", "broken"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{
+			ID:  id,
+			Url: "https://example.com/" + id + ".xml",
+		})
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, s.RecordFeedFetchSuccess(ctx, store.RecordFeedFetchSuccessParams{
+		FeedID:         "healthy",
+		LastStatusCode: new(int64(200)),
+	}))
+	for range 2 {
+		assert.NilError(t, s.RecordFeedFetchFailure(ctx, store.RecordFeedFetchFailureParams{
+			FeedID:         "broken",
+			LastStatusCode: new(int64(503)),
+			LastError:      new("server error: 503"),
+		}))
+	}
+	assert.NilError(t, s.DisableFeed(ctx, store.DisableFeedParams{
+		FeedID:         "broken",
+		DisabledReason: new("feed returned 410 Gone"),
+	}))
+	_, err := s.RecordFeedRedirect(ctx, store.RecordFeedRedirectParams{
+		FeedID:      "broken",
+		RedirectUrl: new("https://example.com/moved.xml"),
+	})
+	assert.NilError(t, err)
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/feeds", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+	var body openapi.ListFeedsResponse
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	feeds := make(map[string]openapi.Feed)
+	for _, feed := range body.Feeds {
+		feeds[feed.Id] = feed
+	}
+
+	healthy := feeds["healthy"]
+	assert.DeepEqual(t, healthy.LastStatusCode, new(int32(200)))
+	assert.Assert(t, healthy.LastError == nil)
+	assert.Equal(t, healthy.ConsecutiveFailures, int32(0))
+	assert.Assert(t, healthy.LastSuccessAt != nil)
+	assert.Assert(t, healthy.DisabledAt == nil)
+	assert.Assert(t, healthy.RedirectUrl == nil)
+
+	broken := feeds["broken"]
+	assert.DeepEqual(t, broken.LastStatusCode, new(int32(503)))
+	assert.DeepEqual(t, broken.LastError, new("server error: 503"))
+	assert.Equal(t, broken.ConsecutiveFailures, int32(2))
+	assert.Assert(t, broken.LastSuccessAt == nil)
+	assert.Assert(t, broken.DisabledAt != nil)
+	assert.DeepEqual(t, broken.DisabledReason, new("feed returned 410 Gone"))
+	assert.DeepEqual(t, broken.RedirectUrl, new("https://example.com/moved.xml"))
+}
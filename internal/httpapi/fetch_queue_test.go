@@ -0,0 +1,56 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"gotest.tools/v3/assert"
+)
+
+type stubFetchQueue []httpapi.FetchQueueHost
+
+func (q stubFetchQueue) HostStats() []httpapi.FetchQueueHost { return q }
+
+func TestOpenAPIFetchQueue(t *testing.T) {
+	deferredUntil := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	queue := stubFetchQueue{
+		{Host: "a.example", Queued: 3, Active: 1},
+		{Host: "b.example", Queued: 1, DeferredUntil: &deferredUntil},
+	}
+
+	for _, tt := range []struct {
+		name  string
+		queue httpapi.FetchQueue
+		want  []openapi.FetchQueueHost
+	}{
+		{
+			name:  "reports hosts",
+			queue: queue,
+			want: []openapi.FetchQueueHost{
+				{Host: "a.example", Queued: 3, Active: 1},
+				{Host: "b.example", Queued: 1, DeferredUntil: &deferredUntil},
+			},
+		},
+		{name: "empty without a queue", want: []openapi.FetchQueueHost{}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := openapi.HandlerFromMuxWithBaseURL(
+				openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: setupTestDB(t), FetchQueue: tt.queue}), nil),
+				http.NewServeMux(),
+				"/api/v2",
+			)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/fetch-queue", nil))
+			assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+			var body openapi.GetFetchQueueResponse
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.DeepEqual(t, body.Hosts, tt.want)
+		})
+	}
+}
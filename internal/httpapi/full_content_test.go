@@ -0,0 +1,93 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+// stubContentExtractor stores a fixed full content for the requested item.
+type stubContentExtractor struct {
+	store   *store.Store
+	content string
+}
+
+func (e *stubContentExtractor) ExtractItem(ctx context.Context, itemID string) error {
+	return e.store.UpsertItemFullContent(ctx, store.UpsertItemFullContentParams{
+		ItemID:    itemID,
+		Content:   &e.content,
+		FetchedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).Format(time.RFC3339),
+	})
+}
+
+func TestOpenAPIFullContent(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://news.example/feed.xml"})
+	assert.NilError(t, err)
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-2", Url: "https://blog.example/feed.xml"})
+	assert.NilError(t, err)
+	content := "Teaser"
+	_, err = s.CreateItem(ctx, store.CreateItemParams{ID: "item-1", Url: "https://news.example/story", Content: &content})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateFeedItem(ctx, store.CreateFeedItemParams{FeedID: "feed-1", ItemID: "item-1"}))
+
+	extractor := &stubContentExtractor{store: s, content: "The whole story."}
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s, ContentExtractor: extractor}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+
+	t.Run("enables full content per feed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/feeds/full-content", strings.NewReader(`{"ids":["feed-1"],"enabled":true}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/feeds", nil))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListFeedsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		enabled := map[string]bool{}
+		for _, feed := range body.Feeds {
+			enabled[feed.Id] = feed.FetchFullContent
+		}
+		assert.DeepEqual(t, enabled, map[string]bool{"feed-1": true, "feed-2": false})
+	})
+
+	t.Run("extracts an item on demand", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v2/items/item-1/extract-content", nil))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.ExtractItemContentResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Item.Content, "Teaser")
+		assert.Equal(t, *body.Item.FullContent, "The whole story.")
+		assert.Assert(t, body.Item.FullContentError == nil)
+		assert.Equal(t, body.Item.FullContentFetchedAt.UTC(), time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	})
+
+	t.Run("requires an extractor", func(t *testing.T) {
+		handler := openapi.HandlerFromMuxWithBaseURL(
+			openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+			http.NewServeMux(),
+			"/api/v2",
+		)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v2/items/item-1/extract-content", nil))
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+	})
+}
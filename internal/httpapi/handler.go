@@ -2,26 +2,37 @@ package httpapi
 
 import (
 	"context"
+	"database/sql"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/mmcdole/gofeed"
 	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
 	"github.com/nakatanakatana/feed-reader/store"
 )
 
 type OpenAPIHandler struct {
-	store         *store.Store
-	uuidGenerator store.UUIDGenerator
-	fetcher       FeedFetcher
-	itemFetcher   ItemFetcher
-	opmlImporter  OPMLImporter
+	store            *store.Store
+	uuidGenerator    store.UUIDGenerator
+	fetcher          FeedFetcher
+	itemFetcher      ItemFetcher
+	opmlImporter     OPMLImporter
+	credentialCipher *feedauth.Cipher
+	fetchQueue       FetchQueue
+	contentExtractor ContentExtractor
+	scraper          PageScraper
+	mediaProxy       *mediaproxy.Proxy
+	counts           countsCache
 }
 
 func (h *OpenAPIHandler) FeedsList(ctx context.Context, request openapi.FeedsListRequestObject) (openapi.FeedsListResponseObject, error) {
@@ -58,6 +69,20 @@ func (h *OpenAPIHandler) FeedsCreate(ctx context.Context, request openapi.FeedsC
 	}
 
 	feed, err := h.createFeedFromURL(ctx, request.Body.Url, nil, request.Body.TagIds)
+	var discoveryErr *FeedDiscoveryError
+	if errors.As(err, &discoveryErr) {
+		if len(discoveryErr.Candidates) == 0 {
+			return openapi.FeedsCreate500JSONResponse{Code: "invalid_argument", Message: discoveryErr.Error()}, nil
+		}
+		autoSelect := request.Body.AutoSelect != nil && *request.Body.AutoSelect
+		if len(discoveryErr.Candidates) > 1 && !autoSelect {
+			candidates := feedCandidatesToOpenAPI(discoveryErr.Candidates)
+			return openapi.FeedsCreate200JSONResponse(openapi.CreateFeedResponse{
+				Candidates: &candidates,
+			}), nil
+		}
+		feed, err = h.createFeedFromURL(ctx, discoveryErr.Candidates[0].URL, nil, request.Body.TagIds)
+	}
 	if err != nil {
 		return openapi.FeedsCreate500JSONResponse{Code: "internal", Message: err.Error()}, nil
 	}
@@ -67,10 +92,22 @@ func (h *OpenAPIHandler) FeedsCreate(ctx context.Context, request openapi.FeedsC
 	}
 
 	return openapi.FeedsCreate200JSONResponse(openapi.CreateFeedResponse{
-		Feed: converted,
+		Feed: &converted,
 	}), nil
 }
 
+func feedCandidatesToOpenAPI(candidates []FeedCandidate) []openapi.FeedCandidate {
+	converted := make([]openapi.FeedCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		converted = append(converted, openapi.FeedCandidate{
+			Url:   candidate.URL,
+			Title: candidate.Title,
+			Type:  candidate.Type,
+		})
+	}
+	return converted
+}
+
 func (h *OpenAPIHandler) FeedsRefresh(ctx context.Context, request openapi.FeedsRefreshRequestObject) (openapi.FeedsRefreshResponseObject, error) {
 	if request.Body == nil {
 		return openapi.FeedsRefresh500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
@@ -170,6 +207,52 @@ func (h *OpenAPIHandler) FeedsSuspend(ctx context.Context, request openapi.Feeds
 	return openapi.FeedsSuspend200Response{}, nil
 }
 
+func (h *OpenAPIHandler) FeedsSetFullContent(ctx context.Context, request openapi.FeedsSetFullContentRequestObject) (openapi.FeedsSetFullContentResponseObject, error) {
+	if request.Body == nil {
+		return openapi.FeedsSetFullContent500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	var enabled int64
+	if request.Body.Enabled {
+		enabled = 1
+	}
+	err := h.store.WithTransaction(ctx, func(qtx *store.Queries) error {
+		for _, id := range request.Body.Ids {
+			if err := qtx.SetFeedFetchFullContent(ctx, store.SetFeedFetchFullContentParams{ID: id, FetchFullContent: enabled}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return openapi.FeedsSetFullContent500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	return openapi.FeedsSetFullContent200Response{}, nil
+}
+
+func (h *OpenAPIHandler) FeedsSetUnreadOnRevision(ctx context.Context, request openapi.FeedsSetUnreadOnRevisionRequestObject) (openapi.FeedsSetUnreadOnRevisionResponseObject, error) {
+	if request.Body == nil {
+		return openapi.FeedsSetUnreadOnRevision500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	var enabled int64
+	if request.Body.Enabled {
+		enabled = 1
+	}
+	err := h.store.WithTransaction(ctx, func(qtx *store.Queries) error {
+		for _, id := range request.Body.Ids {
+			if err := qtx.SetFeedUnreadOnRevision(ctx, store.SetFeedUnreadOnRevisionParams{ID: id, UnreadOnRevision: enabled}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return openapi.FeedsSetUnreadOnRevision500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	return openapi.FeedsSetUnreadOnRevision200Response{}, nil
+}
+
 func (h *OpenAPIHandler) FeedsDelete(ctx context.Context, request openapi.FeedsDeleteRequestObject) (openapi.FeedsDeleteResponseObject, error) {
 	if err := h.store.DeleteFeed(ctx, request.Id); err != nil {
 		return openapi.FeedsDelete500JSONResponse{Code: "internal", Message: err.Error()}, nil
@@ -178,6 +261,91 @@ func (h *OpenAPIHandler) FeedsDelete(ctx context.Context, request openapi.FeedsD
 	return openapi.FeedsDelete200Response{}, nil
 }
 
+func (h *OpenAPIHandler) FeedsGetCredentials(ctx context.Context, request openapi.FeedsGetCredentialsRequestObject) (openapi.FeedsGetCredentialsResponseObject, error) {
+	row, err := h.store.GetFeedCredential(ctx, request.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return openapi.FeedsGetCredentials200JSONResponse(openapi.GetFeedCredentialsResponse{}), nil
+	}
+	if err != nil {
+		return openapi.FeedsGetCredentials500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	if h.credentialCipher == nil {
+		return openapi.FeedsGetCredentials500JSONResponse{Code: "invalid_argument", Message: feedauth.ErrNoKey.Error()}, nil
+	}
+	creds, err := h.credentialCipher.Decrypt(row.EncryptedData)
+	if err != nil {
+		return openapi.FeedsGetCredentials500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	updatedAt, err := parseOpenAPITime(row.UpdatedAt)
+	if err != nil {
+		return openapi.FeedsGetCredentials500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	// Only the shape of the credentials is returned; secrets never leave the server.
+	headerNames := make([]string, 0, len(creds.Headers))
+	for name := range creds.Headers {
+		headerNames = append(headerNames, name)
+	}
+	slices.Sort(headerNames)
+	return openapi.FeedsGetCredentials200JSONResponse(openapi.GetFeedCredentialsResponse{
+		Credentials: &openapi.FeedCredentials{
+			AuthType:    row.AuthType,
+			HeaderNames: headerNames,
+			UpdatedAt:   updatedAt,
+		},
+	}), nil
+}
+
+func (h *OpenAPIHandler) FeedsSetCredentials(ctx context.Context, request openapi.FeedsSetCredentialsRequestObject) (openapi.FeedsSetCredentialsResponseObject, error) {
+	if request.Body == nil {
+		return openapi.FeedsSetCredentials500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	if h.credentialCipher == nil {
+		return openapi.FeedsSetCredentials500JSONResponse{Code: "invalid_argument", Message: feedauth.ErrNoKey.Error()}, nil
+	}
+	if _, err := h.store.GetFeed(ctx, request.Id); err != nil {
+		return openapi.FeedsSetCredentials500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	creds := feedauth.Credentials{AuthType: request.Body.AuthType}
+	if request.Body.Username != nil {
+		creds.Username = *request.Body.Username
+	}
+	if request.Body.Password != nil {
+		creds.Password = *request.Body.Password
+	}
+	if request.Body.Token != nil {
+		creds.Token = *request.Body.Token
+	}
+	if request.Body.Headers != nil {
+		creds.Headers = *request.Body.Headers
+	}
+	if err := creds.Validate(); err != nil {
+		return openapi.FeedsSetCredentials500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	encrypted, err := h.credentialCipher.Encrypt(creds)
+	if err != nil {
+		return openapi.FeedsSetCredentials500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	if err := h.store.UpsertFeedCredential(ctx, store.UpsertFeedCredentialParams{
+		FeedID:        request.Id,
+		AuthType:      creds.AuthType,
+		EncryptedData: encrypted,
+	}); err != nil {
+		return openapi.FeedsSetCredentials500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	return openapi.FeedsSetCredentials200Response{}, nil
+}
+
+func (h *OpenAPIHandler) FeedsDeleteCredentials(ctx context.Context, request openapi.FeedsDeleteCredentialsRequestObject) (openapi.FeedsDeleteCredentialsResponseObject, error) {
+	if err := h.store.DeleteFeedCredential(ctx, request.Id); err != nil {
+		return openapi.FeedsDeleteCredentials500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	return openapi.FeedsDeleteCredentials200Response{}, nil
+}
+
 func (h *OpenAPIHandler) FeedTagsList(ctx context.Context, request openapi.FeedTagsListRequestObject) (openapi.FeedTagsListResponseObject, error) {
 	params := store.ListFeedTagsParams{}
 	if request.Params.FeedId != nil {
@@ -233,6 +401,14 @@ func (h *OpenAPIHandler) ItemsList(ctx context.Context, request openapi.ItemsLis
 			isRead = int64(0)
 		}
 	}
+	var isStarred any
+	if request.Params.IsStarred != nil {
+		if *request.Params.IsStarred {
+			isStarred = int64(1)
+		} else {
+			isStarred = int64(0)
+		}
+	}
 	var tagID any
 	if request.Params.TagId != nil {
 		tagID = *request.Params.TagId
@@ -241,14 +417,96 @@ func (h *OpenAPIHandler) ItemsList(ctx context.Context, request openapi.ItemsLis
 	if request.Params.Since != nil {
 		since = request.Params.Since.UTC().Format(time.RFC3339)
 	}
+	var until any
+	if request.Params.Until != nil {
+		if request.Params.Since != nil && !request.Params.Since.Before(*request.Params.Until) {
+			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: "since must be before until"}, nil
+		}
+		until = request.Params.Until.UTC().Format(time.RFC3339)
+	}
+	var publishedSince any
+	if request.Params.PublishedSince != nil {
+		publishedSince = request.Params.PublishedSince.UTC().Format(time.RFC3339)
+	}
+	var publishedUntil any
+	if request.Params.PublishedUntil != nil {
+		if request.Params.PublishedSince != nil && !request.Params.PublishedSince.Before(*request.Params.PublishedUntil) {
+			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: "publishedSince must be before publishedUntil"}, nil
+		}
+		publishedUntil = request.Params.PublishedUntil.UTC().Format(time.RFC3339)
+	}
+	var author any
+	if value := trimmedOrNil(request.Params.Author); value != nil {
+		author = *value
+	}
+	var category any
+	if value := trimmedOrNil(request.Params.Category); value != nil {
+		category = *value
+	}
+	var hasImage any
+	if request.Params.HasImage != nil {
+		if *request.Params.HasImage {
+			hasImage = int64(1)
+		} else {
+			hasImage = int64(0)
+		}
+	}
+	// Blocked items are left out unless they are asked for.
+	var isBlocked any = false
+	if request.Params.IncludeBlocked != nil && *request.Params.IncludeBlocked {
+		isBlocked = nil
+	}
+
+	var hasEnclosure any
+	if request.Params.HasEnclosure != nil {
+		if *request.Params.HasEnclosure {
+			hasEnclosure = int64(1)
+		} else {
+			hasEnclosure = int64(0)
+		}
+	}
+	var mediaType any
+	if mt := strings.ToLower(strings.TrimSpace(valueOrEmpty(request.Params.MediaType))); mt != "" {
+		mediaType = mt
+	}
+	format, err := parseItemFormat(request.Params.Format)
+	if err != nil {
+		return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	order, err := store.ParseItemOrder(strings.ToLower(strings.TrimSpace(valueOrEmpty(request.Params.Order))))
+	if err != nil {
+		return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	var savedSearchID any
+	if request.Params.SavedSearchId != nil {
+		if _, err := h.store.GetSavedSearch(ctx, *request.Params.SavedSearchId); errors.Is(err, sql.ErrNoRows) {
+			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("saved search %s not found", *request.Params.SavedSearchId)}, nil
+		} else if err != nil {
+			return openapi.ItemsList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		savedSearchID = *request.Params.SavedSearchId
+	}
 
 	params := store.StoreListItemsParams{
-		FeedID:    feedID,
-		IsRead:    isRead,
-		TagID:     tagID,
-		Since:     since,
-		Limit:     pageSize + 1,
-		IsBlocked: false,
+		FeedID:         feedID,
+		IsRead:         isRead,
+		IsStarred:      isStarred,
+		TagID:          tagID,
+		Since:          since,
+		Limit:          pageSize + 1,
+		IsBlocked:      isBlocked,
+		HasEnclosure:   hasEnclosure,
+		MediaType:      mediaType,
+		SavedSearchID:  savedSearchID,
+		Order:          order,
+		Until:          until,
+		PublishedSince: publishedSince,
+		PublishedUntil: publishedUntil,
+		Author:         author,
+		Category:       category,
+		HasImage:       hasImage,
+		FeedIDs:        uniqueIDs(request.Params.FeedIds),
+		TagIDs:         uniqueIDs(request.Params.TagIds),
 	}
 
 	if pageToken := valueOrEmpty(request.Params.PageToken); pageToken != "" {
@@ -260,14 +518,25 @@ func (h *OpenAPIHandler) ItemsList(ctx context.Context, request openapi.ItemsLis
 		if err := json.Unmarshal(b, &token); err != nil {
 			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: %v", err)}, nil
 		}
-		if token.CreatedAt == "" || token.ID == "" {
-			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: "invalid page_token: both created_at and id must be provided for pagination"}, nil
+		if tokenOrder, err := store.ParseItemOrder(token.Order); err != nil || tokenOrder != order {
+			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: it was issued for order %q, not %q", token.Order, order)}, nil
+		}
+		dateName, date := "created_at", token.CreatedAt
+		if order.IsPublished() {
+			dateName, date = "hybrid_date", token.HybridDate
 		}
-		createdAt, err := time.Parse(time.RFC3339, token.CreatedAt)
+		if date == "" || token.ID == "" {
+			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: both %s and id must be provided for pagination", dateName)}, nil
+		}
+		parsed, err := time.Parse(time.RFC3339, date)
 		if err != nil {
-			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: created_at must be RFC3339: %v", err)}, nil
+			return openapi.ItemsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: %s must be RFC3339: %v", dateName, err)}, nil
+		}
+		if order.IsPublished() {
+			params.HybridDateCursor = parsed.UTC().Format(time.RFC3339)
+		} else {
+			params.CreatedAtCursor = parsed.UTC().Format(time.RFC3339)
 		}
-		params.CreatedAtCursor = createdAt.UTC().Format(time.RFC3339)
 		params.IDCursor = token.ID
 	}
 
@@ -282,19 +551,43 @@ func (h *OpenAPIHandler) ItemsList(ctx context.Context, request openapi.ItemsLis
 		rows = rows[:pageSize]
 	}
 
+	itemIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		itemIDs = append(itemIDs, row.ID)
+	}
+	enclosuresByItem := make(map[string][]store.ItemEnclosure)
+	if len(itemIDs) > 0 {
+		enclosureRows, err := h.store.ListItemEnclosuresByItemIDs(ctx, itemIDs)
+		if err != nil {
+			return openapi.ItemsList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		for _, enclosure := range enclosureRows {
+			enclosuresByItem[enclosure.ItemID] = append(enclosuresByItem[enclosure.ItemID], enclosure)
+		}
+	}
+
 	items := make([]openapi.Item, 0, len(rows))
 	for _, row := range rows {
 		item, err := listItemsRowToOpenAPI(row)
 		if err != nil {
 			return openapi.ItemsList500JSONResponse{Code: "internal", Message: err.Error()}, nil
 		}
+		enclosures := enclosuresToOpenAPI(enclosuresByItem[row.ID])
+		item.Enclosures = &enclosures
+		formatItem(&item, format, storedItemHTML{Description: row.DescriptionHtml, Content: row.ContentHtml}, true)
+		h.proxyItemMedia(&item, format)
 		items = append(items, item)
 	}
 
 	var nextPageToken string
 	if hasNextPage && len(rows) > 0 {
 		lastRow := rows[len(rows)-1]
-		token := openAPIListItemsPageToken{CreatedAt: lastRow.CreatedAt, ID: lastRow.ID}
+		token := openAPIListItemsPageToken{ID: lastRow.ID, Order: string(order)}
+		if order.IsPublished() {
+			token.HybridDate = lastRow.HybridDate
+		} else {
+			token.CreatedAt = lastRow.CreatedAt
+		}
 		b, err := json.Marshal(token)
 		if err != nil {
 			slog.Error("failed to marshal list items page token", "error", err)
@@ -322,6 +615,10 @@ func (h *OpenAPIHandler) ItemsUpdateStatus(ctx context.Context, request openapi.
 }
 
 func (h *OpenAPIHandler) ItemsGet(ctx context.Context, request openapi.ItemsGetRequestObject) (openapi.ItemsGetResponseObject, error) {
+	format, err := parseItemFormat(request.Params.Format)
+	if err != nil {
+		return openapi.ItemsGet500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
 	row, err := h.store.GetItem(ctx, request.Id)
 	if err != nil {
 		return openapi.ItemsGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
@@ -337,9 +634,37 @@ func (h *OpenAPIHandler) ItemsGet(ctx context.Context, request openapi.ItemsGetR
 	}
 	itemFeeds := itemFeedsToOpenAPI(feeds)
 	item.Feeds = &itemFeeds
+	enclosureRows, err := h.store.ListItemEnclosures(ctx, request.Id)
+	if err != nil {
+		return openapi.ItemsGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	enclosures := enclosuresToOpenAPI(enclosureRows)
+	item.Enclosures = &enclosures
+	formatItem(&item, format, storedItemHTML{Description: row.DescriptionHtml, Content: row.ContentHtml, FullContent: row.FullContentHtml}, false)
+	h.proxyItemMedia(&item, format)
 	return openapi.ItemsGet200JSONResponse(openapi.GetItemResponse{Item: &item}), nil
 }
 
+func (h *OpenAPIHandler) ItemsExtractContent(ctx context.Context, request openapi.ItemsExtractContentRequestObject) (openapi.ItemsExtractContentResponseObject, error) {
+	if h.contentExtractor == nil {
+		return openapi.ItemsExtractContent500JSONResponse{Code: "internal", Message: "content extractor is not configured"}, nil
+	}
+	if err := h.contentExtractor.ExtractItem(ctx, request.Id); err != nil {
+		return openapi.ItemsExtractContent500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	row, err := h.store.GetItem(ctx, request.Id)
+	if err != nil {
+		return openapi.ItemsExtractContent500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	item, err := getItemRowToOpenAPI(row)
+	if err != nil {
+		return openapi.ItemsExtractContent500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	h.proxyItemMedia(&item, formatMarkdown)
+	return openapi.ItemsExtractContent200JSONResponse(openapi.ExtractItemContentResponse{Item: item}), nil
+}
+
 func (h *OpenAPIHandler) ItemReadsList(ctx context.Context, request openapi.ItemReadsListRequestObject) (openapi.ItemReadsListResponseObject, error) {
 	limit := int64(100)
 	if request.Params.PageSize != nil {
@@ -842,6 +1167,23 @@ func (h *OpenAPIHandler) TagIgnoreWindowsManage(ctx context.Context, request ope
 	return openapi.TagIgnoreWindowsManage200Response{}, nil
 }
 
+func (h *OpenAPIHandler) FetchQueueGet(ctx context.Context, request openapi.FetchQueueGetRequestObject) (openapi.FetchQueueGetResponseObject, error) {
+	hosts := []openapi.FetchQueueHost{}
+	if h.fetchQueue != nil {
+		for _, stat := range h.fetchQueue.HostStats() {
+			hosts = append(hosts, openapi.FetchQueueHost{
+				Host:          stat.Host,
+				Queued:        int32(stat.Queued),
+				Active:        int32(stat.Active),
+				DeferredUntil: stat.DeferredUntil,
+			})
+		}
+	}
+	return openapi.FetchQueueGet200JSONResponse(openapi.GetFetchQueueResponse{
+		Hosts: hosts,
+	}), nil
+}
+
 func parseAndValidateTimeOfDay(s string) (int, error) {
 	trimmed := strings.TrimSpace(s)
 	if trimmed == "24:00" {
@@ -925,9 +1267,14 @@ func parseOpenAPITime(value string) (time.Time, error) {
 	return parsed, nil
 }
 
+// openAPIListItemsPageToken holds the sort key of the last item of a page:
+// CreatedAt for the created orders and HybridDate for the published ones. An
+// empty Order is store.ItemOrderCreatedAsc.
 type openAPIListItemsPageToken struct {
-	CreatedAt string `json:"created_at"`
-	ID        string `json:"id"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	HybridDate string `json:"hybrid_date,omitempty"`
+	ID         string `json:"id"`
+	Order      string `json:"order,omitempty"`
 }
 
 type openAPIListItemReadPageToken struct {
@@ -940,6 +1287,13 @@ func (h *OpenAPIHandler) createFeedFromURL(ctx context.Context, feedURL string,
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch feed: %w", err)
 	}
+	return h.createFetchedFeed(ctx, feedURL, fetchedFeed, titleOverride, tagIDs, nil)
+}
+
+// createFetchedFeed subscribes to a feed that was just fetched. setup, if set,
+// runs in the transaction creating the feed, before its first fetch is
+// scheduled.
+func (h *OpenAPIHandler) createFetchedFeed(ctx context.Context, feedURL string, fetchedFeed *gofeed.Feed, titleOverride *string, tagIDs []string, setup func(qtx *store.Queries, feedID string) error) (*store.FullFeed, error) {
 	newUUID, err := h.uuidGenerator.NewRandom()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate UUID: %w", err)
@@ -955,34 +1309,47 @@ func (h *OpenAPIHandler) createFeedFromURL(ctx context.Context, feedURL string,
 		imageURL = strPtr(fetchedFeed.Image.URL)
 	}
 	title := fetchedFeed.Title
-	if titleOverride != nil && *titleOverride != "" {
+	var titleOverridden int64
+	if titleOverride != nil && *titleOverride != "" && *titleOverride != fetchedFeed.Title {
+		// Refreshing the feed's metadata keeps a title chosen by the user.
 		title = *titleOverride
-	}
-	feed, err := h.store.CreateFeed(ctx, store.CreateFeedParams{
-		ID:          newUUID.String(),
-		Url:         feedURL,
-		Title:       strPtr(title),
-		Description: strPtr(fetchedFeed.Description),
-		Link:        strPtr(fetchedFeed.Link),
-		Lang:        strPtr(fetchedFeed.Language),
-		ImageUrl:    imageURL,
-		Copyright:   strPtr(fetchedFeed.Copyright),
-		FeedType:    strPtr(fetchedFeed.FeedType),
-		FeedVersion: strPtr(fetchedFeed.FeedVersion),
+		titleOverridden = 1
+	}
+	feedID := newUUID.String()
+	err = h.store.WithTransaction(ctx, func(qtx *store.Queries) error {
+		_, err := qtx.CreateFeed(ctx, store.CreateFeedParams{
+			ID:              feedID,
+			Url:             feedURL,
+			Title:           strPtr(title),
+			Description:     strPtr(fetchedFeed.Description),
+			Link:            strPtr(fetchedFeed.Link),
+			Lang:            strPtr(fetchedFeed.Language),
+			ImageUrl:        imageURL,
+			Copyright:       strPtr(fetchedFeed.Copyright),
+			FeedType:        strPtr(fetchedFeed.FeedType),
+			FeedVersion:     strPtr(fetchedFeed.FeedVersion),
+			TitleOverridden: titleOverridden,
+		})
+		if err != nil {
+			return err
+		}
+		if setup != nil {
+			if err := setup(qtx, feedID); err != nil {
+				return err
+			}
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		return qtx.MarkFeedFetched(ctx, store.MarkFeedFetchedParams{FeedID: feedID, NextFetch: &now})
 	})
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now().UTC().Format(time.RFC3339)
-	if err := h.store.MarkFeedFetched(ctx, store.MarkFeedFetchedParams{FeedID: feed.ID, NextFetch: &now}); err != nil {
-		return nil, err
-	}
 	if len(tagIDs) > 0 {
-		if err := h.store.SetFeedTags(ctx, feed.ID, tagIDs); err != nil {
+		if err := h.store.SetFeedTags(ctx, feedID, tagIDs); err != nil {
 			return nil, err
 		}
 	}
-	feedWithSchedule, err := h.store.GetFeed(ctx, feed.ID)
+	feedWithSchedule, err := h.store.GetFeed(ctx, feedID)
 	if err != nil {
 		return nil, err
 	}
@@ -1005,6 +1372,10 @@ func (h *OpenAPIHandler) exportOPML(ctx context.Context, ids []string) ([]byte,
 	for _, row := range tagRows {
 		tagsByFeed[row.FeedID] = append(tagsByFeed[row.FeedID], row.Name)
 	}
+	podcastIDs, err := h.store.ListPodcastFeedIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
 	exportFeeds := make([]ExportFeed, len(feeds))
 	for i, feed := range feeds {
 		title := feed.Url
@@ -1023,7 +1394,7 @@ func (h *OpenAPIHandler) exportOPML(ctx context.Context, ids []string) ([]byte,
 		if tagNames == nil {
 			tagNames = []string{}
 		}
-		exportFeeds[i] = ExportFeed{Title: title, XmlURL: feed.Url, HtmlURL: link, Tags: tagNames, Type: feedType}
+		exportFeeds[i] = ExportFeed{Title: title, XmlURL: feed.Url, HtmlURL: link, Tags: tagNames, Type: feedType, Podcast: slices.Contains(podcastIDs, feed.ID)}
 	}
 	return ExportOPML(exportFeeds)
 }
@@ -1064,17 +1435,45 @@ func (h *OpenAPIHandler) fullFeedToOpenAPI(ctx context.Context, feed store.FullF
 	if err != nil {
 		return openapi.Feed{}, err
 	}
+	lastSuccessAt, err := parseOptionalOpenAPITime(feed.LastSuccessAt)
+	if err != nil {
+		return openapi.Feed{}, err
+	}
+	disabledAt, err := parseOptionalOpenAPITime(feed.DisabledAt)
+	if err != nil {
+		return openapi.Feed{}, err
+	}
+	var lastStatusCode *int32
+	if feed.LastStatusCode != nil {
+		status := int32(*feed.LastStatusCode)
+		lastStatusCode = &status
+	}
+	var consecutiveFailures int32
+	if feed.ConsecutiveFailures != nil {
+		consecutiveFailures = int32(*feed.ConsecutiveFailures)
+	}
 	return openapi.Feed{
-		Id:            feed.ID,
-		Url:           feed.Url,
-		Link:          feed.Link,
-		Title:         title,
-		LastFetchedAt: lastFetchedAt,
-		NextFetchAt:   nextFetchAt,
-		CreatedAt:     createdAt,
-		UpdatedAt:     updatedAt,
-		Tags:          openAPITags,
-		UnreadCount:   strconv.FormatInt(unreadCount, 10),
+		Id:                  feed.ID,
+		Url:                 feed.Url,
+		Link:                feed.Link,
+		Title:               title,
+		LastFetchedAt:       lastFetchedAt,
+		NextFetchAt:         nextFetchAt,
+		CreatedAt:           createdAt,
+		UpdatedAt:           updatedAt,
+		Tags:                openAPITags,
+		UnreadCount:         strconv.FormatInt(unreadCount, 10),
+		LastStatusCode:      lastStatusCode,
+		LastError:           feed.LastError,
+		LastErrorKind:       feed.LastErrorKind,
+		ConsecutiveFailures: consecutiveFailures,
+		LastSuccessAt:       lastSuccessAt,
+		RedirectUrl:         feed.RedirectUrl,
+		DisabledAt:          disabledAt,
+		DisabledReason:      feed.DisabledReason,
+		FetchFullContent:    feed.FetchFullContent != 0,
+		UnreadOnRevision:    feed.UnreadOnRevision != 0,
+		ScheduleReason:      feed.ScheduleReason,
 	}, nil
 }
 
@@ -1132,19 +1531,43 @@ func getItemRowToOpenAPI(item store.GetItemRow) (openapi.Item, error) {
 	if err != nil {
 		return openapi.Item{}, err
 	}
+	fullContentFetchedAt, err := parseOptionalOpenAPITime(item.FullContentFetchedAt)
+	if err != nil {
+		return openapi.Item{}, err
+	}
+	revisedAt, err := parseOptionalOpenAPITime(item.RevisedAt)
+	if err != nil {
+		return openapi.Item{}, err
+	}
+	starredAt, err := parseOptionalOpenAPITime(item.StarredAt)
+	if err != nil {
+		return openapi.Item{}, err
+	}
+	userTags, err := store.DecodeUserTags(item.UserTags)
+	if err != nil {
+		return openapi.Item{}, err
+	}
 	return openapi.Item{
-		Id:          item.ID,
-		Url:         item.Url,
-		Title:       stringValue(item.Title),
-		Description: stringValue(item.Description),
-		PublishedAt: publishedAt,
-		FeedId:      item.FeedID,
-		IsRead:      item.IsRead == 1,
-		Author:      stringValue(item.Author),
-		Content:     stringValue(item.Content),
-		ImageUrl:    stringValue(item.ImageUrl),
-		Categories:  stringValue(item.Categories),
-		CreatedAt:   createdAt,
+		Id:                   item.ID,
+		Url:                  item.Url,
+		Title:                stringValue(item.Title),
+		Description:          stringValue(item.Description),
+		PublishedAt:          publishedAt,
+		FeedId:               item.FeedID,
+		IsRead:               item.IsRead == 1,
+		IsStarred:            item.IsStarred == 1,
+		StarredAt:            starredAt,
+		Note:                 item.Note,
+		UserTags:             userTags,
+		Author:               stringValue(item.Author),
+		Content:              stringValue(item.Content),
+		ImageUrl:             stringValue(item.ImageUrl),
+		Categories:           stringValue(item.Categories),
+		CreatedAt:            createdAt,
+		FullContent:          item.FullContent,
+		FullContentError:     item.FullContentError,
+		FullContentFetchedAt: fullContentFetchedAt,
+		RevisedAt:            revisedAt,
 	}, nil
 }
 
@@ -1159,6 +1582,24 @@ func itemFeedsToOpenAPI(feeds []store.ListItemFeedsRow) []openapi.ItemFeed {
 	return result
 }
 
+func enclosuresToOpenAPI(enclosures []store.ItemEnclosure) []openapi.Enclosure {
+	result := make([]openapi.Enclosure, 0, len(enclosures))
+	for _, enclosure := range enclosures {
+		converted := openapi.Enclosure{
+			Url:          enclosure.Url,
+			MimeType:     enclosure.MimeType,
+			Length:       enclosure.Length,
+			ThumbnailUrl: enclosure.ThumbnailUrl,
+		}
+		if enclosure.DurationSeconds != nil {
+			duration := int32(min(*enclosure.DurationSeconds, math.MaxInt32))
+			converted.DurationSeconds = &duration
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
 func listItemsRowToOpenAPI(row store.ListItemsRow) (openapi.Item, error) {
 	return getItemRowToOpenAPI(store.GetItemRow{
 		ID:          row.ID,
@@ -1172,8 +1613,13 @@ func listItemsRowToOpenAPI(row store.ListItemsRow) (openapi.Item, error) {
 		ImageUrl:    row.ImageUrl,
 		Categories:  row.Categories,
 		CreatedAt:   row.CreatedAt,
+		RevisedAt:   row.RevisedAt,
 		FeedID:      row.FeedID,
 		IsRead:      row.IsRead,
+		IsStarred:   row.IsStarred,
+		StarredAt:   row.StarredAt,
+		Note:        row.Note,
+		UserTags:    row.UserTags,
 	})
 }
 
@@ -1234,13 +1680,13 @@ func (h *OpenAPIHandler) addItemBlockRules(ctx context.Context, rules []openapi.
 			return fmt.Errorf("value is required for rule at index %d", i)
 		}
 		switch rule.RuleType {
-		case "user", "domain", "keyword":
+		case "user", "domain", "keyword", "category":
 		case "user_domain":
 			if rule.Domain == nil || *rule.Domain == "" {
 				return fmt.Errorf("domain is required for user_domain rule at index %d", i)
 			}
 		default:
-			return fmt.Errorf("invalid rule_type at index %d: %s. Must be 'user', 'domain', 'user_domain', or 'keyword'", i, rule.RuleType)
+			return fmt.Errorf("invalid rule_type at index %d: %s. Must be 'user', 'domain', 'user_domain', 'keyword', or 'category'", i, rule.RuleType)
 		}
 		newUUID, err := h.uuidGenerator.NewRandom()
 		if err != nil {
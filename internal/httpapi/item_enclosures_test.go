@@ -0,0 +1,96 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIItemEnclosures(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	podcastTitle := "Show"
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-podcast", Url: "https://example.com/podcast.atom", Title: &podcastTitle, FeedType: new("atom")})
+	assert.NilError(t, err)
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-blog", Url: "https://example.com/blog.xml"})
+	assert.NilError(t, err)
+
+	audio := "audio/mpeg"
+	duration := int64(3723)
+	title := "Episode"
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+		FeedID: "feed-podcast",
+		Url:    "https://example.com/episode",
+		Title:  &title,
+		Enclosures: []store.FetchedEnclosure{
+			{URL: "https://cdn.example.com/episode.mp3", MimeType: &audio, DurationSeconds: &duration},
+		},
+	}))
+	postTitle := "Post"
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-blog", Url: "https://example.com/post", Title: &postTitle}))
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	listItems := func(t *testing.T, query string) []openapi.Item {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items?"+query, nil))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListItemsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return body.Items
+	}
+
+	t.Run("lists items with their enclosures", func(t *testing.T) {
+		items := listItems(t, "mediaType=audio")
+		assert.Equal(t, len(items), 1)
+		assert.Equal(t, items[0].Url, "https://example.com/episode")
+		assert.Assert(t, items[0].Enclosures != nil)
+		assert.DeepEqual(t, *items[0].Enclosures, []openapi.Enclosure{
+			{Url: "https://cdn.example.com/episode.mp3", MimeType: &audio, DurationSeconds: new(int32(3723))},
+		})
+
+		items = listItems(t, "hasEnclosure=false")
+		assert.Equal(t, len(items), 1)
+		assert.Equal(t, items[0].Url, "https://example.com/post")
+		assert.Equal(t, len(*items[0].Enclosures), 0)
+
+		assert.Equal(t, len(listItems(t, "mediaType=video")), 0)
+	})
+
+	t.Run("returns the enclosures of an item", func(t *testing.T) {
+		itemID := listItems(t, "hasEnclosure=true")[0].Id
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items/"+itemID, nil))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.GetItemResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, len(*body.Item.Enclosures), 1)
+		assert.Equal(t, (*body.Item.Enclosures)[0].Url, "https://cdn.example.com/episode.mp3")
+	})
+
+	t.Run("marks podcast feeds in OPML exports", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/feeds/export-opml", strings.NewReader(`{"ids":["feed-podcast","feed-blog"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.ExportOpmlResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		opml := string(body.OpmlContent)
+		assert.Assert(t, strings.Contains(opml, `xmlUrl="https://example.com/podcast.atom" category="/Podcasts" type="rss"`), opml)
+		assert.Assert(t, strings.Contains(opml, `xmlUrl="https://example.com/blog.xml" type="rss"`), opml)
+	})
+}
@@ -0,0 +1,80 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIItemsListFilters(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	for _, id := range []string{"feed-1", "feed-2", "feed-3"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: "https://example.com/" + id + ".xml"})
+		assert.NilError(t, err)
+	}
+	author := "Alice"
+	categories := `["Go"]`
+	for _, item := range []store.SaveFetchedItemParams{
+		{FeedID: "feed-1", Url: "https://example.com/1", Author: &author, Categories: &categories},
+		{FeedID: "feed-2", Url: "https://example.com/2"},
+		{FeedID: "feed-3", Url: "https://example.com/3", Author: &author},
+	} {
+		assert.NilError(t, s.SaveFetchedItem(ctx, item))
+	}
+	rows, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: "feed-3", Limit: 1})
+	assert.NilError(t, err)
+	ruleID := "rule-1"
+	_, err = s.CreateItemBlockRule(ctx, store.CreateItemBlockRuleParams{ID: ruleID, RuleType: "keyword", RuleValue: "blocked"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateItemBlock(ctx, store.CreateItemBlockParams{ItemID: rows[0].ID, RuleID: ruleID}))
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	list := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items?"+query, nil))
+		return rec
+	}
+	listURLs := func(t *testing.T, query string) []string {
+		t.Helper()
+		rec := list(t, query)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListItemsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		urls := make([]string, 0, len(body.Items))
+		for _, item := range body.Items {
+			urls = append(urls, item.Url)
+		}
+		slices.Sort(urls)
+		return urls
+	}
+
+	assert.DeepEqual(t, listURLs(t, "feedIds=feed-1,feed-3"), []string{"https://example.com/1"})
+	assert.DeepEqual(t, listURLs(t, "feedIds=feed-1,feed-3&includeBlocked=true"), []string{"https://example.com/1", "https://example.com/3"})
+	assert.DeepEqual(t, listURLs(t, "author=alice&includeBlocked=true"), []string{"https://example.com/1", "https://example.com/3"})
+	assert.DeepEqual(t, listURLs(t, "category=go"), []string{"https://example.com/1"})
+	assert.DeepEqual(t, listURLs(t, "hasImage=false"), []string{"https://example.com/1", "https://example.com/2"})
+
+	for _, query := range []string{
+		"since=2026-01-02T00:00:00Z&until=2026-01-01T00:00:00Z",
+		"publishedSince=2026-01-02T00:00:00Z&publishedUntil=2026-01-02T00:00:00Z",
+	} {
+		rec := list(t, query)
+		var apiErr openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+		assert.Equal(t, apiErr.Code, "invalid_argument", query)
+	}
+}
@@ -0,0 +1,155 @@
+package httpapi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// itemFormat is the representation of item descriptions and contents.
+type itemFormat string
+
+const (
+	formatMarkdown itemFormat = "markdown"
+	formatHTML     itemFormat = "html"
+	formatText     itemFormat = "text"
+)
+
+// listDescriptionLength is the length of item descriptions in lists.
+const listDescriptionLength = 140
+
+func parseItemFormat(value *string) (itemFormat, error) {
+	switch format := itemFormat(strings.ToLower(strings.TrimSpace(valueOrEmpty(value)))); format {
+	case "", formatMarkdown:
+		return formatMarkdown, nil
+	case formatHTML, formatText:
+		return format, nil
+	default:
+		return "", fmt.Errorf("format must be markdown, html or text, got %q", *value)
+	}
+}
+
+// storedItemHTML is the sanitized HTML an item's Markdown was converted from.
+// Fields are nil for items saved before the HTML was kept.
+type storedItemHTML struct {
+	Description *string
+	Content     *string
+	FullContent *string
+}
+
+// formatItem replaces the Markdown of an item with the requested format. In
+// lists, descriptions are short plain text summaries, escaped for HTML.
+func formatItem(item *openapi.Item, format itemFormat, stored storedItemHTML, list bool) {
+	if format == formatMarkdown {
+		return
+	}
+	convert := func(markdown string, sanitized *string) string {
+		if format == formatHTML {
+			if sanitized != nil {
+				return *sanitized
+			}
+			return textToHTML(markdown)
+		}
+		if sanitized != nil {
+			return htmlToText(*sanitized)
+		}
+		return markdown
+	}
+
+	if list {
+		summary := item.Description
+		if stored.Description != nil {
+			summary = truncateRunes(htmlToText(*stored.Description), listDescriptionLength)
+		}
+		if format == formatHTML {
+			summary = html.EscapeString(summary)
+		}
+		item.Description = summary
+	} else {
+		item.Description = convert(item.Description, stored.Description)
+	}
+	item.Content = convert(item.Content, stored.Content)
+	if item.FullContent != nil {
+		fullContent := convert(*item.FullContent, stored.FullContent)
+		item.FullContent = &fullContent
+	}
+}
+
+// blockElements end a line when converting HTML to text.
+var blockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Br: true, atom.Li: true, atom.Tr: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true,
+	atom.H6: true, atom.Blockquote: true, atom.Pre: true, atom.Figure: true,
+	atom.Figcaption: true, atom.Table: true, atom.Ul: true, atom.Ol: true,
+	atom.Dl: true, atom.Dt: true, atom.Dd: true, atom.Hr: true,
+}
+
+var (
+	spaces     = regexp.MustCompile(`[ \t\r\n\f]+`)
+	lineBreaks = regexp.MustCompile(` *\n *`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToText returns the text of an HTML fragment, with a line per block.
+func htmlToText(fragment string) string {
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(fragment))
+	pre := 0
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			text := lineBreaks.ReplaceAllString(b.String(), "\n")
+			return strings.TrimSpace(blankLines.ReplaceAllString(text, "\n\n"))
+		case html.TextToken:
+			text := string(z.Text())
+			if pre == 0 {
+				text = spaces.ReplaceAllString(text, " ")
+			}
+			b.WriteString(text)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Pre {
+				pre++
+			}
+			if blockElements[a] {
+				b.WriteString("\n")
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Pre && pre > 0 {
+				pre--
+			}
+			if blockElements[a] {
+				b.WriteString("\n")
+			}
+		}
+	}
+}
+
+// textToHTML escapes text as HTML paragraphs, for items without stored HTML.
+func textToHTML(text string) string {
+	var b strings.Builder
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>"))
+		b.WriteString("</p>")
+	}
+	return b.String()
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
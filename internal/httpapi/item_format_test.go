@@ -0,0 +1,100 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIItemFormats(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	description, descriptionHTML := "A **bold** summary", "<p>A <strong>bold</strong> summary</p>"
+	content := "# Title\n\nFirst & second\n\n```\ncode  block\n```"
+	contentHTML := "<h1>Title</h1><p>First &amp; second</p><pre>code  block</pre>"
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+		FeedID:          "feed-1",
+		Url:             "https://example.com/post",
+		Description:     &description,
+		Content:         &content,
+		DescriptionHtml: &descriptionHTML,
+		ContentHtml:     &contentHTML,
+	}))
+	legacy := "Old *item* <b>\n\nSecond"
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-1", Url: "https://example.com/legacy", Content: &legacy}))
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	get := func(t *testing.T, path string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2"+path, nil))
+		return rec
+	}
+	listItems := func(t *testing.T, format string) map[string]openapi.Item {
+		t.Helper()
+		rec := get(t, "/items?format="+format)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListItemsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		items := make(map[string]openapi.Item)
+		for _, item := range body.Items {
+			items[item.Url] = item
+		}
+		return items
+	}
+	getItem := func(t *testing.T, id, format string) openapi.Item {
+		t.Helper()
+		rec := get(t, "/items/"+id+"?format="+format)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.GetItemResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return *body.Item
+	}
+
+	items := listItems(t, "")
+	post, old := items["https://example.com/post"], items["https://example.com/legacy"]
+	assert.Equal(t, post.Content, content)
+	assert.Equal(t, listItems(t, "markdown")["https://example.com/post"].Content, content)
+
+	t.Run("html", func(t *testing.T) {
+		items := listItems(t, "html")
+		assert.Equal(t, items["https://example.com/post"].Description, "A bold summary")
+		assert.Equal(t, items["https://example.com/post"].Content, contentHTML)
+
+		item := getItem(t, post.Id, "html")
+		assert.Equal(t, item.Description, descriptionHTML)
+		assert.Equal(t, item.Content, contentHTML)
+		assert.Equal(t, getItem(t, old.Id, "html").Content, "<p>Old *item* &lt;b&gt;</p><p>Second</p>")
+	})
+
+	t.Run("text", func(t *testing.T) {
+		item := getItem(t, post.Id, "TEXT")
+		assert.Equal(t, item.Description, "A bold summary")
+		assert.Equal(t, item.Content, "Title\n\nFirst & second\n\ncode  block")
+		assert.Equal(t, getItem(t, old.Id, "text").Content, legacy)
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		rec := get(t, "/items?format=pdf")
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+		assert.Assert(t, strings.Contains(rec.Body.String(), "invalid_argument"))
+		rec = get(t, "/items/"+post.Id+"?format=pdf")
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+		assert.Assert(t, strings.Contains(rec.Body.String(), "invalid_argument"))
+	})
+}
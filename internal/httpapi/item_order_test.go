@@ -0,0 +1,93 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIItemsListOrder(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	for _, item := range []struct{ url, publishedAt, createdAt string }{
+		{"https://example.com/1", "2026-01-01T06:00:00Z", "2026-01-01T00:00:00Z"},
+		{"https://example.com/2", "", "2026-01-02T00:00:00Z"},
+		// 2026-01-01T00:00:00Z, before the first item.
+		{"https://example.com/3", "2026-01-01T09:00:00+09:00", "2026-01-03T00:00:00Z"},
+	} {
+		params := store.SaveFetchedItemParams{FeedID: "feed-1", Url: item.url}
+		if item.publishedAt != "" {
+			params.PublishedAt = &item.publishedAt
+		}
+		assert.NilError(t, s.SaveFetchedItem(ctx, params))
+		_, err := s.DB.ExecContext(ctx, "UPDATE items SET created_at = ? WHERE url = ?", item.createdAt, item.url)
+		assert.NilError(t, err)
+	}
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	list := func(t *testing.T, query string) (*httptest.ResponseRecorder, openapi.ListItemsResponse) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items?"+query, nil))
+		var body openapi.ListItemsResponse
+		if rec.Code == http.StatusOK {
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		}
+		return rec, body
+	}
+	listAll := func(t *testing.T, order string) []string {
+		t.Helper()
+		var urls []string
+		query := "pageSize=1&order=" + order
+		for {
+			rec, body := list(t, query)
+			assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+			for _, item := range body.Items {
+				urls = append(urls, item.Url)
+			}
+			if body.NextPageToken == "" {
+				return urls
+			}
+			query = "pageSize=1&order=" + order + "&pageToken=" + body.NextPageToken
+		}
+	}
+
+	for _, tc := range []struct {
+		order string
+		want  []string
+	}{
+		{"", []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"}},
+		{"created_desc", []string{"https://example.com/3", "https://example.com/2", "https://example.com/1"}},
+		{"published_asc", []string{"https://example.com/3", "https://example.com/1", "https://example.com/2"}},
+		{"PUBLISHED_DESC", []string{"https://example.com/2", "https://example.com/1", "https://example.com/3"}},
+	} {
+		t.Run("order "+tc.order, func(t *testing.T) {
+			assert.DeepEqual(t, listAll(t, tc.order), tc.want)
+		})
+	}
+
+	t.Run("Invalid order and mismatched page token", func(t *testing.T) {
+		rec, _ := list(t, "order=newest")
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+		_, body := list(t, "pageSize=1&order=published_desc")
+		assert.Assert(t, body.NextPageToken != "")
+		rec, _ = list(t, "pageSize=1&order=created_desc&pageToken="+body.NextPageToken)
+		var apiErr openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+		assert.Equal(t, apiErr.Code, "invalid_argument")
+	})
+}
@@ -0,0 +1,108 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/textdiff"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+func (h *OpenAPIHandler) ItemsListRevisions(ctx context.Context, request openapi.ItemsListRevisionsRequestObject) (openapi.ItemsListRevisionsResponseObject, error) {
+	rows, err := h.store.ListItemRevisions(ctx, request.Id)
+	if err != nil {
+		return openapi.ItemsListRevisions500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	revisions := make([]openapi.ItemRevision, 0, len(rows))
+	for _, row := range rows {
+		converted, err := itemRevisionToOpenAPI(row)
+		if err != nil {
+			return openapi.ItemsListRevisions500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		revisions = append(revisions, converted)
+	}
+	return openapi.ItemsListRevisions200JSONResponse(openapi.ListItemRevisionsResponse{Revisions: revisions}), nil
+}
+
+// ItemsDiffRevisions renders a unified diff between two revisions of an item.
+// Without parameters it compares the latest revision with the one before it.
+func (h *OpenAPIHandler) ItemsDiffRevisions(ctx context.Context, request openapi.ItemsDiffRevisionsRequestObject) (openapi.ItemsDiffRevisionsResponseObject, error) {
+	var to store.ItemRevision
+	var err error
+	if request.Params.To != nil {
+		to, err = h.getItemRevision(ctx, request.Id, int64(*request.Params.To))
+	} else {
+		to, err = h.store.GetLatestItemRevision(ctx, request.Id)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("item %s has no revisions", request.Id)
+		}
+	}
+	if err != nil {
+		return openapi.ItemsDiffRevisions500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	fromRevision := max(to.Revision-1, 1)
+	if request.Params.From != nil {
+		fromRevision = int64(*request.Params.From)
+	}
+	from, err := h.getItemRevision(ctx, request.Id, fromRevision)
+	if err != nil {
+		return openapi.ItemsDiffRevisions500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	fromAPI, err := itemRevisionToOpenAPI(from)
+	if err != nil {
+		return openapi.ItemsDiffRevisions500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	toAPI, err := itemRevisionToOpenAPI(to)
+	if err != nil {
+		return openapi.ItemsDiffRevisions500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	diff := textdiff.Unified(
+		fmt.Sprintf("revision %d", from.Revision),
+		fmt.Sprintf("revision %d", to.Revision),
+		itemRevisionText(from),
+		itemRevisionText(to),
+		3,
+	)
+	return openapi.ItemsDiffRevisions200JSONResponse(openapi.DiffItemRevisionsResponse{
+		From: fromAPI,
+		To:   toAPI,
+		Diff: diff,
+	}), nil
+}
+
+func (h *OpenAPIHandler) getItemRevision(ctx context.Context, itemID string, revision int64) (store.ItemRevision, error) {
+	row, err := h.store.GetItemRevision(ctx, store.GetItemRevisionParams{ItemID: itemID, Revision: revision})
+	if errors.Is(err, sql.ErrNoRows) {
+		return store.ItemRevision{}, fmt.Errorf("item %s has no revision %d", itemID, revision)
+	}
+	return row, err
+}
+
+// itemRevisionText is the text compared between revisions: the title followed
+// by the content, or the description when the item has no content.
+func itemRevisionText(revision store.ItemRevision) string {
+	body := stringValue(revision.Content)
+	if body == "" {
+		body = stringValue(revision.Description)
+	}
+	return stringValue(revision.Title) + "\n\n" + body + "\n"
+}
+
+func itemRevisionToOpenAPI(revision store.ItemRevision) (openapi.ItemRevision, error) {
+	createdAt, err := parseOpenAPITime(revision.CreatedAt)
+	if err != nil {
+		return openapi.ItemRevision{}, err
+	}
+	return openapi.ItemRevision{
+		Revision:    int32(revision.Revision),
+		ContentHash: revision.ContentHash,
+		Title:       stringValue(revision.Title),
+		CreatedAt:   createdAt,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIItemRevisions(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://news.example/feed.xml"})
+	assert.NilError(t, err)
+	for _, content := range []string{"First line\nSecond line\n", "First line\nSecond line, corrected\n"} {
+		title := "Story"
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-1", Url: "https://news.example/story", Title: &title, Content: &content}))
+	}
+	items, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10})
+	assert.NilError(t, err)
+	itemID := items[0].ID
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+
+	t.Run("lists revisions", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items/"+itemID+"/revisions", nil))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.ListItemRevisionsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, len(body.Revisions), 2)
+		assert.Equal(t, body.Revisions[0].Revision, int32(2))
+		assert.Equal(t, body.Revisions[1].Revision, int32(1))
+		assert.Assert(t, body.Revisions[0].ContentHash != body.Revisions[1].ContentHash)
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items/"+itemID, nil))
+		var item openapi.GetItemResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &item))
+		assert.Assert(t, item.Item.RevisedAt != nil)
+	})
+
+	t.Run("diffs the latest revision", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items/"+itemID+"/revisions/diff", nil))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var body openapi.DiffItemRevisionsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.From.Revision, int32(1))
+		assert.Equal(t, body.To.Revision, int32(2))
+		assert.Assert(t, strings.HasPrefix(body.Diff, "--- revision 1\n+++ revision 2\n"), body.Diff)
+		assert.Assert(t, strings.Contains(body.Diff, "\n-Second line\n+Second line, corrected\n"), body.Diff)
+	})
+
+	t.Run("rejects unknown revisions", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items/"+itemID+"/revisions/diff?from=1&to=5", nil))
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+		assert.Assert(t, strings.Contains(rec.Body.String(), "invalid_argument"), rec.Body.String())
+	})
+
+	t.Run("enables unread on revision per feed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/feeds/unread-on-revision", strings.NewReader(`{"ids":["feed-1"],"enabled":true}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		feed, err := s.GetFeed(ctx, "feed-1")
+		assert.NilError(t, err)
+		assert.Equal(t, feed.UnreadOnRevision, int64(1))
+	})
+}
@@ -0,0 +1,138 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"golang.org/x/net/html"
+)
+
+type openAPISearchItemsPageToken struct {
+	Offset int64 `json:"offset"`
+}
+
+// ItemsSearch runs a full-text search over item titles, descriptions and
+// contents. Results are ranked by relevance, with matches marked by <mark>
+// in their title highlight and snippet, which are HTML.
+func (h *OpenAPIHandler) ItemsSearch(ctx context.Context, request openapi.ItemsSearchRequestObject) (openapi.ItemsSearchResponseObject, error) {
+	match, err := store.ParseSearchQuery(request.Params.Q)
+	if err != nil {
+		return openapi.ItemsSearch500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	pageSize := int64(100)
+	if request.Params.PageSize != nil {
+		if *request.Params.PageSize <= 0 || *request.Params.PageSize > 1000 {
+			return openapi.ItemsSearch500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("page_size must be between 1 and 1000, got %d", *request.Params.PageSize)}, nil
+		}
+		pageSize = int64(*request.Params.PageSize)
+	}
+	format, err := parseItemFormat(request.Params.Format)
+	if err != nil {
+		return openapi.ItemsSearch500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	params := store.SearchItemsParams{Match: match, Limit: pageSize + 1}
+	if request.Params.FeedId != nil {
+		params.FeedID = *request.Params.FeedId
+	}
+	if request.Params.TagId != nil {
+		params.TagID = *request.Params.TagId
+	}
+	if request.Params.IsRead != nil {
+		if *request.Params.IsRead {
+			params.IsRead = int64(1)
+		} else {
+			params.IsRead = int64(0)
+		}
+	}
+	if pageToken := valueOrEmpty(request.Params.PageToken); pageToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return openapi.ItemsSearch500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: %v", err)}, nil
+		}
+		var token openAPISearchItemsPageToken
+		if err := json.Unmarshal(b, &token); err != nil {
+			return openapi.ItemsSearch500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: %v", err)}, nil
+		}
+		if token.Offset < 0 {
+			return openapi.ItemsSearch500JSONResponse{Code: "invalid_argument", Message: "invalid page_token: offset must not be negative"}, nil
+		}
+		params.Offset = token.Offset
+	}
+
+	rows, err := h.store.SearchItems(ctx, params)
+	if err != nil {
+		return openapi.ItemsSearch500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	hasNextPage := len(rows) > int(pageSize)
+	if hasNextPage {
+		rows = rows[:pageSize]
+	}
+
+	itemIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		itemIDs = append(itemIDs, row.ID)
+	}
+	enclosuresByItem := make(map[string][]store.ItemEnclosure)
+	if len(itemIDs) > 0 {
+		enclosureRows, err := h.store.ListItemEnclosuresByItemIDs(ctx, itemIDs)
+		if err != nil {
+			return openapi.ItemsSearch500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		for _, enclosure := range enclosureRows {
+			enclosuresByItem[enclosure.ItemID] = append(enclosuresByItem[enclosure.ItemID], enclosure)
+		}
+	}
+
+	results := make([]openapi.ItemSearchResult, 0, len(rows))
+	for _, row := range rows {
+		item, err := listItemsRowToOpenAPI(row.ListItemsRow)
+		if err != nil {
+			return openapi.ItemsSearch500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		enclosures := enclosuresToOpenAPI(enclosuresByItem[row.ID])
+		item.Enclosures = &enclosures
+		formatItem(&item, format, storedItemHTML{Description: row.DescriptionHtml, Content: row.ContentHtml}, true)
+		h.proxyItemMedia(&item, format)
+		results = append(results, openapi.ItemSearchResult{
+			Item:           item,
+			TitleHighlight: markSearchMatches(row.TitleHighlight),
+			Snippet:        markSearchMatches(row.Snippet),
+			Score:          row.Score,
+		})
+	}
+
+	var nextPageToken string
+	if hasNextPage {
+		b, err := json.Marshal(openAPISearchItemsPageToken{Offset: params.Offset + pageSize})
+		if err != nil {
+			slog.Error("failed to marshal search items page token", "error", err)
+		} else {
+			nextPageToken = base64.RawURLEncoding.EncodeToString(b)
+		}
+	}
+
+	return openapi.ItemsSearch200JSONResponse(openapi.SearchItemsResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// searchHighlightReplacer marks the matches of an escaped highlight or
+// snippet. The markers are private use characters, left as is by escaping.
+var searchHighlightReplacer = strings.NewReplacer(
+	store.SearchHighlightStart, "<mark>",
+	store.SearchHighlightEnd, "</mark>",
+)
+
+// markSearchMatches escapes a highlight or snippet as HTML and marks its
+// matches.
+func markSearchMatches(text string) string {
+	return searchHighlightReplacer.Replace(html.EscapeString(text))
+}
@@ -0,0 +1,94 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIItemsSearch(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-2", Url: "https://example.org/feed.xml"})
+	assert.NilError(t, err)
+
+	save := func(feedID, url, title, description string) {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: feedID, Url: url, Title: &title, Description: &description}))
+	}
+	save("feed-1", "https://example.com/1", "SQLite <internals>", "How the B-tree works")
+	save("feed-1", "https://example.com/2", "Weekly links", "Articles about SQLite & Postgres")
+	save("feed-2", "https://example.org/3", "Postgres tuning", "Vacuum settings")
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	search := func(t *testing.T, query url.Values) (int, openapi.SearchItemsResponse, openapi.ApiError) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items/search?"+query.Encode(), nil))
+		var body openapi.SearchItemsResponse
+		var apiErr openapi.ApiError
+		if rec.Code == http.StatusOK {
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		} else {
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+		}
+		return rec.Code, body, apiErr
+	}
+
+	t.Run("Ranked results with highlights", func(t *testing.T) {
+		code, body, _ := search(t, url.Values{"q": {"sqlite"}})
+		assert.Equal(t, code, http.StatusOK)
+		assert.Equal(t, len(body.Results), 2)
+		first := body.Results[0]
+		assert.Equal(t, first.Item.Url, "https://example.com/1")
+		assert.Equal(t, first.TitleHighlight, "<mark>SQLite</mark> &lt;internals&gt;")
+		assert.Assert(t, first.Score > body.Results[1].Score)
+		assert.Equal(t, body.Results[1].Snippet, "Articles about <mark>SQLite</mark> &amp; Postgres")
+		assert.Equal(t, body.NextPageToken, "")
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		_, body, _ := search(t, url.Values{"q": {"postgres"}, "feedId": {"feed-2"}})
+		assert.Equal(t, len(body.Results), 1)
+		assert.Equal(t, body.Results[0].Item.FeedId, "feed-2")
+
+		_, body, _ = search(t, url.Values{"q": {"postgres"}, "isRead": {"true"}})
+		assert.Equal(t, len(body.Results), 0)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		_, first, _ := search(t, url.Values{"q": {"sqlite OR postgres"}, "pageSize": {"2"}})
+		assert.Equal(t, len(first.Results), 2)
+		assert.Assert(t, first.NextPageToken != "")
+
+		_, second, _ := search(t, url.Values{"q": {"sqlite OR postgres"}, "pageSize": {"2"}, "pageToken": {first.NextPageToken}})
+		assert.Equal(t, len(second.Results), 1)
+		assert.Equal(t, second.NextPageToken, "")
+	})
+
+	t.Run("Invalid queries", func(t *testing.T) {
+		for _, query := range []url.Values{
+			{"q": {""}},
+			{"q": {"sqlite AND"}},
+			{"q": {"sqlite"}, "pageToken": {"!"}},
+			{"q": {"sqlite"}, "pageSize": {"0"}},
+		} {
+			code, _, apiErr := search(t, query)
+			assert.Equal(t, code, http.StatusInternalServerError, query.Encode())
+			assert.Equal(t, apiErr.Code, "invalid_argument", query.Encode())
+		}
+	})
+}
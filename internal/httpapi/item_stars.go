@@ -0,0 +1,149 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+type openAPIListItemStarsPageToken struct {
+	UpdatedAt string `json:"updated_at"`
+	ItemID    string `json:"item_id"`
+}
+
+func (h *OpenAPIHandler) ItemsStar(ctx context.Context, request openapi.ItemsStarRequestObject) (openapi.ItemsStarResponseObject, error) {
+	if request.Body == nil {
+		return openapi.ItemsStar500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	params := store.StoreStarItemParams{ItemID: request.Id, Note: request.Body.Note}
+	if request.Body.UserTags != nil {
+		params.UserTags = *request.Body.UserTags
+	}
+	star, err := h.store.StarItem(ctx, params)
+	if err != nil {
+		return openapi.ItemsStar500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	converted, err := itemStarToOpenAPI(store.ListItemStarsRow{
+		ItemID:    star.ItemID,
+		IsStarred: star.IsStarred,
+		StarredAt: star.StarredAt,
+		Note:      star.Note,
+		UserTags:  star.UserTags,
+		UpdatedAt: star.UpdatedAt,
+	})
+	if err != nil {
+		return openapi.ItemsStar500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.ItemsStar200JSONResponse(openapi.StarItemResponse{ItemStar: converted}), nil
+}
+
+func (h *OpenAPIHandler) ItemsUnstar(ctx context.Context, request openapi.ItemsUnstarRequestObject) (openapi.ItemsUnstarResponseObject, error) {
+	if _, err := h.store.UnstarItem(ctx, request.Id); err != nil {
+		return openapi.ItemsUnstar500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.ItemsUnstar200Response{}, nil
+}
+
+func (h *OpenAPIHandler) ItemStarsList(ctx context.Context, request openapi.ItemStarsListRequestObject) (openapi.ItemStarsListResponseObject, error) {
+	limit := int64(100)
+	if request.Params.PageSize != nil {
+		if *request.Params.PageSize <= 0 || *request.Params.PageSize > 1000 {
+			return openapi.ItemStarsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("page_size must be between 1 and 1000, got %d", *request.Params.PageSize)}, nil
+		}
+		limit = int64(*request.Params.PageSize)
+	}
+	pageToken := valueOrEmpty(request.Params.PageToken)
+	if pageToken != "" && request.Params.Since != nil {
+		return openapi.ItemStarsList500JSONResponse{Code: "invalid_argument", Message: "only one of page_token or since may be specified"}, nil
+	}
+
+	params := store.ListItemStarsParams{Limit: limit + 1}
+	if pageToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return openapi.ItemStarsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: %v", err)}, nil
+		}
+		var token openAPIListItemStarsPageToken
+		if err := json.Unmarshal(b, &token); err != nil {
+			return openapi.ItemStarsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: %v", err)}, nil
+		}
+		if token.UpdatedAt == "" || token.ItemID == "" {
+			return openapi.ItemStarsList500JSONResponse{Code: "invalid_argument", Message: "invalid page_token: both updated_at and item_id must be provided for pagination"}, nil
+		}
+		updatedAt, err := time.Parse(time.RFC3339, token.UpdatedAt)
+		if err != nil {
+			return openapi.ItemStarsList500JSONResponse{Code: "invalid_argument", Message: fmt.Sprintf("invalid page_token: invalid updated_at: %v", err)}, nil
+		}
+		params.UpdatedAtCursor = updatedAt.UTC().Format(time.RFC3339)
+		params.ItemIDCursor = &token.ItemID
+	}
+	if request.Params.Since != nil {
+		params.UpdatedAfter = request.Params.Since.UTC().Format(time.RFC3339)
+	}
+
+	rows, err := h.store.ListItemStars(ctx, params)
+	if err != nil {
+		return openapi.ItemStarsList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	hasNextPage := false
+	if len(rows) > int(limit) {
+		hasNextPage = true
+		rows = rows[:limit]
+	}
+
+	itemStars := make([]openapi.ItemStar, 0, len(rows))
+	for _, row := range rows {
+		converted, err := itemStarToOpenAPI(row)
+		if err != nil {
+			return openapi.ItemStarsList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		itemStars = append(itemStars, converted)
+	}
+
+	var nextPageToken string
+	if hasNextPage && len(rows) > 0 {
+		lastRow := rows[len(rows)-1]
+		token := openAPIListItemStarsPageToken{UpdatedAt: lastRow.UpdatedAt, ItemID: lastRow.ItemID}
+		b, err := json.Marshal(token)
+		if err != nil {
+			slog.Error("failed to marshal item stars page token", "error", err)
+		} else {
+			nextPageToken = base64.RawURLEncoding.EncodeToString(b)
+		}
+	}
+
+	return openapi.ItemStarsList200JSONResponse(openapi.ListItemStarsResponse{
+		ItemStars:     itemStars,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func itemStarToOpenAPI(row store.ListItemStarsRow) (openapi.ItemStar, error) {
+	starredAt, err := parseOptionalOpenAPITime(row.StarredAt)
+	if err != nil {
+		return openapi.ItemStar{}, err
+	}
+	updatedAt, err := parseOpenAPITime(row.UpdatedAt)
+	if err != nil {
+		return openapi.ItemStar{}, err
+	}
+	userTags, err := store.DecodeUserTags(row.UserTags)
+	if err != nil {
+		return openapi.ItemStar{}, err
+	}
+	return openapi.ItemStar{
+		ItemId:    row.ItemID,
+		IsStarred: row.IsStarred == 1,
+		StarredAt: starredAt,
+		Note:      row.Note,
+		UserTags:  userTags,
+		UpdatedAt: updatedAt,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPIItemStars(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	for _, url := range []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"} {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-1", Url: url}))
+	}
+	rows, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10})
+	assert.NilError(t, err)
+	assert.Equal(t, len(rows), 3)
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	do := func(t *testing.T, method, path string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		var reader bytes.Buffer
+		if body != nil {
+			assert.NilError(t, json.NewEncoder(&reader).Encode(body))
+		}
+		req := httptest.NewRequest(method, "/api/v2"+path, &reader)
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	listItems := func(t *testing.T, query string) []openapi.Item {
+		t.Helper()
+		rec := do(t, http.MethodGet, "/items?"+query, nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListItemsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return body.Items
+	}
+
+	note := "Worth a second read"
+	for _, row := range rows[:2] {
+		rec := do(t, http.MethodPut, "/items/"+row.ID+"/star", openapi.StarItemRequest{Note: &note, UserTags: &[]string{"later"}})
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.StarItemResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Assert(t, body.ItemStar.IsStarred)
+		assert.Assert(t, body.ItemStar.StarredAt != nil)
+		assert.DeepEqual(t, body.ItemStar.UserTags, []string{"later"})
+	}
+
+	t.Run("ItemsList filters and returns stars", func(t *testing.T) {
+		items := listItems(t, "isStarred=true")
+		assert.Equal(t, len(items), 2)
+		assert.Assert(t, items[0].IsStarred)
+		assert.Equal(t, *items[0].Note, note)
+		assert.DeepEqual(t, items[0].UserTags, []string{"later"})
+
+		items = listItems(t, "isStarred=false")
+		assert.Equal(t, len(items), 1)
+		assert.Equal(t, items[0].Id, rows[2].ID)
+		assert.DeepEqual(t, items[0].UserTags, []string{})
+	})
+
+	t.Run("Star changes are paged and include unstars", func(t *testing.T) {
+		rec := do(t, http.MethodDelete, "/items/"+rows[0].ID+"/star", nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		rec = do(t, http.MethodGet, "/item-stars?pageSize=1", nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var first openapi.ListItemStarsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &first))
+		assert.Equal(t, len(first.ItemStars), 1)
+		assert.Assert(t, first.NextPageToken != "")
+
+		rec = do(t, http.MethodGet, "/item-stars?pageSize=1&pageToken="+first.NextPageToken, nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var second openapi.ListItemStarsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &second))
+		assert.Equal(t, len(second.ItemStars), 1)
+		assert.Equal(t, second.NextPageToken, "")
+
+		byID := map[string]openapi.ItemStar{}
+		for _, star := range append(first.ItemStars, second.ItemStars...) {
+			byID[star.ItemId] = star
+		}
+		assert.Assert(t, !byID[rows[0].ID].IsStarred)
+		assert.Assert(t, byID[rows[0].ID].Note == nil)
+		assert.Assert(t, byID[rows[1].ID].IsStarred)
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		for _, path := range []string{"/item-stars?pageSize=0", "/item-stars?pageToken=invalid"} {
+			rec := do(t, http.MethodGet, path, nil)
+			var apiErr openapi.ApiError
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+			assert.Equal(t, apiErr.Code, "invalid_argument", path)
+		}
+		rec := do(t, http.MethodPut, "/items/missing/star", openapi.StarItemRequest{})
+		assert.Equal(t, rec.Code, http.StatusInternalServerError, rec.Body.String())
+	})
+}
@@ -0,0 +1,32 @@
+package httpapi
+
+import (
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+)
+
+// proxyItemMedia points the images of an item in the given format at the
+// media proxy, when one is configured. Items are stored with the original
+// URLs, so the signing key can change without rewriting them.
+func (h *OpenAPIHandler) proxyItemMedia(item *openapi.Item, format itemFormat) {
+	if h.mediaProxy == nil {
+		return
+	}
+	var rewrite func(string) string
+	switch format {
+	case formatMarkdown:
+		rewrite = h.mediaProxy.RewriteMarkdown
+	case formatHTML:
+		rewrite = h.mediaProxy.RewriteHTML
+	default:
+		rewrite = func(text string) string { return text }
+	}
+	item.Description = rewrite(item.Description)
+	item.Content = rewrite(item.Content)
+	if item.FullContent != nil {
+		fullContent := rewrite(*item.FullContent)
+		item.FullContent = &fullContent
+	}
+	if item.ImageUrl != "" {
+		item.ImageUrl = h.mediaProxy.URL(item.ImageUrl)
+	}
+}
@@ -0,0 +1,60 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestNewMux_MediaProxy(t *testing.T) {
+	ctx := context.Background()
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(png)
+	}))
+	defer origin.Close()
+
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	title := "Post"
+	content := "Text ![photo](" + origin.URL + "/photo.png) ![inline](data:image/gif;base64,R0lGOD)"
+	imageURL := origin.URL + "/cover.png"
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+		FeedID:   "feed-1",
+		Url:      "https://example.com/post",
+		Title:    &title,
+		Content:  &content,
+		ImageUrl: &imageURL,
+	}))
+
+	proxy, err := mediaproxy.New(mediaproxy.Config{Key: []byte(strings.Repeat("k", 32)), AllowPrivateNetworks: true})
+	assert.NilError(t, err)
+	handler := httpapi.NewMux(httpapi.Dependencies{Store: s, Assets: testAssets(), MediaProxy: proxy})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/items", nil))
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	var body openapi.ListItemsResponse
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, len(body.Items), 1)
+	item := body.Items[0]
+	assert.Equal(t, item.ImageUrl, proxy.URL(imageURL))
+	assert.Equal(t, item.Content, "Text ![photo]("+proxy.URL(origin.URL+"/photo.png")+") ![inline](data:image/gif;base64,R0lGOD)")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, item.ImageUrl, nil))
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	assert.Equal(t, rec.Header().Get("Content-Type"), "image/png")
+	assert.DeepEqual(t, rec.Body.Bytes(), png)
+}
@@ -4,16 +4,22 @@ import (
 	"net/http"
 
 	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
 )
 
 // NewStrictHandler builds the OpenAPI strict server from dependencies.
 func NewStrictHandler(deps Dependencies) openapi.StrictServerInterface {
 	return &OpenAPIHandler{
-		store:         deps.Store,
-		uuidGenerator: realUUIDGenerator{},
-		fetcher:       deps.Fetcher,
-		itemFetcher:   deps.ItemFetcher,
-		opmlImporter:  deps.OPMLImporter,
+		store:            deps.Store,
+		uuidGenerator:    realUUIDGenerator{},
+		fetcher:          deps.Fetcher,
+		itemFetcher:      deps.ItemFetcher,
+		opmlImporter:     deps.OPMLImporter,
+		credentialCipher: deps.CredentialCipher,
+		fetchQueue:       deps.FetchQueue,
+		contentExtractor: deps.ContentExtractor,
+		scraper:          deps.Scraper,
+		mediaProxy:       deps.MediaProxy,
 	}
 }
 
@@ -28,6 +34,12 @@ func NewMux(deps Dependencies) http.Handler {
 		"/api/v2",
 	)
 	mux.Handle("/api/", http.NotFoundHandler())
+	if deps.WebSubHandler != nil {
+		mux.Handle(WebSubCallbackPath, deps.WebSubHandler)
+	}
+	if deps.MediaProxy != nil {
+		mux.Handle(mediaproxy.PathPrefix, deps.MediaProxy)
+	}
 	mux.Handle("/", NewAssetsHandler(deps.Assets))
 	methods := deps.AllowedMethods
 	if methods == "" {
@@ -84,3 +84,23 @@ func TestNewMux_CORSAllowedMethods(t *testing.T) {
 		assert.Equal(t, rec.Header().Get("Access-Control-Allow-Methods"), "GET, HEAD, OPTIONS")
 	})
 }
+
+func TestNewMux_WebSubCallback(t *testing.T) {
+	testStore := setupTestDB(t)
+	var gotPath string
+	handler := httpapi.NewMux(httpapi.Dependencies{
+		Store:  testStore,
+		Assets: testAssets(),
+		WebSubHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			w.WriteHeader(http.StatusAccepted)
+		}),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, httpapi.WebSubCallbackPath+"feed-1", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, rec.Code, http.StatusAccepted)
+	assert.Equal(t, gotPath, "/websub/callback/feed-1")
+}
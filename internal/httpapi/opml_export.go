@@ -2,6 +2,7 @@ package httpapi
 
 import (
 	"encoding/xml"
+	"slices"
 	"strings"
 )
 
@@ -12,8 +13,14 @@ type ExportFeed struct {
 	HtmlURL string
 	Tags    []string
 	Type    string
+	// Podcast marks feeds whose items carry audio enclosures.
+	Podcast bool
 }
 
+// podcastCategory is the OPML category path given to podcast feeds. Imports
+// skip it rather than turning it into a tag.
+const podcastCategory = "/Podcasts"
+
 type opmlOutline struct {
 	Text     string        `xml:"text,attr"`
 	Title    string        `xml:"title,attr"`
@@ -44,8 +51,10 @@ func ExportOPML(feeds []ExportFeed) ([]byte, error) {
 	outlines := make([]opmlOutline, len(feeds))
 	for i, f := range feeds {
 		feedType := f.Type
-		if feedType == "" {
-			feedType = "rss" // Default to rss if unknown
+		// Default to rss if unknown. Podcast clients only subscribe to rss
+		// outlines, whatever the format of the feed.
+		if feedType == "" || f.Podcast {
+			feedType = "rss"
 		}
 
 		outline := opmlOutline{
@@ -56,8 +65,12 @@ func ExportOPML(feeds []ExportFeed) ([]byte, error) {
 			Type:    feedType,
 		}
 
-		if len(f.Tags) > 0 {
-			outline.Category = strings.Join(f.Tags, ",")
+		categories := f.Tags
+		if f.Podcast {
+			categories = append(slices.Clone(categories), podcastCategory)
+		}
+		if len(categories) > 0 {
+			outline.Category = strings.Join(categories, ",")
 		}
 
 		outlines[i] = outline
@@ -0,0 +1,254 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+func (h *OpenAPIHandler) SavedSearchesList(ctx context.Context, request openapi.SavedSearchesListRequestObject) (openapi.SavedSearchesListResponseObject, error) {
+	rows, err := h.store.ListSavedSearches(ctx)
+	if err != nil {
+		return openapi.SavedSearchesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	unreadCounts, err := h.savedSearchUnreadCounts(ctx)
+	if err != nil {
+		return openapi.SavedSearchesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	savedSearches := make([]openapi.SavedSearch, 0, len(rows))
+	for _, row := range rows {
+		converted, err := savedSearchToOpenAPI(row, unreadCounts[row.ID])
+		if err != nil {
+			return openapi.SavedSearchesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		savedSearches = append(savedSearches, converted)
+	}
+	return openapi.SavedSearchesList200JSONResponse(openapi.ListSavedSearchesResponse{SavedSearches: savedSearches}), nil
+}
+
+func (h *OpenAPIHandler) SavedSearchesCreate(ctx context.Context, request openapi.SavedSearchesCreateRequestObject) (openapi.SavedSearchesCreateResponseObject, error) {
+	if request.Body == nil {
+		return openapi.SavedSearchesCreate500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	params, err := savedSearchParams(*request.Body)
+	if err != nil {
+		return openapi.SavedSearchesCreate500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	newUUID, err := h.uuidGenerator.NewRandom()
+	if err != nil {
+		return openapi.SavedSearchesCreate500JSONResponse{Code: "internal", Message: fmt.Sprintf("failed to generate UUID: %v", err)}, nil
+	}
+	created, err := h.store.CreateSavedSearch(ctx, newUUID.String(), params)
+	if errors.Is(err, store.ErrInvalidSearchQuery) {
+		return openapi.SavedSearchesCreate500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	if err != nil {
+		return openapi.SavedSearchesCreate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	unreadCounts, err := h.savedSearchUnreadCounts(ctx)
+	if err != nil {
+		return openapi.SavedSearchesCreate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	converted, err := savedSearchToOpenAPI(created, unreadCounts[created.ID])
+	if err != nil {
+		return openapi.SavedSearchesCreate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.SavedSearchesCreate200JSONResponse(openapi.CreateSavedSearchResponse{SavedSearch: converted}), nil
+}
+
+func (h *OpenAPIHandler) SavedSearchesGet(ctx context.Context, request openapi.SavedSearchesGetRequestObject) (openapi.SavedSearchesGetResponseObject, error) {
+	savedSearch, err := h.store.GetSavedSearch(ctx, request.Id)
+	if err != nil {
+		return openapi.SavedSearchesGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	unreadCounts, err := h.savedSearchUnreadCounts(ctx)
+	if err != nil {
+		return openapi.SavedSearchesGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	converted, err := savedSearchToOpenAPI(savedSearch, unreadCounts[savedSearch.ID])
+	if err != nil {
+		return openapi.SavedSearchesGet500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.SavedSearchesGet200JSONResponse(openapi.GetSavedSearchResponse{SavedSearch: converted}), nil
+}
+
+func (h *OpenAPIHandler) SavedSearchesUpdate(ctx context.Context, request openapi.SavedSearchesUpdateRequestObject) (openapi.SavedSearchesUpdateResponseObject, error) {
+	if request.Body == nil {
+		return openapi.SavedSearchesUpdate500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	params, err := savedSearchParams(openapi.CreateSavedSearchRequest(*request.Body))
+	if err != nil {
+		return openapi.SavedSearchesUpdate500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	updated, err := h.store.UpdateSavedSearch(ctx, request.Id, params)
+	if errors.Is(err, store.ErrInvalidSearchQuery) {
+		return openapi.SavedSearchesUpdate500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+	if err != nil {
+		return openapi.SavedSearchesUpdate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	unreadCounts, err := h.savedSearchUnreadCounts(ctx)
+	if err != nil {
+		return openapi.SavedSearchesUpdate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	converted, err := savedSearchToOpenAPI(updated, unreadCounts[updated.ID])
+	if err != nil {
+		return openapi.SavedSearchesUpdate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.SavedSearchesUpdate200JSONResponse(openapi.UpdateSavedSearchResponse{SavedSearch: converted}), nil
+}
+
+func (h *OpenAPIHandler) SavedSearchesDelete(ctx context.Context, request openapi.SavedSearchesDeleteRequestObject) (openapi.SavedSearchesDeleteResponseObject, error) {
+	if err := h.store.DeleteSavedSearch(ctx, request.Id); err != nil {
+		return openapi.SavedSearchesDelete500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.SavedSearchesDelete200Response{}, nil
+}
+
+func (h *OpenAPIHandler) savedSearchUnreadCounts(ctx context.Context) (map[string]int64, error) {
+	rows, err := h.store.CountItemsPerSavedSearch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.SavedSearchID] = row.UnreadCount
+	}
+	return counts, nil
+}
+
+func savedSearchParams(body openapi.CreateSavedSearchRequest) (store.SavedSearchParams, error) {
+	name := strings.TrimSpace(body.Name)
+	if name == "" {
+		return store.SavedSearchParams{}, errors.New("name is required")
+	}
+	params := store.SavedSearchParams{
+		Name:    name,
+		Query:   trimmedOrNil(body.Query),
+		Author:  trimmedOrNil(body.Author),
+		FeedIDs: uniqueIDs(body.FeedIds),
+		TagIDs:  uniqueIDs(body.TagIds),
+	}
+	if body.IsRead != nil {
+		isRead := int64(0)
+		if *body.IsRead {
+			isRead = 1
+		}
+		params.IsRead = &isRead
+	}
+	if body.IsStarred != nil {
+		isStarred := int64(0)
+		if *body.IsStarred {
+			isStarred = 1
+		}
+		params.IsStarred = &isStarred
+	}
+	if body.Since != nil && body.Until != nil && !body.Since.Before(*body.Until) {
+		return store.SavedSearchParams{}, errors.New("since must be before until")
+	}
+	if body.Since != nil {
+		since := body.Since.UTC().Format(time.RFC3339)
+		params.Since = &since
+	}
+	if body.Until != nil {
+		until := body.Until.UTC().Format(time.RFC3339)
+		params.Until = &until
+	}
+	if body.MaxAgeDays != nil {
+		if *body.MaxAgeDays <= 0 {
+			return store.SavedSearchParams{}, fmt.Errorf("maxAgeDays must be positive, got %d", *body.MaxAgeDays)
+		}
+		maxAgeDays := int64(*body.MaxAgeDays)
+		params.MaxAgeDays = &maxAgeDays
+	}
+	return params, nil
+}
+
+func savedSearchToOpenAPI(savedSearch store.FullSavedSearch, unreadCount int64) (openapi.SavedSearch, error) {
+	createdAt, err := parseOpenAPITime(savedSearch.CreatedAt)
+	if err != nil {
+		return openapi.SavedSearch{}, err
+	}
+	updatedAt, err := parseOpenAPITime(savedSearch.UpdatedAt)
+	if err != nil {
+		return openapi.SavedSearch{}, err
+	}
+	since, err := parseOptionalOpenAPITime(savedSearch.Since)
+	if err != nil {
+		return openapi.SavedSearch{}, err
+	}
+	until, err := parseOptionalOpenAPITime(savedSearch.Until)
+	if err != nil {
+		return openapi.SavedSearch{}, err
+	}
+
+	converted := openapi.SavedSearch{
+		Id:          savedSearch.ID,
+		Name:        savedSearch.Name,
+		Query:       savedSearch.Query,
+		FeedIds:     savedSearch.FeedIDs,
+		TagIds:      savedSearch.TagIDs,
+		Author:      savedSearch.Author,
+		Since:       since,
+		Until:       until,
+		UnreadCount: strconv.FormatInt(unreadCount, 10),
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+	if converted.FeedIds == nil {
+		converted.FeedIds = []string{}
+	}
+	if converted.TagIds == nil {
+		converted.TagIds = []string{}
+	}
+	if savedSearch.IsRead != nil {
+		isRead := *savedSearch.IsRead != 0
+		converted.IsRead = &isRead
+	}
+	if savedSearch.IsStarred != nil {
+		isStarred := *savedSearch.IsStarred != 0
+		converted.IsStarred = &isStarred
+	}
+	if savedSearch.MaxAgeDays != nil {
+		maxAgeDays := int32(*savedSearch.MaxAgeDays)
+		converted.MaxAgeDays = &maxAgeDays
+	}
+	return converted, nil
+}
+
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func uniqueIDs(ids *[]string) []string {
+	if ids == nil {
+		return nil
+	}
+	unique := make([]string, 0, len(*ids))
+	for _, id := range *ids {
+		if id = strings.TrimSpace(id); id != "" && !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,111 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestOpenAPISavedSearches(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-2", Url: "https://example.org/feed.xml"})
+	assert.NilError(t, err)
+	save := func(feedID, url, title string) {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: feedID, Url: url, Title: &title}))
+	}
+	save("feed-1", "https://example.com/1", "Go generics")
+	save("feed-1", "https://example.com/2", "Rust traits")
+	save("feed-2", "https://example.org/3", "Generics in Java")
+
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	do := func(t *testing.T, method, path string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		var reader bytes.Buffer
+		if body != nil {
+			assert.NilError(t, json.NewEncoder(&reader).Encode(body))
+		}
+		req := httptest.NewRequest(method, "/api/v2"+path, &reader)
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	query := "generics"
+	rec := do(t, http.MethodPost, "/saved-searches", openapi.CreateSavedSearchRequest{
+		Name:    " Generics ",
+		Query:   &query,
+		FeedIds: &[]string{"feed-1", "feed-1"},
+	})
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	var created openapi.CreateSavedSearchResponse
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.Equal(t, created.SavedSearch.Name, "Generics")
+	assert.DeepEqual(t, created.SavedSearch.FeedIds, []string{"feed-1"})
+	assert.DeepEqual(t, created.SavedSearch.TagIds, []string{})
+	assert.Equal(t, created.SavedSearch.UnreadCount, "1")
+	id := created.SavedSearch.Id
+
+	t.Run("ItemsList takes a saved search", func(t *testing.T) {
+		rec := do(t, http.MethodGet, "/items?savedSearchId="+id, nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListItemsResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, len(body.Items), 1)
+		assert.Equal(t, body.Items[0].Url, "https://example.com/1")
+
+		rec = do(t, http.MethodGet, "/items?savedSearchId=missing", nil)
+		var apiErr openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+		assert.Equal(t, apiErr.Code, "invalid_argument")
+	})
+
+	t.Run("Update, list and delete", func(t *testing.T) {
+		rec := do(t, http.MethodPut, "/saved-searches/"+id, openapi.UpdateSavedSearchRequest{Name: "All generics", Query: &query})
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		rec = do(t, http.MethodGet, "/saved-searches", nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var list openapi.ListSavedSearchesResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		assert.Equal(t, len(list.SavedSearches), 1)
+		assert.Equal(t, list.SavedSearches[0].Name, "All generics")
+		assert.Equal(t, list.SavedSearches[0].UnreadCount, "2")
+		assert.DeepEqual(t, list.SavedSearches[0].FeedIds, []string{})
+
+		rec = do(t, http.MethodDelete, "/saved-searches/"+id, nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		rec = do(t, http.MethodGet, "/saved-searches/"+id, nil)
+		assert.Equal(t, rec.Code, http.StatusInternalServerError, rec.Body.String())
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		invalidQuery := "generics OR"
+		zero := int32(0)
+		for _, body := range []openapi.CreateSavedSearchRequest{
+			{Name: " "},
+			{Name: "Bad query", Query: &invalidQuery},
+			{Name: "Bad age", MaxAgeDays: &zero},
+		} {
+			rec := do(t, http.MethodPost, "/saved-searches", body)
+			var apiErr openapi.ApiError
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+			assert.Equal(t, apiErr.Code, "invalid_argument", body.Name)
+		}
+	})
+}
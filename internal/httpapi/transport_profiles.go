@@ -0,0 +1,322 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"github.com/nakatanakatana/feed-reader/internal/feedtransport"
+	"github.com/nakatanakatana/feed-reader/store"
+)
+
+func (h *OpenAPIHandler) TransportProfilesList(ctx context.Context, request openapi.TransportProfilesListRequestObject) (openapi.TransportProfilesListResponseObject, error) {
+	rows, err := h.store.ListTransportProfiles(ctx)
+	if err != nil {
+		return openapi.TransportProfilesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	profiles := make([]openapi.TransportProfile, 0, len(rows))
+	for _, row := range rows {
+		converted, err := transportProfileToOpenAPI(row)
+		if err != nil {
+			return openapi.TransportProfilesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+		}
+		profiles = append(profiles, converted)
+	}
+
+	return openapi.TransportProfilesList200JSONResponse(openapi.ListTransportProfilesResponse{
+		TransportProfiles: profiles,
+	}), nil
+}
+
+func (h *OpenAPIHandler) TransportProfilesCreate(ctx context.Context, request openapi.TransportProfilesCreateRequestObject) (openapi.TransportProfilesCreateResponseObject, error) {
+	if request.Body == nil {
+		return openapi.TransportProfilesCreate500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	params, err := h.transportProfileParams(*request.Body, nil)
+	if err != nil {
+		return openapi.TransportProfilesCreate500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	newUUID, err := h.uuidGenerator.NewRandom()
+	if err != nil {
+		return openapi.TransportProfilesCreate500JSONResponse{Code: "internal", Message: fmt.Sprintf("failed to generate UUID: %v", err)}, nil
+	}
+	params.ID = newUUID.String()
+
+	created, err := h.store.CreateTransportProfile(ctx, params)
+	if err != nil {
+		return openapi.TransportProfilesCreate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	converted, err := transportProfileToOpenAPI(created)
+	if err != nil {
+		return openapi.TransportProfilesCreate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	return openapi.TransportProfilesCreate200JSONResponse(openapi.CreateTransportProfileResponse{
+		TransportProfile: converted,
+	}), nil
+}
+
+func (h *OpenAPIHandler) TransportProfilesUpdate(ctx context.Context, request openapi.TransportProfilesUpdateRequestObject) (openapi.TransportProfilesUpdateResponseObject, error) {
+	if request.Body == nil {
+		return openapi.TransportProfilesUpdate500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	existing, err := h.store.GetTransportProfile(ctx, request.Id)
+	if err != nil {
+		return openapi.TransportProfilesUpdate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	params, err := h.transportProfileParams(openapi.CreateTransportProfileRequest(*request.Body), existing.ClientKey)
+	if err != nil {
+		return openapi.TransportProfilesUpdate500JSONResponse{Code: "invalid_argument", Message: err.Error()}, nil
+	}
+
+	updated, err := h.store.UpdateTransportProfile(ctx, store.UpdateTransportProfileParams{
+		ID:                 request.Id,
+		Name:               params.Name,
+		ProxyUrl:           params.ProxyUrl,
+		CaBundle:           params.CaBundle,
+		ClientCert:         params.ClientCert,
+		ClientKey:          params.ClientKey,
+		InsecureSkipVerify: params.InsecureSkipVerify,
+		TimeoutSeconds:     params.TimeoutSeconds,
+		RetryMax:           params.RetryMax,
+	})
+	if err != nil {
+		return openapi.TransportProfilesUpdate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	converted, err := transportProfileToOpenAPI(updated)
+	if err != nil {
+		return openapi.TransportProfilesUpdate500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+
+	return openapi.TransportProfilesUpdate200JSONResponse(openapi.UpdateTransportProfileResponse{
+		TransportProfile: converted,
+	}), nil
+}
+
+func (h *OpenAPIHandler) TransportProfilesDelete(ctx context.Context, request openapi.TransportProfilesDeleteRequestObject) (openapi.TransportProfilesDeleteResponseObject, error) {
+	if err := h.store.DeleteTransportProfile(ctx, request.Id); err != nil {
+		return openapi.TransportProfilesDelete500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.TransportProfilesDelete200Response{}, nil
+}
+
+func (h *OpenAPIHandler) FeedTransportProfilesList(ctx context.Context, request openapi.FeedTransportProfilesListRequestObject) (openapi.FeedTransportProfilesListResponseObject, error) {
+	params := store.ListFeedTransportProfilesParams{}
+	if request.Params.FeedId != nil {
+		params.FeedID = *request.Params.FeedId
+	}
+	if request.Params.TransportProfileId != nil {
+		params.TransportProfileID = *request.Params.TransportProfileId
+	}
+	rows, err := h.store.ListFeedTransportProfiles(ctx, params)
+	if err != nil {
+		return openapi.FeedTransportProfilesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	feedTransportProfiles := make([]openapi.FeedTransportProfile, 0, len(rows))
+	for _, row := range rows {
+		feedTransportProfiles = append(feedTransportProfiles, openapi.FeedTransportProfile{
+			FeedId:             row.FeedID,
+			TransportProfileId: row.TransportProfileID,
+		})
+	}
+	return openapi.FeedTransportProfilesList200JSONResponse(openapi.ListFeedTransportProfilesResponse{
+		FeedTransportProfiles: feedTransportProfiles,
+	}), nil
+}
+
+func (h *OpenAPIHandler) FeedTransportProfilesAssign(ctx context.Context, request openapi.FeedTransportProfilesAssignRequestObject) (openapi.FeedTransportProfilesAssignResponseObject, error) {
+	if request.Body == nil {
+		return openapi.FeedTransportProfilesAssign500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	err := h.store.WithTransaction(ctx, func(qtx *store.Queries) error {
+		for _, feedID := range request.Body.FeedIds {
+			if request.Body.TransportProfileId == nil {
+				if err := qtx.DeleteFeedTransportProfile(ctx, feedID); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := qtx.SetFeedTransportProfile(ctx, store.SetFeedTransportProfileParams{
+				FeedID:             feedID,
+				TransportProfileID: *request.Body.TransportProfileId,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return openapi.FeedTransportProfilesAssign500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.FeedTransportProfilesAssign200Response{}, nil
+}
+
+func (h *OpenAPIHandler) TagTransportProfilesList(ctx context.Context, request openapi.TagTransportProfilesListRequestObject) (openapi.TagTransportProfilesListResponseObject, error) {
+	params := store.ListTagTransportProfilesParams{}
+	if request.Params.TagId != nil {
+		params.TagID = *request.Params.TagId
+	}
+	if request.Params.TransportProfileId != nil {
+		params.TransportProfileID = *request.Params.TransportProfileId
+	}
+	rows, err := h.store.ListTagTransportProfiles(ctx, params)
+	if err != nil {
+		return openapi.TagTransportProfilesList500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	tagTransportProfiles := make([]openapi.TagTransportProfile, 0, len(rows))
+	for _, row := range rows {
+		tagTransportProfiles = append(tagTransportProfiles, openapi.TagTransportProfile{
+			TagId:              row.TagID,
+			TransportProfileId: row.TransportProfileID,
+		})
+	}
+	return openapi.TagTransportProfilesList200JSONResponse(openapi.ListTagTransportProfilesResponse{
+		TagTransportProfiles: tagTransportProfiles,
+	}), nil
+}
+
+func (h *OpenAPIHandler) TagTransportProfilesAssign(ctx context.Context, request openapi.TagTransportProfilesAssignRequestObject) (openapi.TagTransportProfilesAssignResponseObject, error) {
+	if request.Body == nil {
+		return openapi.TagTransportProfilesAssign500JSONResponse{Code: "invalid_argument", Message: "request body is required"}, nil
+	}
+	err := h.store.WithTransaction(ctx, func(qtx *store.Queries) error {
+		for _, tagID := range request.Body.TagIds {
+			if request.Body.TransportProfileId == nil {
+				if err := qtx.DeleteTagTransportProfile(ctx, tagID); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := qtx.SetTagTransportProfile(ctx, store.SetTagTransportProfileParams{
+				TagID:              tagID,
+				TransportProfileID: *request.Body.TransportProfileId,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return openapi.TagTransportProfilesAssign500JSONResponse{Code: "internal", Message: err.Error()}, nil
+	}
+	return openapi.TagTransportProfilesAssign200Response{}, nil
+}
+
+// transportProfileParams validates a create or update request and encrypts its
+// client key. When no key is given but a client certificate is, storedKey is kept.
+func (h *OpenAPIHandler) transportProfileParams(body openapi.CreateTransportProfileRequest, storedKey *string) (store.CreateTransportProfileParams, error) {
+	name := strings.TrimSpace(body.Name)
+	if name == "" {
+		return store.CreateTransportProfileParams{}, errors.New("name is required")
+	}
+
+	profile := feedtransport.Profile{
+		ProxyURL:           strings.TrimSpace(stringValue(body.ProxyUrl)),
+		CABundle:           stringValue(body.CaBundle),
+		ClientCert:         stringValue(body.ClientCert),
+		ClientKey:          stringValue(body.ClientKey),
+		InsecureSkipVerify: body.InsecureSkipVerify != nil && *body.InsecureSkipVerify,
+	}
+	if body.TimeoutSeconds != nil {
+		profile.Timeout = time.Duration(*body.TimeoutSeconds) * time.Second
+	}
+	if body.RetryMax != nil {
+		retryMax := int(*body.RetryMax)
+		profile.RetryMax = &retryMax
+	}
+
+	encryptedKey := storedKey
+	if profile.ClientCert == "" {
+		encryptedKey = nil
+	}
+	if profile.ClientKey != "" || encryptedKey != nil {
+		if h.credentialCipher == nil {
+			return store.CreateTransportProfileParams{}, feedauth.ErrNoKey
+		}
+	}
+	if profile.ClientKey == "" && encryptedKey != nil {
+		key, err := h.credentialCipher.Open(*encryptedKey)
+		if err != nil {
+			return store.CreateTransportProfileParams{}, err
+		}
+		profile.ClientKey = string(key)
+	}
+	if err := profile.Validate(); err != nil {
+		return store.CreateTransportProfileParams{}, err
+	}
+	if body.ClientKey != nil && *body.ClientKey != "" {
+		sealed, err := h.credentialCipher.Seal([]byte(*body.ClientKey))
+		if err != nil {
+			return store.CreateTransportProfileParams{}, err
+		}
+		encryptedKey = &sealed
+	}
+
+	params := store.CreateTransportProfileParams{
+		Name:       name,
+		ProxyUrl:   nonEmpty(profile.ProxyURL),
+		CaBundle:   nonEmpty(profile.CABundle),
+		ClientCert: nonEmpty(profile.ClientCert),
+		ClientKey:  encryptedKey,
+	}
+	if profile.InsecureSkipVerify {
+		params.InsecureSkipVerify = 1
+	}
+	if body.TimeoutSeconds != nil {
+		timeout := int64(*body.TimeoutSeconds)
+		params.TimeoutSeconds = &timeout
+	}
+	if body.RetryMax != nil {
+		retryMax := int64(*body.RetryMax)
+		params.RetryMax = &retryMax
+	}
+	return params, nil
+}
+
+func transportProfileToOpenAPI(profile store.TransportProfile) (openapi.TransportProfile, error) {
+	createdAt, err := parseOpenAPITime(profile.CreatedAt)
+	if err != nil {
+		return openapi.TransportProfile{}, err
+	}
+	updatedAt, err := parseOpenAPITime(profile.UpdatedAt)
+	if err != nil {
+		return openapi.TransportProfile{}, err
+	}
+
+	converted := openapi.TransportProfile{
+		Id:                 profile.ID,
+		Name:               profile.Name,
+		ProxyUrl:           profile.ProxyUrl,
+		CaBundle:           profile.CaBundle,
+		ClientCert:         profile.ClientCert,
+		HasClientKey:       profile.ClientKey != nil,
+		InsecureSkipVerify: profile.InsecureSkipVerify != 0,
+		CreatedAt:          createdAt,
+		UpdatedAt:          updatedAt,
+	}
+	if profile.TimeoutSeconds != nil {
+		timeout := int32(*profile.TimeoutSeconds)
+		converted.TimeoutSeconds = &timeout
+	}
+	if profile.RetryMax != nil {
+		retryMax := int32(*profile.RetryMax)
+		converted.RetryMax = &retryMax
+	}
+	return converted, nil
+}
+
+func nonEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,197 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/gen/openapi"
+	"github.com/nakatanakatana/feed-reader/internal/feedauth"
+	"github.com/nakatanakatana/feed-reader/internal/httpapi"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func newTestClientCertificate(t *testing.T) (certPEM, keyPEM string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "feed-reader"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NilError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NilError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestOpenAPITransportProfiles(t *testing.T) {
+	ctx := context.Background()
+	s := setupTestDB(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://intranet.example/feed.xml"})
+	assert.NilError(t, err)
+	_, err = s.CreateTag(ctx, store.CreateTagParams{ID: "tag-1", Name: "intranet"})
+	assert.NilError(t, err)
+
+	c, err := feedauth.NewCipher(bytes.Repeat([]byte{1}, feedauth.KeySize))
+	assert.NilError(t, err)
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s, CredentialCipher: c}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+	do := func(t *testing.T, method, path string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		var reader *strings.Reader
+		if body == nil {
+			reader = strings.NewReader("")
+		} else {
+			encoded, err := json.Marshal(body)
+			assert.NilError(t, err)
+			reader = strings.NewReader(string(encoded))
+		}
+		req := httptest.NewRequest(method, path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	certPEM, keyPEM := newTestClientCertificate(t)
+	rec := do(t, http.MethodPost, "/api/v2/transport-profiles", map[string]any{
+		"name":           "intranet",
+		"proxyUrl":       "socks5://proxy.example:1080",
+		"clientCert":     certPEM,
+		"clientKey":      keyPEM,
+		"timeoutSeconds": 30,
+		"retryMax":       1,
+	})
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	assert.Assert(t, !strings.Contains(rec.Body.String(), "PRIVATE KEY"))
+	var created openapi.CreateTransportProfileResponse
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	profile := created.TransportProfile
+	assert.Equal(t, profile.Name, "intranet")
+	assert.Equal(t, *profile.ProxyUrl, "socks5://proxy.example:1080")
+	assert.Assert(t, profile.HasClientKey)
+	assert.Equal(t, *profile.TimeoutSeconds, int32(30))
+	assert.Equal(t, *profile.RetryMax, int32(1))
+
+	stored, err := s.GetTransportProfile(ctx, profile.Id)
+	assert.NilError(t, err)
+	assert.Assert(t, stored.ClientKey != nil)
+	assert.Assert(t, !strings.Contains(*stored.ClientKey, "PRIVATE KEY"))
+
+	t.Run("update keeps the stored client key", func(t *testing.T) {
+		rec := do(t, http.MethodPut, "/api/v2/transport-profiles/"+profile.Id, map[string]any{
+			"name":       "intranet",
+			"clientCert": certPEM,
+		})
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.UpdateTransportProfileResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Assert(t, body.TransportProfile.HasClientKey)
+		assert.Assert(t, body.TransportProfile.ProxyUrl == nil)
+		assert.Assert(t, body.TransportProfile.TimeoutSeconds == nil)
+	})
+
+	t.Run("rejects invalid settings", func(t *testing.T) {
+		rec := do(t, http.MethodPost, "/api/v2/transport-profiles", map[string]any{
+			"name":     "broken",
+			"proxyUrl": "ftp://proxy.example",
+		})
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+		var body openapi.ApiError
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, body.Code, "invalid_argument")
+		assert.Assert(t, strings.HasPrefix(body.Message, "proxy URL must be"), body.Message)
+	})
+
+	t.Run("assigns profiles to feeds and tags", func(t *testing.T) {
+		rec := do(t, http.MethodPost, "/api/v2/feed-transport-profiles/assign", map[string]any{
+			"feedIds":            []string{"feed-1"},
+			"transportProfileId": profile.Id,
+		})
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		rec = do(t, http.MethodPost, "/api/v2/tag-transport-profiles/assign", map[string]any{
+			"tagIds":             []string{"tag-1"},
+			"transportProfileId": profile.Id,
+		})
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		rec = do(t, http.MethodGet, "/api/v2/feed-transport-profiles?feedId=feed-1", nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var feeds openapi.ListFeedTransportProfilesResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &feeds))
+		assert.DeepEqual(t, feeds.FeedTransportProfiles, []openapi.FeedTransportProfile{{FeedId: "feed-1", TransportProfileId: profile.Id}})
+
+		rec = do(t, http.MethodGet, "/api/v2/tag-transport-profiles?transportProfileId="+profile.Id, nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var tags openapi.ListTagTransportProfilesResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &tags))
+		assert.DeepEqual(t, tags.TagTransportProfiles, []openapi.TagTransportProfile{{TagId: "tag-1", TransportProfileId: profile.Id}})
+
+		rec = do(t, http.MethodPost, "/api/v2/feed-transport-profiles/assign", map[string]any{
+			"feedIds": []string{"feed-1"},
+		})
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		rows, err := s.ListFeedTransportProfiles(ctx, store.ListFeedTransportProfilesParams{FeedID: "feed-1"})
+		assert.NilError(t, err)
+		assert.Equal(t, len(rows), 0)
+	})
+
+	t.Run("delete removes the profile and its assignments", func(t *testing.T) {
+		rec := do(t, http.MethodDelete, "/api/v2/transport-profiles/"+profile.Id, nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		rec = do(t, http.MethodGet, "/api/v2/transport-profiles", nil)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		var body openapi.ListTransportProfilesResponse
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, len(body.TransportProfiles), 0)
+
+		rows, err := s.ListTagTransportProfiles(ctx, store.ListTagTransportProfilesParams{})
+		assert.NilError(t, err)
+		assert.Equal(t, len(rows), 0)
+	})
+}
+
+func TestOpenAPITransportProfilesClientKeyRequiresKey(t *testing.T) {
+	s := setupTestDB(t)
+	handler := openapi.HandlerFromMuxWithBaseURL(
+		openapi.NewStrictHandler(httpapi.NewStrictHandler(httpapi.Dependencies{Store: s}), nil),
+		http.NewServeMux(),
+		"/api/v2",
+	)
+
+	certPEM, keyPEM := newTestClientCertificate(t)
+	payload, err := json.Marshal(map[string]any{"name": "mtls", "clientCert": certPEM, "clientKey": keyPEM})
+	assert.NilError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/transport-profiles", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+	var body openapi.ApiError
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, body.Code, "invalid_argument")
+	assert.Equal(t, body.Message, feedauth.ErrNoKey.Error())
+}
@@ -0,0 +1,496 @@
+// Package mediaproxy serves remote images through signed local URLs, so that
+// reading an item does not reveal the reader to the publisher.
+package mediaproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// PathPrefix is where the proxy is mounted.
+const PathPrefix = "/media/"
+
+// signatureSize is the number of bytes of the HMAC kept in proxy URLs.
+const signatureSize = 16
+
+const (
+	defaultMaxSize       = 10 << 20
+	defaultTimeout       = 30 * time.Second
+	defaultCacheMaxBytes = 1 << 30
+	defaultCacheMaxAge   = 30 * 24 * time.Hour
+)
+
+// cachePruneInterval is how often the cache is pruned of expired images while
+// images are being added to it.
+const cachePruneInterval = time.Hour
+
+// cacheControl lets browsers keep proxied images, which are addressed by the
+// URL of the original.
+const cacheControl = "public, max-age=2592000, immutable"
+
+// contentSecurityPolicy keeps scripts in proxied SVG images from running
+// when they are opened directly.
+const contentSecurityPolicy = "default-src 'none'; style-src 'unsafe-inline'; sandbox"
+
+// ErrKeyTooShort is returned by New when the signing key is too short.
+var ErrKeyTooShort = errors.New("media proxy key must be at least 32 bytes")
+
+// errPrivateAddress is returned when an image resolves to a private address.
+var errPrivateAddress = errors.New("refusing to fetch from a private address")
+
+// Config configures a Proxy.
+type Config struct {
+	// Key signs proxy URLs. Servers sharing a database must share the key.
+	Key []byte
+	// CacheDir is where fetched images are kept. Empty disables caching.
+	CacheDir string
+	// CacheMaxBytes bounds the size of the cache. Once it is exceeded, the
+	// least recently served images are removed. Zero means 1 GiB.
+	CacheMaxBytes int64
+	// CacheMaxAge is how long a cached image is kept after it was last
+	// served. Zero means 30 days.
+	CacheMaxAge time.Duration
+	// MaxSize bounds the size of a proxied image. Zero means 10 MiB.
+	MaxSize int64
+	// Timeout bounds fetching an image. Zero means 30 seconds.
+	Timeout time.Duration
+	// AllowPrivateNetworks allows fetching from loopback and private
+	// addresses, which are refused by default.
+	AllowPrivateNetworks bool
+	Logger               *slog.Logger
+}
+
+// Proxy signs image URLs and serves them.
+type Proxy struct {
+	key           []byte
+	cacheDir      string
+	cacheMaxBytes int64
+	cacheMaxAge   time.Duration
+	maxSize       int64
+	client        *http.Client
+	logger        *slog.Logger
+
+	// cacheSize is the size of the cache when it was last pruned, plus the
+	// images written since.
+	cacheSize     atomic.Int64
+	cachePrunedAt atomic.Int64
+	pruning       sync.Mutex
+}
+
+// New creates a Proxy.
+func New(cfg Config) (*Proxy, error) {
+	if len(cfg.Key) < 32 {
+		return nil, ErrKeyTooShort
+	}
+	if cfg.CacheDir != "" {
+		if err := os.MkdirAll(cfg.CacheDir, 0o755); err != nil {
+			return nil, fmt.Errorf("create media cache directory: %w", err)
+		}
+	}
+	cacheMaxBytes := cfg.CacheMaxBytes
+	if cacheMaxBytes <= 0 {
+		cacheMaxBytes = defaultCacheMaxBytes
+	}
+	cacheMaxAge := cfg.CacheMaxAge
+	if cacheMaxAge <= 0 {
+		cacheMaxAge = defaultCacheMaxAge
+	}
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = refusePrivateAddresses
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	p := &Proxy{
+		key:           cfg.Key,
+		cacheDir:      cfg.CacheDir,
+		cacheMaxBytes: cacheMaxBytes,
+		cacheMaxAge:   cacheMaxAge,
+		maxSize:       maxSize,
+		client:        &http.Client{Transport: transport, Timeout: timeout},
+		logger:        logger,
+	}
+	if p.cacheDir != "" {
+		if err := p.pruneCache(); err != nil {
+			return nil, fmt.Errorf("prune media cache: %w", err)
+		}
+	}
+	return p, nil
+}
+
+// ParseKey decodes a base64 encoded signing key.
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("decode media proxy key: %w", err)
+	}
+	if len(key) < 32 {
+		return nil, ErrKeyTooShort
+	}
+	return key, nil
+}
+
+func refusePrivateAddresses(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast() {
+		return errPrivateAddress
+	}
+	return nil
+}
+
+func (p *Proxy) sign(src string) string {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(src))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureSize])
+}
+
+// URL returns the proxy URL of an image. URLs that are not absolute http or
+// https URLs, such as data URIs, are returned unchanged.
+func (p *Proxy) URL(src string) string {
+	u, err := url.Parse(src)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return src
+	}
+	return PathPrefix + p.sign(src) + "/" + base64.RawURLEncoding.EncodeToString([]byte(src))
+}
+
+// markdownImage matches the destination of a Markdown image, ![alt](url).
+var markdownImage = regexp.MustCompile(`(!\[(?:[^\]\\]|\\.)*\]\()(<[^>\n]*>|[^)\s]+)`)
+
+// RewriteMarkdown points the images of a Markdown document at the proxy.
+func (p *Proxy) RewriteMarkdown(markdown string) string {
+	if !strings.Contains(markdown, "![") {
+		return markdown
+	}
+	return markdownImage.ReplaceAllStringFunc(markdown, func(match string) string {
+		parts := markdownImage.FindStringSubmatch(match)
+		destination := parts[2]
+		if strings.HasPrefix(destination, "<") {
+			return parts[1] + "<" + p.URL(strings.TrimSuffix(strings.TrimPrefix(destination, "<"), ">")) + ">"
+		}
+		return parts[1] + p.URL(destination)
+	})
+}
+
+// RewriteHTML points the images of an HTML fragment at the proxy.
+func (p *Proxy) RewriteHTML(fragment string) string {
+	if !strings.Contains(fragment, "<img") {
+		return fragment
+	}
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return fragment
+	}
+	var b strings.Builder
+	for _, n := range nodes {
+		p.rewriteImages(n)
+		if err := html.Render(&b, n); err != nil {
+			return fragment
+		}
+	}
+	return b.String()
+}
+
+func (p *Proxy) rewriteImages(n *html.Node) {
+	if n.Type == html.ElementNode && n.DataAtom == atom.Img {
+		for i, attr := range n.Attr {
+			if attr.Key == "src" {
+				n.Attr[i].Val = p.URL(attr.Val)
+			}
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		p.rewriteImages(c)
+	}
+}
+
+// ServeHTTP serves /media/<signature>/<base64url of the image URL>.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	signature, encoded, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, PathPrefix), "/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	src := string(decoded)
+	if !hmac.Equal([]byte(signature), []byte(p.sign(src))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	contentType, data, err := p.load(r.Context(), src)
+	if err != nil {
+		p.logger.WarnContext(r.Context(), "failed to proxy media", "url", src, "error", err)
+		http.Error(w, "failed to fetch media", http.StatusBadGateway)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("Content-Security-Policy", contentSecurityPolicy)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+// load returns an image from the cache, fetching and caching it on a miss.
+func (p *Proxy) load(ctx context.Context, src string) (string, []byte, error) {
+	path := p.cachePath(src)
+	if path != "" {
+		if contentType, data, err := p.readCached(path); err == nil {
+			return contentType, data, nil
+		}
+	}
+	contentType, data, err := p.fetch(ctx, src)
+	if err != nil {
+		return "", nil, err
+	}
+	if path != "" {
+		if err := writeCached(path, contentType, data); err != nil {
+			p.logger.WarnContext(ctx, "failed to cache media", "url", src, "error", err)
+		} else {
+			p.cacheSize.Add(int64(len(contentType) + 1 + len(data)))
+			p.maybePruneCache(ctx)
+		}
+	}
+	return contentType, data, nil
+}
+
+func (p *Proxy) fetch(ctx context.Context, src string) (string, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Accept", "image/*")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	if resp.ContentLength > p.maxSize {
+		return "", nil, fmt.Errorf("content length %d exceeds %d bytes", resp.ContentLength, p.maxSize)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, p.maxSize+1))
+	if err != nil {
+		return "", nil, err
+	}
+	if int64(len(data)) > p.maxSize {
+		return "", nil, fmt.Errorf("body exceeds %d bytes", p.maxSize)
+	}
+	contentType := imageContentType(resp.Header.Get("Content-Type"), data)
+	if contentType == "" {
+		return "", nil, fmt.Errorf("not an image: %q", resp.Header.Get("Content-Type"))
+	}
+	return contentType, data, nil
+}
+
+// imageContentType returns the media type of an image, sniffing the body when
+// the server sends a generic type, or "" when it is not an image.
+func imageContentType(header string, data []byte) string {
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil || mediaType == "application/octet-stream" || mediaType == "binary/octet-stream" {
+		mediaType, _, _ = mime.ParseMediaType(http.DetectContentType(data))
+	}
+	if !strings.HasPrefix(mediaType, "image/") {
+		return ""
+	}
+	return mediaType
+}
+
+func (p *Proxy) cachePath(src string) string {
+	if p.cacheDir == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(src))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(p.cacheDir, name[:2], name)
+}
+
+// Cached files hold the content type on their first line, then the image.
+// Their modification time is when they were last served.
+
+func (p *Proxy) readCached(path string) (string, []byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", nil, err
+	}
+	if time.Since(info.ModTime()) > p.cacheMaxAge {
+		return "", nil, errors.New("expired cache entry")
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, err
+	}
+	contentType, data, ok := bytes.Cut(raw, []byte("\n"))
+	if !ok || len(contentType) == 0 {
+		return "", nil, errors.New("corrupt cache entry")
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return string(contentType), data, nil
+}
+
+func writeCached(path, contentType string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.WriteString(contentType + "\n"); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// maybePruneCache prunes the cache once it has grown over its bound, or when
+// it was last pruned over cachePruneInterval ago. Requests arriving while the
+// cache is being pruned leave it to the one pruning it.
+func (p *Proxy) maybePruneCache(ctx context.Context) {
+	if p.cacheSize.Load() <= p.cacheMaxBytes && time.Since(time.Unix(0, p.cachePrunedAt.Load())) < cachePruneInterval {
+		return
+	}
+	if !p.pruning.TryLock() {
+		return
+	}
+	defer p.pruning.Unlock()
+	if err := p.pruneCache(); err != nil {
+		p.logger.WarnContext(ctx, "failed to prune media cache", "error", err)
+	}
+}
+
+// pruneCache removes the images that were not served for cacheMaxAge, then
+// the least recently served ones until the cache is down to nine tenths of
+// cacheMaxBytes, so that it is not pruned again on every write.
+func (p *Proxy) pruneCache() error {
+	type entry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var entries []entry
+	var total int64
+	now := time.Now()
+	err := filepath.WalkDir(p.cacheDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		// Temporary files of images being written are left alone until
+		// they are clearly abandoned.
+		if strings.HasPrefix(d.Name(), ".tmp-") && now.Sub(info.ModTime()) < cachePruneInterval {
+			return nil
+		}
+		if now.Sub(info.ModTime()) > p.cacheMaxAge {
+			return removeCached(path)
+		}
+		entries = append(entries, entry{path: path, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if total > p.cacheMaxBytes {
+		slices.SortFunc(entries, func(a, b entry) int { return a.modTime.Compare(b.modTime) })
+		for _, e := range entries {
+			if total <= p.cacheMaxBytes/10*9 {
+				break
+			}
+			if err := removeCached(e.path); err != nil {
+				return err
+			}
+			total -= e.size
+		}
+	}
+	p.cacheSize.Store(total)
+	p.cachePrunedAt.Store(now.UnixNano())
+	return nil
+}
+
+func removeCached(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package mediaproxy_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/internal/mediaproxy"
+	"gotest.tools/v3/assert"
+)
+
+var testKey = []byte(strings.Repeat("k", 32))
+
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestNew(t *testing.T) {
+	_, err := mediaproxy.New(mediaproxy.Config{Key: []byte("short")})
+	assert.Assert(t, errors.Is(err, mediaproxy.ErrKeyTooShort))
+
+	key, err := mediaproxy.ParseKey(base64.StdEncoding.EncodeToString(testKey) + "\n")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, key, testKey)
+	_, err = mediaproxy.ParseKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Assert(t, errors.Is(err, mediaproxy.ErrKeyTooShort))
+}
+
+func TestProxy_URL(t *testing.T) {
+	proxy, err := mediaproxy.New(mediaproxy.Config{Key: testKey})
+	assert.NilError(t, err)
+	other, err := mediaproxy.New(mediaproxy.Config{Key: []byte(strings.Repeat("o", 32))})
+	assert.NilError(t, err)
+
+	signed := proxy.URL("https://example.com/a.png")
+	assert.Assert(t, strings.HasPrefix(signed, mediaproxy.PathPrefix))
+	assert.Equal(t, proxy.URL("https://example.com/a.png"), signed)
+	assert.Assert(t, other.URL("https://example.com/a.png") != signed)
+
+	for _, src := range []string{"data:image/gif;base64,R0lGOD", "/relative.png", "javascript:alert(1)", ""} {
+		assert.Equal(t, proxy.URL(src), src)
+	}
+}
+
+func TestProxy_RewriteMarkdown(t *testing.T) {
+	proxy, err := mediaproxy.New(mediaproxy.Config{Key: testKey})
+	assert.NilError(t, err)
+	a, b := "https://example.com/a.png", "https://example.com/b c.png"
+
+	assert.Equal(t,
+		proxy.RewriteMarkdown(`See [link](https://example.com/) ![A \] b](`+a+` "title") and ![](<`+b+`>)`),
+		`See [link](https://example.com/) ![A \] b](`+proxy.URL(a)+` "title") and ![](<`+proxy.URL(b)+`>)`,
+	)
+	assert.Equal(t, proxy.RewriteMarkdown("no images"), "no images")
+}
+
+func TestProxy_ServeHTTP(t *testing.T) {
+	var requests atomic.Int32
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/image.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(testPNG)
+		case "/sniffed":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(testPNG)
+		case "/page.html":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html></html>"))
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(make([]byte, 2048))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer origin.Close()
+
+	cacheDir := t.TempDir()
+	proxy, err := mediaproxy.New(mediaproxy.Config{Key: testKey, CacheDir: cacheDir, MaxSize: 1024, AllowPrivateNetworks: true})
+	assert.NilError(t, err)
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		proxy.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	t.Run("serves and caches an image", func(t *testing.T) {
+		requests.Store(0)
+		path := proxy.URL(origin.URL + "/image.png")
+		for range 2 {
+			rec := serve(http.MethodGet, path)
+			assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+			assert.Equal(t, rec.Header().Get("Content-Type"), "image/png")
+			assert.Equal(t, rec.Header().Get("X-Content-Type-Options"), "nosniff")
+			assert.Equal(t, rec.Header().Get("Referrer-Policy"), "no-referrer")
+			assert.Assert(t, rec.Header().Get("Content-Security-Policy") != "")
+			assert.DeepEqual(t, rec.Body.Bytes(), testPNG)
+		}
+		assert.Equal(t, requests.Load(), int32(1))
+
+		entries, err := filepath.Glob(filepath.Join(cacheDir, "*", "*"))
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 1)
+
+		rec := serve(http.MethodHead, path)
+		assert.Equal(t, rec.Code, http.StatusOK)
+		assert.Equal(t, rec.Body.Len(), 0)
+	})
+
+	t.Run("sniffs generic content types", func(t *testing.T) {
+		rec := serve(http.MethodGet, proxy.URL(origin.URL+"/sniffed"))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+		assert.Equal(t, rec.Header().Get("Content-Type"), "image/png")
+	})
+
+	t.Run("rejects a bad signature", func(t *testing.T) {
+		other, err := mediaproxy.New(mediaproxy.Config{Key: []byte(strings.Repeat("o", 32))})
+		assert.NilError(t, err)
+		assert.Equal(t, serve(http.MethodGet, other.URL(origin.URL+"/image.png")).Code, http.StatusForbidden)
+		assert.Equal(t, serve(http.MethodGet, mediaproxy.PathPrefix+"garbage").Code, http.StatusNotFound)
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		assert.Equal(t, serve(http.MethodPost, proxy.URL(origin.URL+"/image.png")).Code, http.StatusMethodNotAllowed)
+	})
+
+	t.Run("rejects responses that are not images", func(t *testing.T) {
+		assert.Equal(t, serve(http.MethodGet, proxy.URL(origin.URL+"/page.html")).Code, http.StatusBadGateway)
+		assert.Equal(t, serve(http.MethodGet, proxy.URL(origin.URL+"/missing.png")).Code, http.StatusBadGateway)
+	})
+
+	t.Run("rejects images over the size limit", func(t *testing.T) {
+		assert.Equal(t, serve(http.MethodGet, proxy.URL(origin.URL+"/large.png")).Code, http.StatusBadGateway)
+	})
+
+	t.Run("ignores a corrupt cache entry", func(t *testing.T) {
+		path := proxy.URL(origin.URL + "/image.png")
+		entries, err := filepath.Glob(filepath.Join(cacheDir, "*", "*"))
+		assert.NilError(t, err)
+		for _, entry := range entries {
+			assert.NilError(t, os.WriteFile(entry, nil, 0o644))
+		}
+		rec := serve(http.MethodGet, path)
+		assert.Equal(t, rec.Code, http.StatusOK)
+		assert.DeepEqual(t, rec.Body.Bytes(), testPNG)
+	})
+}
+
+func TestProxy_PrunesCache(t *testing.T) {
+	var requests atomic.Int32
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(testPNG)
+	}))
+	defer origin.Close()
+
+	cacheDir := t.TempDir()
+	// Each cached image takes its content type line and the PNG.
+	entrySize := int64(len("image/png\n") + len(testPNG))
+	cached := func(name string) string {
+		sum := sha256.Sum256([]byte(origin.URL + "/" + name))
+		file := hex.EncodeToString(sum[:])
+		return filepath.Join(cacheDir, file[:2], file)
+	}
+	exists := func(name string) bool {
+		_, err := os.Stat(cached(name))
+		return err == nil
+	}
+	servedAgo := func(name string, ago time.Duration) {
+		at := time.Now().Add(-ago)
+		assert.NilError(t, os.Chtimes(cached(name), at, at))
+	}
+	newProxy := func(maxBytes int64) *mediaproxy.Proxy {
+		proxy, err := mediaproxy.New(mediaproxy.Config{Key: testKey, CacheDir: cacheDir, CacheMaxBytes: maxBytes, CacheMaxAge: 24 * time.Hour, AllowPrivateNetworks: true})
+		assert.NilError(t, err)
+		return proxy
+	}
+	serve := func(proxy *mediaproxy.Proxy, name string) {
+		rec := httptest.NewRecorder()
+		proxy.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, proxy.URL(origin.URL+"/"+name), nil))
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	proxy := newProxy(10 * entrySize)
+	for _, name := range []string{"a.png", "b.png", "c.png"} {
+		serve(proxy, name)
+	}
+	assert.Equal(t, requests.Load(), int32(3))
+
+	t.Run("refetches images not served for the max age", func(t *testing.T) {
+		servedAgo("a.png", 48*time.Hour)
+		serve(proxy, "a.png")
+		assert.Equal(t, requests.Load(), int32(4))
+	})
+
+	t.Run("removes expired and least recently served images", func(t *testing.T) {
+		servedAgo("a.png", 48*time.Hour)
+		servedAgo("b.png", 2*time.Hour)
+		servedAgo("c.png", time.Hour)
+		newProxy(entrySize + entrySize/2)
+		assert.Assert(t, !exists("a.png"))
+		assert.Assert(t, !exists("b.png"))
+		assert.Assert(t, exists("c.png"))
+	})
+
+	t.Run("prunes once the cache grows over its bound", func(t *testing.T) {
+		proxy := newProxy(entrySize + entrySize/2)
+		servedAgo("c.png", time.Hour)
+		serve(proxy, "d.png")
+		assert.Assert(t, !exists("c.png"))
+		assert.Assert(t, exists("d.png"))
+	})
+}
+
+func TestProxy_RefusesPrivateAddresses(t *testing.T) {
+	var requests atomic.Int32
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(testPNG)
+	}))
+	defer origin.Close()
+
+	proxy, err := mediaproxy.New(mediaproxy.Config{Key: testKey})
+	assert.NilError(t, err)
+	rec := httptest.NewRecorder()
+	proxy.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, proxy.URL(origin.URL+"/image.png"), nil))
+	assert.Equal(t, rec.Code, http.StatusBadGateway)
+	assert.Equal(t, requests.Load(), int32(0))
+}
+
+func TestProxy_RewriteHTML(t *testing.T) {
+	proxy, err := mediaproxy.New(mediaproxy.Config{Key: testKey})
+	assert.NilError(t, err)
+	a := "https://example.com/a.png?x=1&y=2"
+
+	assert.Equal(t,
+		proxy.RewriteHTML(`<p>See <a href="https://example.com/">link</a> <img src="`+strings.ReplaceAll(a, "&", "&amp;")+`" alt="A"></p><img src="data:image/gif;base64,R0lGOD">`),
+		`<p>See <a href="https://example.com/">link</a> <img src="`+proxy.URL(a)+`" alt="A"/></p><img src="data:image/gif;base64,R0lGOD"/>`,
+	)
+	assert.Equal(t, proxy.RewriteHTML("<p>no images</p>"), "<p>no images</p>")
+}
@@ -0,0 +1,312 @@
+// Package readability extracts the main article from an HTML page, in the
+// spirit of Mozilla's Readability: paragraphs score their ancestors, and the
+// best scoring element with its related siblings is kept.
+package readability
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ErrNoContent is returned when no element looks like article content.
+var ErrNoContent = errors.New("no article content found")
+
+// Article is the extracted main content of a page.
+type Article struct {
+	Title string
+	// Content is an HTML fragment with absolute links.
+	Content string
+}
+
+var (
+	unlikelyCandidates = regexp.MustCompile(`(?i)banner|breadcrumb|comment|cookie|disqus|footer|header|menu|modal|nav|popup|promo|related|share|sidebar|social|sponsor|subscribe|widget|advert`)
+	maybeCandidate     = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+	positiveClass      = regexp.MustCompile(`(?i)article|body|content|entry|hentry|main|page|post|story|text`)
+	negativeClass      = regexp.MustCompile(`(?i)comment|footer|footnote|masthead|meta|outbrain|related|share|shoutbox|sidebar|skyscraper|sponsor|tags|widget|advert`)
+)
+
+// removedTags never contain article text.
+var removedTags = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Iframe:   true,
+	atom.Form:     true,
+	atom.Button:   true,
+	atom.Nav:      true,
+	atom.Aside:    true,
+	atom.Footer:   true,
+	atom.Svg:      true,
+	atom.Template: true,
+}
+
+// minParagraphLength is the shortest text that counts as a paragraph.
+const minParagraphLength = 25
+
+// Extract returns the main article of the page read from r. Relative links and
+// image sources are resolved against pageURL when it is not nil.
+func Extract(r io.Reader, pageURL *url.URL) (*Article, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	article := &Article{Title: title(doc)}
+	body := find(doc, atom.Body)
+	if body == nil {
+		return nil, ErrNoContent
+	}
+	clean(body)
+
+	scores := make(map[*html.Node]float64)
+	var score func(n *html.Node)
+	score = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			score(c)
+		}
+		if n.Type != html.ElementNode {
+			return
+		}
+		switch n.DataAtom {
+		case atom.P, atom.Pre, atom.Td, atom.Blockquote:
+		default:
+			return
+		}
+		text := innerText(n)
+		if len(text) < minParagraphLength {
+			return
+		}
+		points := 1 + float64(strings.Count(text, ",")) + min(float64(len(text))/100, 3)
+		for level, ancestor := 0, n.Parent; level < 3 && ancestor != nil && ancestor.Type == html.ElementNode; level, ancestor = level+1, ancestor.Parent {
+			if _, ok := scores[ancestor]; !ok {
+				scores[ancestor] = initialScore(ancestor)
+			}
+			switch level {
+			case 0:
+				scores[ancestor] += points
+			case 1:
+				scores[ancestor] += points / 2
+			default:
+				scores[ancestor] += points / 6
+			}
+		}
+	}
+	score(body)
+
+	// Candidates are visited in document order, so that the first of equally
+	// scored elements wins.
+	var top *html.Node
+	var topScore float64
+	var pick func(n *html.Node)
+	pick = func(n *html.Node) {
+		if s, ok := scores[n]; ok {
+			s *= 1 - linkDensity(n)
+			scores[n] = s
+			if top == nil || s > topScore {
+				top, topScore = n, s
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			pick(c)
+		}
+	}
+	pick(doc)
+	if top == nil {
+		return nil, ErrNoContent
+	}
+
+	// Keep siblings that look like part of the same article, such as a lead
+	// paragraph outside the main container.
+	var buf bytes.Buffer
+	threshold := max(10, topScore*0.2)
+	if top.Parent == nil {
+		render(&buf, top, pageURL)
+	} else {
+		for sibling := top.Parent.FirstChild; sibling != nil; sibling = sibling.NextSibling {
+			if sibling == top || scores[sibling] >= threshold || isStandaloneParagraph(sibling) {
+				render(&buf, sibling, pageURL)
+			}
+		}
+	}
+	article.Content = buf.String()
+	return article, nil
+}
+
+// title prefers og:title over the document title.
+func title(doc *html.Node) string {
+	var og, plain string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.DataAtom {
+			case atom.Meta:
+				if attr(n, "property") == "og:title" && og == "" {
+					og = strings.TrimSpace(attr(n, "content"))
+				}
+			case atom.Title:
+				if plain == "" {
+					plain = strings.TrimSpace(innerText(n))
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	if og != "" {
+		return og
+	}
+	return plain
+}
+
+// clean removes elements that never hold article text and those whose class
+// or id marks them as page chrome.
+func clean(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if c.Type == html.CommentNode || (c.Type == html.ElementNode && unlikely(c)) {
+			n.RemoveChild(c)
+		} else {
+			clean(c)
+		}
+		c = next
+	}
+}
+
+func unlikely(n *html.Node) bool {
+	if removedTags[n.DataAtom] {
+		return true
+	}
+	if n.DataAtom == atom.Body || n.DataAtom == atom.Article || n.DataAtom == atom.Main {
+		return false
+	}
+	match := attr(n, "class") + " " + attr(n, "id")
+	return unlikelyCandidates.MatchString(match) && !maybeCandidate.MatchString(match)
+}
+
+func initialScore(n *html.Node) float64 {
+	var s float64
+	switch n.DataAtom {
+	case atom.Article:
+		s = 10
+	case atom.Div, atom.Main, atom.Section:
+		s = 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		s = 3
+	case atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li, atom.Form:
+		s = -3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		s = -5
+	}
+	for _, value := range []string{attr(n, "class"), attr(n, "id")} {
+		if value == "" {
+			continue
+		}
+		if negativeClass.MatchString(value) {
+			s -= 25
+		}
+		if positiveClass.MatchString(value) {
+			s += 25
+		}
+	}
+	return s
+}
+
+// linkDensity is the share of a node's text that sits inside links.
+func linkDensity(n *html.Node) float64 {
+	text := len(innerText(n))
+	if text == 0 {
+		return 0
+	}
+	var links int
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.A {
+			links += len(innerText(n))
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return float64(links) / float64(text)
+}
+
+func isStandaloneParagraph(n *html.Node) bool {
+	if n.Type != html.ElementNode || n.DataAtom != atom.P {
+		return false
+	}
+	text := innerText(n)
+	return len(text) > 80 && linkDensity(n) < 0.25
+}
+
+// render writes n with its links and image sources made absolute.
+func render(w *bytes.Buffer, n *html.Node, base *url.URL) {
+	if base != nil {
+		resolve(n, base)
+	}
+	_ = html.Render(w, n)
+}
+
+func resolve(n *html.Node, base *url.URL) {
+	if n.Type == html.ElementNode {
+		for i, a := range n.Attr {
+			if a.Namespace != "" || (a.Key != "href" && a.Key != "src") {
+				continue
+			}
+			ref, err := url.Parse(strings.TrimSpace(a.Val))
+			if err != nil {
+				continue
+			}
+			n.Attr[i].Val = base.ResolveReference(ref).String()
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		resolve(c, base)
+	}
+}
+
+func find(n *html.Node, a atom.Atom) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := find(c, a); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func innerText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
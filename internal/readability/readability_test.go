@@ -0,0 +1,74 @@
+package readability_test
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/internal/readability"
+	"gotest.tools/v3/assert"
+)
+
+const articlePage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>Example Blog</title>
+  <meta property="og:title" content="How we moved to SQLite">
+  <script>var tracking = true;</script>
+</head>
+<body>
+  <nav class="site-nav"><a href="/">Home</a> <a href="/about">About</a></nav>
+  <div class="sidebar">
+    <p>Subscribe to our newsletter, follow us everywhere, and never miss a post again.</p>
+  </div>
+  <article class="post">
+    <h1>How we moved to SQLite</h1>
+    <p>We ran PostgreSQL for years, but the service only ever had a single writer, so we decided to try SQLite instead.</p>
+    <p>The migration took two weeks, including a rewrite of the queue, the backups and the read replicas.</p>
+    <p>See the <a href="/posts/benchmarks">benchmarks</a> for the numbers, and <img src="images/chart.png" alt="chart"> the chart below.</p>
+  </article>
+  <div id="comments">
+    <p>Great post, thanks for sharing, I have been wondering about this for a long time.</p>
+  </div>
+  <footer><p>Copyright Example Blog, all rights reserved, since the beginning of time.</p></footer>
+</body>
+</html>`
+
+func TestExtract(t *testing.T) {
+	pageURL, err := url.Parse("https://blog.example/2026/sqlite/")
+	assert.NilError(t, err)
+
+	article, err := readability.Extract(strings.NewReader(articlePage), pageURL)
+	assert.NilError(t, err)
+	assert.Equal(t, article.Title, "How we moved to SQLite")
+
+	content := article.Content
+	assert.Assert(t, strings.Contains(content, "single writer"), content)
+	assert.Assert(t, strings.Contains(content, "read replicas"), content)
+	assert.Assert(t, strings.Contains(content, `href="https://blog.example/posts/benchmarks"`), content)
+	assert.Assert(t, strings.Contains(content, `src="https://blog.example/2026/sqlite/images/chart.png"`), content)
+	for _, unwanted := range []string{"newsletter", "Great post", "Copyright", "tracking", "About"} {
+		assert.Assert(t, !strings.Contains(content, unwanted), "unexpected %q in %s", unwanted, content)
+	}
+}
+
+func TestExtractNoContent(t *testing.T) {
+	_, err := readability.Extract(strings.NewReader(`<html><body><nav><a href="/">Home</a></nav></body></html>`), nil)
+	assert.Assert(t, errors.Is(err, readability.ErrNoContent), "got %v", err)
+}
+
+func TestExtractTiesPickFirstCandidate(t *testing.T) {
+	paragraph := `<p>The same paragraph, long enough to count, appears in both of these sections.</p>`
+	page := `<html><body>` +
+		`<div><section id="first">` + paragraph + `</section></div>` +
+		`<div><section id="second">` + paragraph + `</section></div>` +
+		`</body></html>`
+	// Map iteration order is random, so a few runs catch a nondeterministic pick.
+	for range 20 {
+		article, err := readability.Extract(strings.NewReader(page), nil)
+		assert.NilError(t, err)
+		assert.Assert(t, strings.Contains(article.Content, `id="first"`), article.Content)
+		assert.Assert(t, !strings.Contains(article.Content, `id="second"`), article.Content)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"sync"
 
 	"github.com/XSAM/otelsql"
+	"github.com/ncruces/go-sqlite3"
 	_ "github.com/ncruces/go-sqlite3/driver"
+	"github.com/ncruces/go-sqlite3/ext/fts5"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
@@ -18,6 +20,9 @@ var (
 
 func readonlyOTELDriverName() (string, error) {
 	readonlyOTELOne.Do(func() {
+		// Item search queries the FTS5 index, which the driver leaves out
+		// unless it is registered.
+		sqlite3.AutoExtension(fts5.Register)
 		readonlyOTELDriver, readonlyOTELDriverErr = otelsql.Register(
 			"sqlite3",
 			otelsql.WithAttributes(semconv.DBSystemSqlite),
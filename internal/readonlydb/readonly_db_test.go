@@ -1,11 +1,14 @@
 package readonlydb_test
 
 import (
+	"context"
 	"database/sql"
 	"path/filepath"
 	"testing"
 
 	"github.com/nakatanakatana/feed-reader/internal/readonlydb"
+	schema "github.com/nakatanakatana/feed-reader/sql"
+	"github.com/nakatanakatana/feed-reader/store"
 	_ "github.com/ncruces/go-sqlite3/driver"
 	"gotest.tools/v3/assert"
 	_ "modernc.org/sqlite"
@@ -44,6 +47,33 @@ func TestVerifyReadOnlyDB(t *testing.T) {
 	assert.NilError(t, err)
 }
 
+func TestOpenReadOnlyDB_SearchItems(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "search.db")
+
+	writer, err := sql.Open("sqlite", dbPath)
+	assert.NilError(t, err)
+	_, err = writer.ExecContext(ctx, schema.Schema)
+	assert.NilError(t, err)
+	s := store.NewStore(writer)
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	title := "Full-text search on a replica"
+	assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-1", Url: "https://example.com/1", Title: &title}))
+	assert.NilError(t, writer.Close())
+
+	db, err := readonlydb.OpenReadOnlyDB("file:"+dbPath+"?mode=ro", 1)
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+
+	match, err := store.ParseSearchQuery("replica")
+	assert.NilError(t, err)
+	rows, err := store.NewStore(db).SearchItems(ctx, store.SearchItemsParams{Match: match, Limit: 10})
+	assert.NilError(t, err)
+	assert.Equal(t, len(rows), 1)
+	assert.Equal(t, rows[0].TitleHighlight, "Full-text search on a "+store.SearchHighlightStart+"replica"+store.SearchHighlightEnd)
+}
+
 func createReadOnlyFixtureDB(t *testing.T, dbPath string) {
 	t.Helper()
 
@@ -0,0 +1,251 @@
+// Package scraper turns HTML pages without a feed into feeds, using CSS
+// selectors to find the items on the page and their fields.
+package scraper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ErrNoItems is returned when the item selector matches nothing on the page.
+var ErrNoItems = errors.New("item selector matched no elements")
+
+// Selectors locate the items of a page. Item matches the container of each
+// item; the other selectors are evaluated within it. Link matches an element
+// with an href, or an element containing one, and defaults to the first link
+// of the item. Title defaults to the text of the link. Date and Content are
+// optional.
+type Selectors struct {
+	Item    string
+	Title   string
+	Link    string
+	Date    string
+	Content string
+}
+
+type compiled struct {
+	item, title, link, date, content cascadia.Matcher
+}
+
+func (s Selectors) compile() (compiled, error) {
+	var c compiled
+	if strings.TrimSpace(s.Item) == "" {
+		return c, errors.New("item selector is required")
+	}
+	for _, f := range []struct {
+		name  string
+		value string
+		dest  *cascadia.Matcher
+	}{
+		{"item", s.Item, &c.item},
+		{"title", s.Title, &c.title},
+		{"link", s.Link, &c.link},
+		{"date", s.Date, &c.date},
+		{"content", s.Content, &c.content},
+	} {
+		if strings.TrimSpace(f.value) == "" {
+			continue
+		}
+		sel, err := cascadia.ParseGroup(f.value)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s selector %q: %w", f.name, f.value, err)
+		}
+		*f.dest = sel
+	}
+	return c, nil
+}
+
+// Validate reports whether the selectors are usable.
+func (s Selectors) Validate() error {
+	_, err := s.compile()
+	return err
+}
+
+// dateLayouts are tried in order on the text of the date element.
+var dateLayouts = []string{
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	"2006.01.02",
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+	"02 Jan 2006",
+	"2 Jan 2006",
+}
+
+// Parse extracts a feed from the HTML page at pageURL. Items without a link
+// are skipped. Relative links are resolved against the page URL, or its
+// <base href> when present.
+func Parse(r io.Reader, pageURL *url.URL, selectors Selectors) (*gofeed.Feed, error) {
+	sel, err := selectors.compile()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	base := pageURL
+	if n := cascadia.Query(doc, cascadia.MustCompile("base[href]")); n != nil {
+		if u, err := pageURL.Parse(attr(n, "href")); err == nil {
+			base = u
+		}
+	}
+
+	feed := &gofeed.Feed{
+		Link:     pageURL.String(),
+		FeedType: "html",
+	}
+	if n := cascadia.Query(doc, cascadia.MustCompile("title")); n != nil {
+		feed.Title = text(n)
+	}
+
+	containers := cascadia.QueryAll(doc, sel.item)
+	if len(containers) == 0 {
+		return nil, ErrNoItems
+	}
+	for _, container := range containers {
+		item := &gofeed.Item{}
+
+		linkNode := first(container, sel.link)
+		if sel.link == nil {
+			linkNode = cascadia.Query(container, cascadia.MustCompile("a[href]"))
+			if linkNode == nil && container.DataAtom == atom.A {
+				linkNode = container
+			}
+		}
+		href := hrefOf(linkNode)
+		if href == "" {
+			continue
+		}
+		link, err := base.Parse(href)
+		if err != nil {
+			continue
+		}
+		item.Link = link.String()
+
+		if n := first(container, sel.title); n != nil {
+			item.Title = text(n)
+		} else if linkNode != nil {
+			item.Title = text(linkNode)
+		}
+
+		if n := first(container, sel.date); n != nil {
+			value := attr(n, "datetime")
+			if value == "" {
+				value = text(n)
+			}
+			item.Published = value
+			if t, ok := parseDate(value); ok {
+				item.PublishedParsed = &t
+			}
+		}
+
+		if n := first(container, sel.content); n != nil {
+			resolveLinks(n, base)
+			item.Content = innerHTML(n)
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+	return feed, nil
+}
+
+func first(n *html.Node, sel cascadia.Matcher) *html.Node {
+	if sel == nil {
+		return nil
+	}
+	if sel.Match(n) {
+		return n
+	}
+	return cascadia.Query(n, sel)
+}
+
+// hrefOf returns the href of n, or of the first link within it.
+func hrefOf(n *html.Node) string {
+	if n == nil {
+		return ""
+	}
+	if href := attr(n, "href"); href != "" {
+		return href
+	}
+	if a := cascadia.Query(n, cascadia.MustCompile("a[href]")); a != nil {
+		return attr(a, "href")
+	}
+	return ""
+}
+
+func parseDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
+
+func text(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func resolveLinks(n *html.Node, base *url.URL) {
+	if n.Type == html.ElementNode {
+		for i, a := range n.Attr {
+			if a.Key != "href" && a.Key != "src" {
+				continue
+			}
+			if u, err := base.Parse(strings.TrimSpace(a.Val)); err == nil {
+				n.Attr[i].Val = u.String()
+			}
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		resolveLinks(c, base)
+	}
+}
+
+func innerHTML(n *html.Node) string {
+	var buf bytes.Buffer
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		_ = html.Render(&buf, c)
+	}
+	return strings.TrimSpace(buf.String())
+}
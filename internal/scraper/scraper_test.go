@@ -0,0 +1,76 @@
+package scraper_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/internal/scraper"
+	"gotest.tools/v3/assert"
+)
+
+const testPage = `<html><head><title>Company News</title></head><body>
+<nav><a href="/">Home</a></nav>
+<ul class="news">
+  <li class="entry">
+    <h2><a href="/news/2">Second release</a></h2>
+    <time datetime="2026-03-02T10:00:00Z">March 2</time>
+    <div class="body"><p>See <a href="/docs">the docs</a>.</p></div>
+  </li>
+  <li class="entry">
+    <h2><a href="news/1?ref=list">First release</a></h2>
+    <span class="date">January 5, 2026</span>
+  </li>
+  <li class="entry"><h2>No link</h2></li>
+</ul>
+</body></html>`
+
+func TestParse(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/company/")
+
+	feed, err := scraper.Parse(strings.NewReader(testPage), pageURL, scraper.Selectors{
+		Item:    "li.entry",
+		Title:   "h2",
+		Link:    "h2 a",
+		Date:    "time, .date",
+		Content: ".body",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, feed.Title, "Company News")
+	assert.Equal(t, feed.Link, "https://example.com/company/")
+	assert.Equal(t, len(feed.Items), 2)
+
+	second := feed.Items[0]
+	assert.Equal(t, second.Title, "Second release")
+	assert.Equal(t, second.Link, "https://example.com/news/2")
+	assert.Equal(t, *second.PublishedParsed, time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC))
+	assert.Equal(t, second.Content, `<p>See <a href="https://example.com/docs">the docs</a>.</p>`)
+
+	first := feed.Items[1]
+	assert.Equal(t, first.Title, "First release")
+	assert.Equal(t, first.Link, "https://example.com/company/news/1?ref=list")
+	assert.Equal(t, *first.PublishedParsed, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, first.Content, "")
+}
+
+func TestParse_Defaults(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/")
+
+	feed, err := scraper.Parse(strings.NewReader(testPage), pageURL, scraper.Selectors{Item: "li.entry"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(feed.Items), 2)
+	assert.Equal(t, feed.Items[0].Title, "Second release")
+	assert.Equal(t, feed.Items[0].Link, "https://example.com/news/2")
+	assert.Assert(t, feed.Items[0].PublishedParsed == nil)
+}
+
+func TestParse_Errors(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/")
+
+	_, err := scraper.Parse(strings.NewReader(testPage), pageURL, scraper.Selectors{Item: "article"})
+	assert.ErrorIs(t, err, scraper.ErrNoItems)
+
+	assert.ErrorContains(t, scraper.Selectors{}.Validate(), "item selector is required")
+	assert.ErrorContains(t, scraper.Selectors{Item: "li", Title: "h2["}.Validate(), "invalid title selector")
+}
@@ -0,0 +1,199 @@
+// Package textdiff computes line and word diffs between two texts and renders
+// them as unified diffs.
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Op is the kind of an edit.
+type Op int
+
+const (
+	Equal Op = iota
+	Delete
+	Insert
+)
+
+// Edit is a single token that is kept, deleted from a or inserted from b.
+type Edit struct {
+	Op   Op
+	Text string
+}
+
+// maxCells bounds the size of the LCS table. Larger inputs are diffed as a
+// wholesale replacement of their differing middle.
+const maxCells = 1 << 22
+
+// Diff returns the edits turning a into b, based on the longest common
+// subsequence of tokens.
+func Diff(a, b []string) []Edit {
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]Edit, 0, len(a)+len(b))
+	for _, t := range a[:prefix] {
+		edits = append(edits, Edit{Op: Equal, Text: t})
+	}
+	edits = append(edits, lcs(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, t := range a[len(a)-suffix:] {
+		edits = append(edits, Edit{Op: Equal, Text: t})
+	}
+	return edits
+}
+
+func lcs(a, b []string) []Edit {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 || n*m > maxCells {
+		edits := make([]Edit, 0, n+m)
+		for _, t := range a {
+			edits = append(edits, Edit{Op: Delete, Text: t})
+		}
+		for _, t := range b {
+			edits = append(edits, Edit{Op: Insert, Text: t})
+		}
+		return edits
+	}
+
+	// table[i][j] is the LCS length of a[i:] and b[j:].
+	table := make([][]int32, n+1)
+	for i := range table {
+		table[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]Edit, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, Edit{Op: Equal, Text: a[i]})
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			edits = append(edits, Edit{Op: Delete, Text: a[i]})
+			i++
+		default:
+			edits = append(edits, Edit{Op: Insert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		edits = append(edits, Edit{Op: Delete, Text: a[i]})
+	}
+	for ; j < m; j++ {
+		edits = append(edits, Edit{Op: Insert, Text: b[j]})
+	}
+	return edits
+}
+
+// ChangeRatio is the share of words that were deleted or inserted between a
+// and b, relative to the longer text. Identical texts have a ratio of 0.
+func ChangeRatio(a, b string) float64 {
+	wa, wb := strings.Fields(a), strings.Fields(b)
+	longest := max(len(wa), len(wb))
+	if longest == 0 {
+		return 0
+	}
+	var changed int
+	for _, e := range Diff(wa, wb) {
+		if e.Op != Equal {
+			changed++
+		}
+	}
+	return min(float64(changed)/float64(longest), 1)
+}
+
+// Unified renders the line diff of a and b in unified diff format with the
+// given number of context lines. It returns "" when the texts are equal.
+func Unified(fromName, toName, a, b string, context int) string {
+	edits := Diff(splitLines(a), splitLines(b))
+
+	var out strings.Builder
+	// Line numbers in a and b of the edit at index k.
+	aLine, bLine := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for k, e := range edits {
+		aLine[k+1], bLine[k+1] = aLine[k], bLine[k]
+		if e.Op != Insert {
+			aLine[k+1]++
+		}
+		if e.Op != Delete {
+			bLine[k+1]++
+		}
+	}
+
+	for start := 0; start < len(edits); {
+		// Find the next change and extend the hunk while changes are
+		// close enough to share context.
+		first := start
+		for first < len(edits) && edits[first].Op == Equal {
+			first++
+		}
+		if first == len(edits) {
+			break
+		}
+		last := first
+		for k := first; k < len(edits); k++ {
+			if edits[k].Op != Equal {
+				last = k
+			} else if k-last > 2*context {
+				break
+			}
+		}
+		from := max(first-context, start)
+		to := min(last+context+1, len(edits))
+
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n",
+			hunkRange(aLine[from], aLine[to]-aLine[from]),
+			hunkRange(bLine[from], bLine[to]-bLine[from]))
+		for _, e := range edits[from:to] {
+			switch e.Op {
+			case Equal:
+				out.WriteByte(' ')
+			case Delete:
+				out.WriteByte('-')
+			case Insert:
+				out.WriteByte('+')
+			}
+			out.WriteString(e.Text)
+			out.WriteByte('\n')
+		}
+		start = to
+	}
+	return out.String()
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,54 @@
+package textdiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/internal/textdiff"
+	"gotest.tools/v3/assert"
+)
+
+func TestDiff(t *testing.T) {
+	edits := textdiff.Diff(strings.Fields("a b c d"), strings.Fields("a c d e"))
+	assert.DeepEqual(t, edits, []textdiff.Edit{
+		{Op: textdiff.Equal, Text: "a"},
+		{Op: textdiff.Delete, Text: "b"},
+		{Op: textdiff.Equal, Text: "c"},
+		{Op: textdiff.Equal, Text: "d"},
+		{Op: textdiff.Insert, Text: "e"},
+	})
+}
+
+func TestChangeRatio(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "", b: "", want: 0},
+		{a: "same text", b: "same  text\n", want: 0},
+		{a: "one two three four", b: "one two three five", want: 0.5},
+		{a: "", b: "new text", want: 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, textdiff.ChangeRatio(tt.a, tt.b), tt.want, "%q -> %q", tt.a, tt.b)
+	}
+}
+
+func TestUnified(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+	b := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n"
+
+	assert.Equal(t, textdiff.Unified("r1", "r2", a, b, 1), `--- r1
++++ r2
+@@ -2,3 +2,3 @@
+ 2
+-3
++three
+ 4
+@@ -10 +10,2 @@
+ 10
++11
+`)
+	assert.Equal(t, textdiff.Unified("r1", "r2", a, a, 3), "")
+	assert.Equal(t, textdiff.Unified("r1", "r2", "", "new\n", 3), "--- r1\n+++ r2\n@@ -0,0 +1 @@\n+new\n")
+}
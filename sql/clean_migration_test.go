@@ -24,7 +24,7 @@ func TestCleanMigration(t *testing.T) {
 	assert.NilError(t, err)
 	defer func() { _ = db.Close() }()
 
-	tables := []string{"feeds", "items", "feed_items", "item_reads", "tags", "feed_tags", "feed_fetcher", "url_parsing_rules", "item_block_rules", "item_blocks", "ignore_windows", "feed_ignore_windows", "tag_ignore_windows"}
+	tables := []string{"feeds", "items", "feed_items", "item_reads", "tags", "feed_tags", "feed_fetcher", "url_parsing_rules", "item_block_rules", "item_blocks", "ignore_windows", "feed_ignore_windows", "tag_ignore_windows", "websub_subscriptions", "feed_credentials", "transport_profiles", "feed_transport_profiles", "tag_transport_profiles", "item_full_contents", "item_revisions", "item_enclosures", "item_identities", "feed_scrapers", "feed_icons", "items_fts", "saved_searches", "saved_search_feeds", "saved_search_tags", "item_stars", "categories", "item_categories", "item_counts_version"}
 	for _, table := range tables {
 		var name string
 		err = db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name=?", table).Scan(&name)
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+// seedItemCountsVersion adds the single row of item_counts_version, which the
+// counts triggers only update. If dryRun is true, it only reports that the
+// row is missing.
+func seedItemCountsVersion(ctx context.Context, dbPath string, dryRun bool) error {
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	if dryRun {
+		var count int
+		if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM item_counts_version WHERE id = 1").Scan(&count); err != nil {
+			return fmt.Errorf("failed to check item_counts_version: %w", err)
+		}
+		if count == 0 {
+			return fmt.Errorf("database schema is out of sync:\nitem_counts_version has no row")
+		}
+		return nil
+	}
+	if _, err := db.ExecContext(ctx, "INSERT OR IGNORE INTO item_counts_version (id, version) VALUES (1, 1)"); err != nil {
+		return fmt.Errorf("failed to seed item_counts_version: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSeedItemCountsVersion(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+
+	db, err := sql.Open("sqlite", dbPath)
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.ExecContext(ctx, Schema)
+	assert.NilError(t, err)
+
+	err = seedItemCountsVersion(ctx, dbPath, true)
+	assert.ErrorContains(t, err, "item_counts_version has no row")
+	assert.NilError(t, seedItemCountsVersion(ctx, dbPath, false))
+	assert.NilError(t, seedItemCountsVersion(ctx, dbPath, true))
+
+	// The triggers bump the seeded version, and seeding again keeps it.
+	_, err = db.ExecContext(ctx, "INSERT INTO tags (id, name) VALUES ('tag-1', 'Go')")
+	assert.NilError(t, err)
+	assert.NilError(t, seedItemCountsVersion(ctx, dbPath, false))
+	var version int64
+	assert.NilError(t, db.QueryRowContext(ctx, "SELECT version FROM item_counts_version").Scan(&version))
+	assert.Equal(t, version, int64(2))
+}
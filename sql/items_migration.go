@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+var itemsTableRe = regexp.MustCompile(`(?s)CREATE TABLE items \(.*?\n\);`)
+
+// migrateItemsURLConstraint rebuilds the items table of databases created
+// while items were identified by their URL alone. SQLite cannot drop the
+// UNIQUE constraint on items.url with ALTER TABLE, so the table is recreated
+// from the desired schema as described in
+// https://www.sqlite.org/lang_altertable.html#otheralter, keeping its rows,
+// indexes and triggers. If dryRun is true, it only reports that the rebuild is
+// needed.
+func migrateItemsURLConstraint(ctx context.Context, dbPath string, desiredSchema string, dryRun bool) error {
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open connection: %w", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	unique, err := hasUniqueURL(ctx, conn)
+	if err != nil || !unique {
+		return err
+	}
+	if dryRun {
+		return fmt.Errorf("database schema is out of sync:\nitems.url must not be UNIQUE")
+	}
+
+	createItems := itemsTableRe.FindString(desiredSchema)
+	if createItems == "" {
+		return fmt.Errorf("desired schema has no items table")
+	}
+
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return fmt.Errorf("failed to disable foreign keys: %w", err)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "PRAGMA foreign_keys = ON")
+	}()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	dependents, err := queryStrings(ctx, tx, "SELECT sql FROM sqlite_master WHERE tbl_name = 'items' AND type IN ('index', 'trigger') AND sql IS NOT NULL")
+	if err != nil {
+		return fmt.Errorf("failed to list items indexes and triggers: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, strings.Replace(createItems, "CREATE TABLE items (", "CREATE TABLE items_new (", 1)); err != nil {
+		return fmt.Errorf("failed to create items_new: %w", err)
+	}
+
+	oldColumns, err := queryStrings(ctx, tx, "SELECT name FROM pragma_table_info('items')")
+	if err != nil {
+		return fmt.Errorf("failed to list items columns: %w", err)
+	}
+	newColumns, err := queryStrings(ctx, tx, "SELECT name FROM pragma_table_info('items_new')")
+	if err != nil {
+		return fmt.Errorf("failed to list items_new columns: %w", err)
+	}
+	var columns []string
+	for _, column := range newColumns {
+		for _, old := range oldColumns {
+			if column == old {
+				columns = append(columns, `"`+column+`"`)
+				break
+			}
+		}
+	}
+	list := strings.Join(columns, ", ")
+
+	statements := []string{
+		// Rowids are kept, as the full-text index refers to items by rowid.
+		fmt.Sprintf("INSERT INTO items_new (rowid, %s) SELECT rowid, %s FROM items", list, list),
+		"DROP TABLE items",
+		"ALTER TABLE items_new RENAME TO items",
+	}
+	statements = append(statements, dependents...)
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to rebuild items: %s: %w", statement, err)
+		}
+	}
+
+	violations, err := queryStrings(ctx, tx, "SELECT \"table\" FROM pragma_foreign_key_check")
+	if err != nil {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("rebuilding items breaks foreign keys of %s", strings.Join(violations, ", "))
+	}
+	return tx.Commit()
+}
+
+func hasUniqueURL(ctx context.Context, conn *sql.Conn) (bool, error) {
+	indexes, err := queryStrings(ctx, conn, "SELECT name FROM pragma_index_list('items') WHERE \"unique\" = 1 AND origin = 'u'")
+	if err != nil {
+		return false, fmt.Errorf("failed to list items indexes: %w", err)
+	}
+	for _, index := range indexes {
+		columns, err := queryStrings(ctx, conn, "SELECT name FROM pragma_index_info(?)", index)
+		if err != nil {
+			return false, fmt.Errorf("failed to list columns of %s: %w", index, err)
+		}
+		if len(columns) == 1 && columns[0] == "url" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func queryStrings(ctx context.Context, q queryer, query string, args ...any) ([]string, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var result []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMigrateItemsURLConstraint(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+
+	db, err := sql.Open("sqlite", dbPath)
+	assert.NilError(t, err)
+	defer func() { _ = db.Close() }()
+
+	_, err = db.ExecContext(ctx, `
+CREATE TABLE items (
+  id         TEXT PRIMARY KEY,
+  url        TEXT NOT NULL UNIQUE,
+  title      TEXT,
+  created_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now'))
+);
+CREATE TABLE item_reads (
+  item_id TEXT PRIMARY KEY,
+  is_read INTEGER NOT NULL DEFAULT 0,
+  FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_items_created_at ON items(created_at);
+CREATE TRIGGER trg_items_insert_item_reads
+AFTER INSERT ON items
+BEGIN
+  INSERT INTO item_reads (item_id, is_read) VALUES (NEW.id, 0);
+END;
+INSERT INTO items (id, url, title) VALUES ('item-1', 'https://example.com/', 'First');
+`)
+	assert.NilError(t, err)
+
+	err = migrateItemsURLConstraint(ctx, dbPath, Schema, true)
+	assert.ErrorContains(t, err, "items.url must not be UNIQUE")
+
+	assert.NilError(t, migrateItemsURLConstraint(ctx, dbPath, Schema, false))
+
+	var title string
+	assert.NilError(t, db.QueryRowContext(ctx, "SELECT title FROM items WHERE id = 'item-1'").Scan(&title))
+	assert.Equal(t, title, "First")
+
+	// Items may now share a URL, and the trigger and index were kept.
+	_, err = db.ExecContext(ctx, "INSERT INTO items (id, url) VALUES ('item-2', 'https://example.com/')")
+	assert.NilError(t, err)
+	var reads int
+	assert.NilError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM item_reads").Scan(&reads))
+	assert.Equal(t, reads, 2)
+	var index string
+	assert.NilError(t, db.QueryRowContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'index' AND name = 'idx_items_created_at'").Scan(&index))
+
+	// A migrated database is left alone.
+	assert.NilError(t, migrateItemsURLConstraint(ctx, dbPath, Schema, true))
+}
@@ -14,6 +14,10 @@ import (
 // Migrate performs database migration using sqldef.
 // If dryRun is true, it only detects differences and returns an error if any are found.
 func Migrate(ctx context.Context, dbPath string, desiredSchema string, dryRun bool) error {
+	if err := migrateItemsURLConstraint(ctx, dbPath, desiredSchema, dryRun); err != nil {
+		return err
+	}
+
 	config := database.Config{
 		DbName: dbPath,
 	}
@@ -41,7 +45,7 @@ func Migrate(ctx context.Context, dbPath string, desiredSchema string, dryRun bo
 	}
 
 	if len(ddls) == 0 {
-		return nil
+		return seedItemCountsVersion(ctx, dbPath, dryRun)
 	}
 
 	if dryRun {
@@ -60,5 +64,5 @@ func Migrate(ctx context.Context, dbPath string, desiredSchema string, dryRun bo
 		return fmt.Errorf("failed to run DDLs: %w", err)
 	}
 
-	return nil
+	return seedItemCountsVersion(ctx, dbPath, dryRun)
 }
@@ -2,7 +2,16 @@
 SELECT
   f.*,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -14,7 +23,16 @@ WHERE
 SELECT
   f.*,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -26,7 +44,16 @@ WHERE
 SELECT
   f.*,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -42,7 +69,16 @@ ORDER BY
 SELECT
   f.*,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -61,9 +97,10 @@ INSERT INTO feeds (
   image_url,
   copyright,
   feed_type,
-  feed_version
+  feed_version,
+  title_overridden
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
 RETURNING *;
 
@@ -71,19 +108,31 @@ RETURNING *;
 UPDATE
   feeds
 SET
-  link = COALESCE(?, link),
-  title = COALESCE(?, title),
-  description = COALESCE(?, description),
-  lang = COALESCE(?, lang),
-  image_url = COALESCE(?, image_url),
-  copyright = COALESCE(?, copyright),
-  feed_type = COALESCE(?, feed_type),
-  feed_version = COALESCE(?, feed_version),
+  link = COALESCE(sqlc.narg('link'), link),
+  title = CASE
+    WHEN title_overridden = 1 THEN title
+    ELSE COALESCE(sqlc.narg('title'), title)
+  END,
+  description = COALESCE(sqlc.narg('description'), description),
+  lang = COALESCE(sqlc.narg('lang'), lang),
+  image_url = COALESCE(sqlc.narg('image_url'), image_url),
+  copyright = COALESCE(sqlc.narg('copyright'), copyright),
+  feed_type = COALESCE(sqlc.narg('feed_type'), feed_type),
+  feed_version = COALESCE(sqlc.narg('feed_version'), feed_version),
   updated_at = (strftime('%FT%TZ', 'now'))
 WHERE
-  id = ?
+  id = sqlc.arg('id')
 RETURNING *;
 
+-- name: UpdateFeedURL :exec
+UPDATE
+  feeds
+SET
+  url = sqlc.arg('url'),
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = sqlc.arg('id');
+
 -- name: DeleteFeed :exec
 DELETE FROM
   feeds
@@ -101,11 +150,14 @@ INSERT INTO items (
   guid,
   content,
   image_url,
-  categories
+  categories,
+  description_html,
+  content_html
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
-ON CONFLICT(url) DO UPDATE SET
+ON CONFLICT(id) DO UPDATE SET
+  url = excluded.url,
   title = excluded.title,
   description = excluded.description,
   author = excluded.author,
@@ -113,9 +165,72 @@ ON CONFLICT(url) DO UPDATE SET
   content = excluded.content,
   image_url = excluded.image_url,
   categories = excluded.categories,
+  description_html = excluded.description_html,
+  content_html = excluded.content_html,
   updated_at = (strftime('%FT%TZ', 'now'))
 RETURNING *;
 
+-- name: GetSavedItem :one
+SELECT
+  *
+FROM
+  items
+WHERE
+  id = ?;
+
+-- name: GetItemIDByIdentity :one
+SELECT
+  item_id
+FROM
+  item_identities
+WHERE
+  feed_id = ? AND identity = ?;
+
+-- name: FindSharedItemByURL :one
+SELECT
+  i.id
+FROM
+  items i
+WHERE
+  i.url = sqlc.arg('url')
+  AND NOT EXISTS (
+    SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id AND fi.feed_id = sqlc.arg('feed_id')
+  )
+ORDER BY
+  i.created_at ASC, i.id ASC
+LIMIT 1;
+
+-- name: CreateItemIdentity :exec
+INSERT INTO item_identities (
+  feed_id,
+  identity,
+  item_id
+) VALUES (
+  ?, ?, ?
+)
+ON CONFLICT(feed_id, identity) DO NOTHING;
+
+-- name: ListFeedItemsWithoutIdentity :many
+SELECT
+  fi.feed_id,
+  i.id,
+  i.url,
+  i.title,
+  i.description,
+  i.guid,
+  i.content
+FROM
+  feed_items fi
+  JOIN items i ON i.id = fi.item_id
+WHERE
+  NOT EXISTS (
+    SELECT 1 FROM item_identities ii WHERE ii.feed_id = fi.feed_id AND ii.item_id = fi.item_id
+  )
+  AND (fi.feed_id > sqlc.arg('after_feed_id') OR (fi.feed_id = sqlc.arg('after_feed_id') AND fi.item_id > sqlc.arg('after_item_id')))
+ORDER BY
+  fi.feed_id ASC, fi.item_id ASC
+LIMIT sqlc.arg('limit');
+
 -- name: CreateFeedItem :exec
 INSERT INTO feed_items (
   feed_id,
@@ -140,13 +255,118 @@ ON CONFLICT(item_id) DO NOTHING;
 INSERT INTO feed_fetcher (
   feed_id,
   last_fetched_at,
-  next_fetch
+  next_fetch,
+  schedule_reason
 ) VALUES (
-  ?, ?, ?
+  ?, ?, ?, ?
 )
 ON CONFLICT(feed_id) DO UPDATE SET
   last_fetched_at = COALESCE(excluded.last_fetched_at, feed_fetcher.last_fetched_at),
   next_fetch = COALESCE(excluded.next_fetch, feed_fetcher.next_fetch),
+  schedule_reason = excluded.schedule_reason,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: RecordFeedFetchSuccess :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  last_status_code,
+  last_success_at
+) VALUES (
+  sqlc.arg('feed_id'), sqlc.narg('last_status_code'), (strftime('%FT%TZ', 'now'))
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  last_status_code = excluded.last_status_code,
+  last_error = NULL,
+  last_error_kind = NULL,
+  consecutive_failures = 0,
+  last_success_at = excluded.last_success_at,
+  disabled_at = NULL,
+  disabled_reason = NULL,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: RecordFeedFetchFailure :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  last_status_code,
+  last_error,
+  last_error_kind,
+  consecutive_failures,
+  next_fetch,
+  schedule_reason
+) VALUES (
+  sqlc.arg('feed_id'), sqlc.narg('last_status_code'), sqlc.narg('last_error'), sqlc.narg('last_error_kind'), 1, sqlc.narg('next_fetch'), sqlc.narg('schedule_reason')
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  last_status_code = excluded.last_status_code,
+  last_error = excluded.last_error,
+  last_error_kind = excluded.last_error_kind,
+  consecutive_failures = feed_fetcher.consecutive_failures + 1,
+  next_fetch = COALESCE(excluded.next_fetch, feed_fetcher.next_fetch),
+  schedule_reason = CASE
+    WHEN excluded.next_fetch IS NULL THEN feed_fetcher.schedule_reason
+    ELSE excluded.schedule_reason
+  END,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: PostponeHostFetches :exec
+-- Pushes next_fetch of every feed served from host to at least next_fetch.
+-- host is lower-cased and compared with the part of the URL between the
+-- scheme and the first '/', '#' or question mark (char(63), as sqlc would
+-- take a literal one for a parameter), which are all turned into '/' first.
+INSERT INTO feed_fetcher (
+  feed_id,
+  next_fetch
+)
+SELECT f.id, CAST(sqlc.arg('next_fetch') AS TEXT)
+FROM feeds f
+WHERE
+  lower(substr(f.url, 1, instr(f.url, '://') - 1)) IN ('http', 'https') AND
+  lower(substr(
+    replace(replace(substr(f.url, instr(f.url, '://') + 3), char(63), '/'), '#', '/') || '/',
+    1,
+    instr(replace(replace(substr(f.url, instr(f.url, '://') + 3), char(63), '/'), '#', '/') || '/', '/') - 1
+  )) = sqlc.arg('host')
+ON CONFLICT(feed_id) DO UPDATE SET
+  next_fetch = MAX(COALESCE(feed_fetcher.next_fetch, ''), excluded.next_fetch),
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: RecordFeedRedirect :one
+INSERT INTO feed_fetcher (
+  feed_id,
+  redirect_url,
+  redirect_count
+) VALUES (
+  sqlc.arg('feed_id'), sqlc.arg('redirect_url'), 1
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  redirect_count = CASE
+    WHEN feed_fetcher.redirect_url = excluded.redirect_url THEN feed_fetcher.redirect_count + 1
+    ELSE 1
+  END,
+  redirect_url = excluded.redirect_url,
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING redirect_count;
+
+-- name: ClearFeedRedirect :exec
+UPDATE feed_fetcher
+SET
+  redirect_url = NULL,
+  redirect_count = 0,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  feed_id = ? AND redirect_url IS NOT NULL;
+
+-- name: DisableFeed :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  disabled_at,
+  disabled_reason
+) VALUES (
+  sqlc.arg('feed_id'), (strftime('%FT%TZ', 'now')), sqlc.arg('disabled_reason')
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  disabled_at = excluded.disabled_at,
+  disabled_reason = excluded.disabled_reason,
   updated_at = (strftime('%FT%TZ', 'now'));
 
 -- name: GetItem :one
@@ -162,53 +382,221 @@ SELECT
   i.image_url,
   i.categories,
   i.created_at,
+  i.revised_at,
+  i.description_html,
+  i.content_html,
   fi.feed_id,
-  CAST(COALESCE(ir.is_read, 0) AS INTEGER) AS is_read
+  CAST(COALESCE(ir.is_read, 0) AS INTEGER) AS is_read,
+  CAST(COALESCE(st.is_starred, 0) AS INTEGER) AS is_starred,
+  st.starred_at,
+  st.note,
+  st.user_tags,
+  fc.content AS full_content,
+  fc.content_html AS full_content_html,
+  fc.error AS full_content_error,
+  fc.fetched_at AS full_content_fetched_at
 FROM
   items i
 JOIN
   feed_items fi ON i.id = fi.item_id
 LEFT JOIN
   item_reads ir ON i.id = ir.item_id
+LEFT JOIN
+  item_stars st ON i.id = st.item_id
+LEFT JOIN
+  item_full_contents fc ON i.id = fc.item_id
 WHERE
   i.id = ?;
 
--- name: ListItems :many
+-- name: SetFeedFetchFullContent :exec
+UPDATE
+  feeds
+SET
+  fetch_full_content = ?,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = ?;
+
+-- name: SetFeedUnreadOnRevision :exec
+UPDATE
+  feeds
+SET
+  unread_on_revision = ?,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = ?;
+
+-- name: GetLatestItemRevision :one
 SELECT
-  i.id,
-  i.url,
-  i.title,
-  CAST(COALESCE(SUBSTR(i.description, 1, 140), '') AS TEXT) AS description,
-  i.published_at,
-  i.author,
-  i.guid,
-  i.content,
-  i.image_url,
-  i.categories,
-  i.created_at,
-  CAST((SELECT fi.feed_id FROM feed_items fi WHERE fi.item_id = i.id LIMIT 1) AS TEXT) AS feed_id,
-  CAST(COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) AS INTEGER) AS is_read
+  *
 FROM
-  items i
+  item_revisions
 WHERE
-  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id) AND
-  (sqlc.narg('feed_id') IS NULL OR EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id AND fi.feed_id = sqlc.narg('feed_id'))) AND
-  (sqlc.narg('is_read') IS NULL OR COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) = sqlc.narg('is_read')) AND
-  (sqlc.narg('tag_id') IS NULL OR EXISTS (
-    SELECT 1 FROM feed_items fi 
-    JOIN feed_tags ft ON fi.feed_id = ft.feed_id 
-    WHERE fi.item_id = i.id AND ft.tag_id = sqlc.narg('tag_id')
-  )) AND
-  (sqlc.narg('since') IS NULL OR i.created_at >= sqlc.narg('since')) AND
-  (sqlc.narg('is_blocked') IS NULL OR (CASE WHEN EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = i.id) THEN 1 ELSE 0 END = sqlc.narg('is_blocked'))) AND
-  (
-    (sqlc.narg('created_at_cursor') IS NULL AND sqlc.narg('id_cursor') IS NULL) OR
-    (i.created_at, i.id) > (sqlc.narg('created_at_cursor'), sqlc.narg('id_cursor'))
-  )
+  item_id = ?
 ORDER BY
-  i.created_at ASC,
-  i.id ASC
-LIMIT sqlc.arg('limit');
+  revision DESC
+LIMIT 1;
+
+-- name: GetItemRevision :one
+SELECT
+  *
+FROM
+  item_revisions
+WHERE
+  item_id = ? AND revision = ?;
+
+-- name: ListItemRevisions :many
+SELECT
+  *
+FROM
+  item_revisions
+WHERE
+  item_id = ?
+ORDER BY
+  revision DESC;
+
+-- name: CreateItemRevision :exec
+INSERT INTO item_revisions (
+  item_id,
+  revision,
+  content_hash,
+  title,
+  description,
+  content,
+  feed_id
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: ClaimItemRevision :exec
+-- Makes a revision without a feed the baseline of the feed saving its item.
+UPDATE
+  item_revisions
+SET
+  feed_id = sqlc.arg('feed_id'),
+  content_hash = sqlc.arg('content_hash')
+WHERE
+  item_id = sqlc.arg('item_id') AND revision = sqlc.arg('revision');
+
+-- name: UpdateItemRevisionHash :exec
+UPDATE
+  item_revisions
+SET
+  content_hash = sqlc.arg('content_hash')
+WHERE
+  item_id = sqlc.arg('item_id') AND revision = sqlc.arg('revision');
+
+-- name: UpdateItemRevisionText :exec
+UPDATE
+  item_revisions
+SET
+  title = sqlc.narg('title'),
+  description = sqlc.narg('description'),
+  content = sqlc.narg('content')
+WHERE
+  item_id = sqlc.arg('item_id') AND revision = sqlc.arg('revision');
+
+-- name: DeleteItemEnclosures :exec
+DELETE FROM
+  item_enclosures
+WHERE
+  item_id = ?;
+
+-- name: CreateItemEnclosure :exec
+INSERT INTO item_enclosures (
+  item_id,
+  position,
+  url,
+  mime_type,
+  length,
+  duration_seconds,
+  thumbnail_url
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: ListItemEnclosures :many
+SELECT
+  *
+FROM
+  item_enclosures
+WHERE
+  item_id = ?
+ORDER BY
+  position ASC;
+
+-- name: ListItemEnclosuresByItemIDs :many
+SELECT
+  *
+FROM
+  item_enclosures
+WHERE
+  item_id IN (sqlc.slice('item_ids'))
+ORDER BY
+  item_id ASC,
+  position ASC;
+
+-- name: ListPodcastFeedIDs :many
+SELECT DISTINCT
+  fi.feed_id
+FROM
+  feed_items fi
+JOIN
+  item_enclosures ie ON ie.item_id = fi.item_id
+WHERE
+  fi.feed_id IN (sqlc.slice('feed_ids')) AND
+  ie.mime_type LIKE 'audio/%';
+
+-- name: MarkItemRevised :exec
+UPDATE
+  items
+SET
+  revised_at = ?
+WHERE
+  id = ?;
+
+-- name: MarkItemUnread :exec
+UPDATE
+  item_reads
+SET
+  is_read = 0,
+  read_at = NULL,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  item_id = ? AND is_read = 1;
+
+-- name: GetItemFullContent :one
+SELECT
+  *
+FROM
+  item_full_contents
+WHERE
+  item_id = ?;
+
+-- name: UpsertItemFullContent :exec
+-- Content is kept when a later extraction fails. Items deleted in the
+-- meantime are skipped rather than failing the write batch.
+INSERT INTO item_full_contents (
+  item_id,
+  content,
+  content_html,
+  error,
+  fetched_at
+)
+SELECT
+  sqlc.arg('item_id'),
+  sqlc.narg('content'),
+  sqlc.narg('content_html'),
+  sqlc.narg('error'),
+  sqlc.arg('fetched_at')
+WHERE
+  EXISTS (SELECT 1 FROM items WHERE id = sqlc.arg('item_id'))
+ON CONFLICT(item_id) DO UPDATE SET
+  content = COALESCE(excluded.content, item_full_contents.content),
+  content_html = CASE WHEN excluded.content IS NULL THEN item_full_contents.content_html ELSE excluded.content_html END,
+  error = excluded.error,
+  fetched_at = excluded.fetched_at,
+  updated_at = (strftime('%FT%TZ', 'now'));
 
 -- name: ListRecentItemPublishedDates :many
 SELECT
@@ -282,27 +670,6 @@ WHERE
   ir.is_read = 0 AND
   NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = fi.item_id);
 
--- name: CountItems :one
-SELECT
-  COUNT(DISTINCT i.id)
-FROM
-  items i
-JOIN
-  feed_items fi ON i.id = fi.item_id
-LEFT JOIN
-  item_reads ir ON i.id = ir.item_id
-LEFT JOIN
-  item_blocks ib ON i.id = ib.item_id
-WHERE
-  (sqlc.narg('feed_id') IS NULL OR fi.feed_id = sqlc.narg('feed_id')) AND
-  (sqlc.narg('is_read') IS NULL OR COALESCE(ir.is_read, 0) = sqlc.narg('is_read')) AND
-  (sqlc.narg('tag_id') IS NULL OR EXISTS (
-    SELECT 1 FROM feed_tags ft WHERE ft.feed_id = fi.feed_id AND ft.tag_id = sqlc.narg('tag_id')
-  )) AND
-  (sqlc.narg('since') IS NULL OR i.created_at >= sqlc.narg('since')) AND
-  (sqlc.narg('is_blocked') IS NULL OR (CASE WHEN ib.item_id IS NOT NULL THEN 1 ELSE 0 END = sqlc.narg('is_blocked')));
-
-
 -- name: ListItemRead :many
 SELECT
   item_id,
@@ -479,6 +846,36 @@ ON CONFLICT(feed_id) DO UPDATE SET
   updated_at = (strftime('%FT%TZ', 'now'))
 RETURNING *;
 
+-- name: RecordFeedCacheHint :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  hint_max_age
+) VALUES (
+  ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  hint_max_age = excluded.hint_max_age,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: RecordFeedScheduleHints :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  hint_max_age,
+  hint_ttl,
+  hint_update_period,
+  hint_skip_hours,
+  hint_skip_days
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  hint_max_age = excluded.hint_max_age,
+  hint_ttl = excluded.hint_ttl,
+  hint_update_period = excluded.hint_update_period,
+  hint_skip_hours = excluded.hint_skip_hours,
+  hint_skip_days = excluded.hint_skip_days,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
 -- name: DeleteFeedFetcher :exec
 DELETE FROM
   feed_fetcher
@@ -497,7 +894,8 @@ FROM
 LEFT JOIN
   feed_fetcher ff ON f.id = ff.feed_id
 WHERE
-  ff.next_fetch IS NULL OR ff.next_fetch <= (strftime('%Y-%m-%dT%H:%M:%SZ', 'now'))
+  (ff.next_fetch IS NULL OR ff.next_fetch <= (strftime('%Y-%m-%dT%H:%M:%SZ', 'now')))
+  AND ff.disabled_at IS NULL
 ORDER BY
   ff.next_fetch ASC;
 
@@ -726,3 +1124,587 @@ LEFT JOIN feed_tags ft ON tiw.tag_id = ft.tag_id AND ft.feed_id = sqlc.arg('feed
 WHERE fiw.feed_id IS NOT NULL OR ft.feed_id IS NOT NULL
 ORDER BY iw.name ASC;
 
+
+-- name: GetWebSubSubscription :one
+SELECT
+  *
+FROM
+  websub_subscriptions
+WHERE
+  feed_id = ?;
+
+-- name: UpsertWebSubSubscription :one
+INSERT INTO websub_subscriptions (
+  feed_id,
+  hub_url,
+  topic_url,
+  secret
+) VALUES (
+  ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  hub_url = excluded.hub_url,
+  topic_url = excluded.topic_url,
+  secret = excluded.secret,
+  state = 'pending',
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING *;
+
+-- name: ActivateWebSubSubscription :exec
+UPDATE websub_subscriptions
+SET
+  state = 'active',
+  lease_seconds = sqlc.narg('lease_seconds'),
+  lease_expires_at = sqlc.narg('lease_expires_at'),
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  feed_id = sqlc.arg('feed_id');
+
+-- name: DeleteWebSubSubscription :exec
+DELETE FROM
+  websub_subscriptions
+WHERE
+  feed_id = ?;
+
+-- name: ListWebSubSubscriptionsToRenew :many
+SELECT
+  *
+FROM
+  websub_subscriptions
+WHERE
+  state = 'active'
+  AND lease_expires_at IS NOT NULL
+  AND lease_expires_at <= sqlc.arg('renew_before')
+ORDER BY
+  lease_expires_at ASC;
+
+-- name: GetFeedCredential :one
+SELECT
+  *
+FROM
+  feed_credentials
+WHERE
+  feed_id = ?;
+
+-- name: UpsertFeedCredential :exec
+INSERT INTO feed_credentials (
+  feed_id,
+  auth_type,
+  encrypted_data
+) VALUES (
+  ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  auth_type = excluded.auth_type,
+  encrypted_data = excluded.encrypted_data,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: DeleteFeedCredential :exec
+DELETE FROM
+  feed_credentials
+WHERE
+  feed_id = ?;
+
+-- name: GetFeedScraper :one
+SELECT
+  *
+FROM
+  feed_scrapers
+WHERE
+  feed_id = ?;
+
+-- name: UpsertFeedScraper :exec
+INSERT INTO feed_scrapers (
+  feed_id,
+  item_selector,
+  title_selector,
+  link_selector,
+  date_selector,
+  content_selector
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  item_selector = excluded.item_selector,
+  title_selector = excluded.title_selector,
+  link_selector = excluded.link_selector,
+  date_selector = excluded.date_selector,
+  content_selector = excluded.content_selector,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: GetFeedIcon :one
+SELECT
+  *
+FROM
+  feed_icons
+WHERE
+  feed_id = ?;
+
+-- name: UpsertFeedIcon :exec
+INSERT INTO feed_icons (
+  feed_id,
+  source_url,
+  mime_type,
+  data,
+  error,
+  fetched_at
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  source_url = COALESCE(excluded.source_url, feed_icons.source_url),
+  mime_type = COALESCE(excluded.mime_type, feed_icons.mime_type),
+  data = COALESCE(excluded.data, feed_icons.data),
+  error = excluded.error,
+  fetched_at = excluded.fetched_at,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: CreateTransportProfile :one
+INSERT INTO transport_profiles (
+  id,
+  name,
+  proxy_url,
+  ca_bundle,
+  client_cert,
+  client_key,
+  insecure_skip_verify,
+  timeout_seconds,
+  retry_max
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: GetTransportProfile :one
+SELECT * FROM transport_profiles WHERE id = ?;
+
+-- name: ListTransportProfiles :many
+SELECT * FROM transport_profiles ORDER BY name ASC;
+
+-- name: UpdateTransportProfile :one
+UPDATE transport_profiles
+SET
+  name = sqlc.arg('name'),
+  proxy_url = sqlc.narg('proxy_url'),
+  ca_bundle = sqlc.narg('ca_bundle'),
+  client_cert = sqlc.narg('client_cert'),
+  client_key = sqlc.narg('client_key'),
+  insecure_skip_verify = sqlc.arg('insecure_skip_verify'),
+  timeout_seconds = sqlc.narg('timeout_seconds'),
+  retry_max = sqlc.narg('retry_max'),
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: DeleteTransportProfile :exec
+DELETE FROM transport_profiles WHERE id = ?;
+
+-- name: ListFeedTransportProfiles :many
+SELECT feed_id, transport_profile_id FROM feed_transport_profiles
+WHERE
+  (sqlc.narg('feed_id') IS NULL OR feed_id = sqlc.narg('feed_id'))
+  AND (sqlc.narg('transport_profile_id') IS NULL OR transport_profile_id = sqlc.narg('transport_profile_id'));
+
+-- name: SetFeedTransportProfile :exec
+INSERT INTO feed_transport_profiles (feed_id, transport_profile_id)
+VALUES (?, ?)
+ON CONFLICT(feed_id) DO UPDATE SET
+  transport_profile_id = excluded.transport_profile_id,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: DeleteFeedTransportProfile :exec
+DELETE FROM feed_transport_profiles WHERE feed_id = ?;
+
+-- name: ListTagTransportProfiles :many
+SELECT tag_id, transport_profile_id FROM tag_transport_profiles
+WHERE
+  (sqlc.narg('tag_id') IS NULL OR tag_id = sqlc.narg('tag_id'))
+  AND (sqlc.narg('transport_profile_id') IS NULL OR transport_profile_id = sqlc.narg('transport_profile_id'));
+
+-- name: SetTagTransportProfile :exec
+INSERT INTO tag_transport_profiles (tag_id, transport_profile_id)
+VALUES (?, ?)
+ON CONFLICT(tag_id) DO UPDATE SET
+  transport_profile_id = excluded.transport_profile_id,
+  updated_at = (strftime('%FT%TZ', 'now'));
+
+-- name: DeleteTagTransportProfile :exec
+DELETE FROM tag_transport_profiles WHERE tag_id = ?;
+
+-- name: GetTransportProfileForFeed :one
+-- A profile assigned to the feed wins over profiles assigned to its tags;
+-- among tags, the first by name wins.
+SELECT tp.*
+FROM transport_profiles tp
+WHERE tp.id = COALESCE(
+  (SELECT ftp.transport_profile_id FROM feed_transport_profiles ftp WHERE ftp.feed_id = sqlc.arg('feed_id')),
+  (
+    SELECT ttp.transport_profile_id
+    FROM feed_tags ft
+    JOIN tag_transport_profiles ttp ON ft.tag_id = ttp.tag_id
+    JOIN tags t ON t.id = ft.tag_id
+    WHERE ft.feed_id = sqlc.arg('feed_id')
+    ORDER BY t.name ASC
+    LIMIT 1
+  )
+);
+
+-- name: ListItemsWithHTML :many
+SELECT
+  id,
+  title,
+  description,
+  content,
+  description_html,
+  content_html
+FROM
+  items
+WHERE
+  (description_html IS NOT NULL OR content_html IS NOT NULL) AND
+  id > sqlc.arg('after_id')
+ORDER BY
+  id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateItemMarkdown :exec
+-- Only the stored HTML columns are converted; the Markdown of items saved
+-- without HTML is kept.
+UPDATE
+  items
+SET
+  description = CASE WHEN description_html IS NULL THEN description ELSE sqlc.narg('description') END,
+  content = CASE WHEN content_html IS NULL THEN content ELSE sqlc.narg('content') END,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = sqlc.arg('id');
+
+-- name: ListItemFullContentsWithHTML :many
+SELECT
+  item_id,
+  content_html
+FROM
+  item_full_contents
+WHERE
+  content_html IS NOT NULL AND
+  item_id > sqlc.arg('after_item_id')
+ORDER BY
+  item_id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateItemFullContentMarkdown :exec
+UPDATE
+  item_full_contents
+SET
+  content = ?,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  item_id = ?;
+
+-- name: CreateSavedSearch :one
+INSERT INTO saved_searches (
+  id,
+  name,
+  query,
+  match_query,
+  is_read,
+  is_starred,
+  author,
+  since,
+  until,
+  max_age_days
+) VALUES (
+  sqlc.arg('id'),
+  sqlc.arg('name'),
+  sqlc.narg('query'),
+  sqlc.narg('match_query'),
+  sqlc.narg('is_read'),
+  sqlc.narg('is_starred'),
+  sqlc.narg('author'),
+  sqlc.narg('since'),
+  sqlc.narg('until'),
+  sqlc.narg('max_age_days')
+)
+RETURNING *;
+
+-- name: GetSavedSearch :one
+SELECT * FROM saved_searches WHERE id = ?;
+
+-- name: ListSavedSearches :many
+SELECT * FROM saved_searches ORDER BY name ASC;
+
+-- name: UpdateSavedSearch :one
+UPDATE saved_searches
+SET
+  name = sqlc.arg('name'),
+  query = sqlc.narg('query'),
+  match_query = sqlc.narg('match_query'),
+  is_read = sqlc.narg('is_read'),
+  is_starred = sqlc.narg('is_starred'),
+  author = sqlc.narg('author'),
+  since = sqlc.narg('since'),
+  until = sqlc.narg('until'),
+  max_age_days = sqlc.narg('max_age_days'),
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: DeleteSavedSearch :exec
+DELETE FROM saved_searches WHERE id = ?;
+
+-- name: ListSavedSearchFeeds :many
+SELECT saved_search_id, feed_id FROM saved_search_feeds ORDER BY saved_search_id, feed_id;
+
+-- name: ListSavedSearchTags :many
+SELECT saved_search_id, tag_id FROM saved_search_tags ORDER BY saved_search_id, tag_id;
+
+-- name: CreateSavedSearchFeed :exec
+INSERT INTO saved_search_feeds (saved_search_id, feed_id) VALUES (?, ?);
+
+-- name: CreateSavedSearchTag :exec
+INSERT INTO saved_search_tags (saved_search_id, tag_id) VALUES (?, ?);
+
+-- name: DeleteSavedSearchFeeds :exec
+DELETE FROM saved_search_feeds WHERE saved_search_id = ?;
+
+-- name: DeleteSavedSearchTags :exec
+DELETE FROM saved_search_tags WHERE saved_search_id = ?;
+
+-- name: GetItemCountsVersion :one
+SELECT
+  version
+FROM
+  item_counts_version
+WHERE
+  id = 1;
+
+-- name: GetSavedSearchCountsExpiry :one
+-- The earliest time an item leaves a saved search with max_age_days by
+-- getting too old for it, which changes its counts without any write. It is
+-- empty when no item will.
+SELECT
+  CAST(COALESCE(MIN(strftime('%FT%TZ', i.created_at, '+' || ss.max_age_days || ' days')), '') AS TEXT) AS expires_at
+FROM
+  saved_searches ss
+JOIN
+  items i ON i.created_at >= strftime('%FT%TZ', 'now', '-' || ss.max_age_days || ' days')
+WHERE
+  ss.max_age_days IS NOT NULL;
+
+-- name: CountItemsPerSavedSearch :many
+SELECT
+  ssi.saved_search_id,
+  CAST(COUNT(*) AS INTEGER) AS total_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS unread_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN 1 ELSE 0 END), 0) AS INTEGER) AS starred_count
+FROM
+  saved_search_items ssi
+LEFT JOIN
+  item_reads ir ON ir.item_id = ssi.item_id
+LEFT JOIN
+  item_stars st ON st.item_id = ssi.item_id
+WHERE
+  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = ssi.item_id) AND
+  NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = ssi.item_id)
+GROUP BY
+  ssi.saved_search_id
+ORDER BY
+  ssi.saved_search_id;
+
+-- name: GetItemStar :one
+SELECT * FROM item_stars WHERE item_id = ?;
+
+-- name: StarItem :one
+-- starred_at is kept when the item is already starred, so that editing the
+-- note or tags does not move it.
+INSERT INTO item_stars (
+  item_id,
+  is_starred,
+  starred_at,
+  note,
+  user_tags
+)
+SELECT
+  sqlc.arg('item_id'),
+  1,
+  sqlc.arg('starred_at'),
+  sqlc.narg('note'),
+  sqlc.narg('user_tags')
+WHERE
+  EXISTS (SELECT 1 FROM items WHERE id = sqlc.arg('item_id'))
+ON CONFLICT(item_id) DO UPDATE SET
+  is_starred = 1,
+  starred_at = CASE WHEN item_stars.is_starred = 1 THEN item_stars.starred_at ELSE excluded.starred_at END,
+  note = excluded.note,
+  user_tags = excluded.user_tags,
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING *;
+
+-- name: UnstarItem :one
+INSERT INTO item_stars (
+  item_id,
+  is_starred
+)
+SELECT
+  sqlc.arg('item_id'),
+  0
+WHERE
+  EXISTS (SELECT 1 FROM items WHERE id = sqlc.arg('item_id'))
+ON CONFLICT(item_id) DO UPDATE SET
+  is_starred = 0,
+  starred_at = NULL,
+  note = NULL,
+  user_tags = NULL,
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING *;
+
+-- name: ListItemStars :many
+SELECT
+  item_id,
+  is_starred,
+  starred_at,
+  note,
+  user_tags,
+  updated_at
+FROM
+  item_stars
+WHERE
+  (
+    sqlc.narg('updated_at_cursor') IS NOT NULL
+    AND sqlc.narg('item_id_cursor') IS NOT NULL
+    AND (updated_at, item_id) > (sqlc.narg('updated_at_cursor'), sqlc.narg('item_id_cursor'))
+  )
+  OR (
+    (sqlc.narg('updated_at_cursor') IS NULL OR sqlc.narg('item_id_cursor') IS NULL)
+    AND sqlc.narg('updated_after') IS NOT NULL
+    AND (updated_at, item_id) > (sqlc.narg('updated_after'), '')
+  )
+  OR (
+    (sqlc.narg('updated_at_cursor') IS NULL OR sqlc.narg('item_id_cursor') IS NULL)
+    AND sqlc.narg('updated_after') IS NULL
+  )
+ORDER BY
+  updated_at ASC,
+  item_id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: UpsertCategory :one
+INSERT INTO categories (id, name)
+VALUES (?, ?)
+ON CONFLICT(name) DO UPDATE SET name = categories.name
+RETURNING id;
+
+-- name: DeleteItemCategories :exec
+DELETE FROM item_categories
+WHERE item_id = ?;
+
+-- name: CreateItemCategory :exec
+INSERT INTO item_categories (item_id, category_id)
+VALUES (?, ?)
+ON CONFLICT(item_id, category_id) DO NOTHING;
+
+-- name: ListItemsWithoutCategories :many
+-- Items whose categories were saved as JSON only, before they were
+-- normalized into item_categories.
+SELECT
+  i.id,
+  i.categories
+FROM
+  items i
+WHERE
+  (CASE WHEN json_valid(i.categories) THEN json_type(i.categories) END) = 'array' AND
+  EXISTS (
+    SELECT 1 FROM json_each(CASE WHEN json_valid(i.categories) THEN i.categories END) ic
+    WHERE ic.type = 'text' AND trim(ic.value) != ''
+  ) AND
+  NOT EXISTS (SELECT 1 FROM item_categories ic WHERE ic.item_id = i.id) AND
+  i.id > sqlc.arg('after_id')
+ORDER BY
+  i.id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListCategoryFacets :many
+-- Categories with the number of items and unread items in each, optionally
+-- restricted to a feed or to the feeds with a tag. Blocked items are not
+-- counted.
+SELECT
+  c.name,
+  CAST(COUNT(*) AS INTEGER) AS item_count,
+  CAST(SUM(CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END) AS INTEGER) AS unread_count
+FROM
+  categories c
+  JOIN item_categories ic ON ic.category_id = c.id
+  LEFT JOIN item_reads ir ON ir.item_id = ic.item_id
+WHERE
+  EXISTS (
+    SELECT 1 FROM feed_items fi
+    WHERE fi.item_id = ic.item_id AND
+    (sqlc.narg('feed_id') IS NULL OR fi.feed_id = sqlc.narg('feed_id')) AND
+    (sqlc.narg('tag_id') IS NULL OR EXISTS (
+      SELECT 1 FROM feed_tags ft WHERE ft.feed_id = fi.feed_id AND ft.tag_id = sqlc.narg('tag_id')
+    ))
+  ) AND
+  NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = ic.item_id)
+GROUP BY
+  c.id
+ORDER BY
+  item_count DESC,
+  c.name ASC
+LIMIT sqlc.arg('limit');
+
+-- name: CountAllItems :one
+-- Counts are of the items in feeds, leaving out blocked items as unread
+-- counts do.
+SELECT
+  CAST(COUNT(*) AS INTEGER) AS total_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS unread_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN 1 ELSE 0 END), 0) AS INTEGER) AS starred_count
+FROM
+  items i
+LEFT JOIN
+  item_reads ir ON ir.item_id = i.id
+LEFT JOIN
+  item_stars st ON st.item_id = i.id
+WHERE
+  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id) AND
+  NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = i.id);
+
+-- name: CountItemsPerFeed :many
+SELECT
+  f.id AS feed_id,
+  CAST(COUNT(fi.item_id) AS INTEGER) AS total_count,
+  CAST(COALESCE(SUM(CASE WHEN fi.item_id IS NOT NULL AND COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS unread_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN 1 ELSE 0 END), 0) AS INTEGER) AS starred_count
+FROM
+  feeds f
+LEFT JOIN
+  feed_items fi ON fi.feed_id = f.id AND NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = fi.item_id)
+LEFT JOIN
+  item_reads ir ON ir.item_id = fi.item_id
+LEFT JOIN
+  item_stars st ON st.item_id = fi.item_id
+GROUP BY
+  f.id
+ORDER BY
+  f.id;
+
+-- name: CountItemsPerTag :many
+-- An item in several feeds with the tag is counted once.
+SELECT
+  t.id AS tag_id,
+  CAST(COUNT(DISTINCT fi.item_id) AS INTEGER) AS total_count,
+  CAST(COUNT(DISTINCT CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN fi.item_id END) AS INTEGER) AS unread_count,
+  CAST(COUNT(DISTINCT CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN fi.item_id END) AS INTEGER) AS starred_count
+FROM
+  tags t
+LEFT JOIN
+  feed_tags ft ON ft.tag_id = t.id
+LEFT JOIN
+  feed_items fi ON fi.feed_id = ft.feed_id AND NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = fi.item_id)
+LEFT JOIN
+  item_reads ir ON ir.item_id = fi.item_id
+LEFT JOIN
+  item_stars st ON st.item_id = fi.item_id
+GROUP BY
+  t.id
+ORDER BY
+  t.id;
@@ -9,13 +9,16 @@ CREATE TABLE feeds (
   copyright       TEXT,
   feed_type       TEXT,
   feed_version    TEXT,
+  fetch_full_content INTEGER NOT NULL DEFAULT 0,
+  unread_on_revision INTEGER NOT NULL DEFAULT 0,
+  title_overridden INTEGER NOT NULL DEFAULT 0,
   created_at      TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
   updated_at      TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now'))
 );
 
 CREATE TABLE items (
   id           TEXT PRIMARY KEY,
-  url          TEXT NOT NULL UNIQUE,
+  url          TEXT NOT NULL,
   title        TEXT,
   description  TEXT,
   published_at TEXT,
@@ -24,10 +27,23 @@ CREATE TABLE items (
   content      TEXT,
   image_url    TEXT,
   categories   TEXT,
+  revised_at   TEXT,
+  description_html TEXT,
+  content_html TEXT,
   created_at   TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
   updated_at   TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now'))
 );
 
+CREATE TABLE item_identities (
+  feed_id    TEXT NOT NULL,
+  identity   TEXT NOT NULL,
+  item_id    TEXT NOT NULL,
+  created_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  PRIMARY KEY (feed_id, identity),
+  FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE,
+  FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+);
+
 CREATE TABLE feed_items (
   feed_id    TEXT NOT NULL,
   item_id    TEXT NOT NULL,
@@ -39,6 +55,45 @@ CREATE TABLE feed_items (
   FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
 );
 
+CREATE TABLE item_revisions (
+  item_id      TEXT NOT NULL,
+  revision     INTEGER NOT NULL,
+  content_hash TEXT NOT NULL,
+  title        TEXT,
+  description  TEXT,
+  content      TEXT,
+  created_at   TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  -- The feed the revision was saved from. Items shared by several feeds are
+  -- only revised by that feed, as the others may carry different text.
+  feed_id      TEXT REFERENCES feeds(id) ON DELETE SET NULL,
+  PRIMARY KEY (item_id, revision),
+  FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+);
+
+CREATE TABLE item_enclosures (
+  item_id          TEXT NOT NULL,
+  position         INTEGER NOT NULL,
+  url              TEXT NOT NULL,
+  mime_type        TEXT,
+  length           INTEGER,
+  duration_seconds INTEGER,
+  thumbnail_url    TEXT,
+  created_at       TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  PRIMARY KEY (item_id, position),
+  FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+);
+
+CREATE TABLE item_full_contents (
+  item_id    TEXT PRIMARY KEY,
+  content    TEXT,
+  content_html TEXT,
+  error      TEXT,
+  fetched_at TEXT NOT NULL,
+  created_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+);
+
 CREATE TABLE item_reads (
   item_id    TEXT NOT NULL,
   is_read    INTEGER NOT NULL DEFAULT 0,
@@ -72,6 +127,21 @@ CREATE TABLE feed_fetcher (
   last_modified TEXT,
   last_fetched_at TEXT,
   next_fetch    TEXT,
+  last_status_code     INTEGER,
+  last_error           TEXT,
+  last_error_kind      TEXT,
+  consecutive_failures INTEGER NOT NULL DEFAULT 0,
+  last_success_at      TEXT,
+  redirect_url         TEXT,
+  redirect_count       INTEGER NOT NULL DEFAULT 0,
+  disabled_at          TEXT,
+  disabled_reason      TEXT,
+  hint_max_age         INTEGER,
+  hint_ttl             INTEGER,
+  hint_update_period   INTEGER,
+  hint_skip_hours      TEXT,
+  hint_skip_days       TEXT,
+  schedule_reason      TEXT,
   created_at    TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
   updated_at    TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
   FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE
@@ -117,6 +187,11 @@ CREATE INDEX idx_item_blocks_rule_id ON item_blocks(rule_id);
 CREATE INDEX idx_feed_items_item_id ON feed_items(item_id);
 CREATE INDEX idx_item_reads_is_read ON item_reads(is_read);
 CREATE INDEX idx_items_created_at_id ON items(created_at, id);
+-- Orders items by publication date, falling back to the time they were saved.
+CREATE INDEX idx_items_hybrid_date_id ON items(strftime('%FT%TZ', datetime(COALESCE(published_at, created_at))), id);
+CREATE INDEX idx_items_url ON items(url);
+CREATE INDEX idx_item_identities_item_id ON item_identities(item_id);
+CREATE INDEX idx_item_enclosures_mime_type ON item_enclosures(mime_type);
 
 CREATE TRIGGER trg_items_insert_item_reads
 AFTER INSERT ON items
@@ -124,6 +199,36 @@ BEGIN
   INSERT INTO item_reads (item_id, is_read) VALUES (NEW.id, 0);
 END;
 
+-- Full-text index of items. It reads the indexed text from items by rowid,
+-- and is kept in sync by the triggers below.
+CREATE VIRTUAL TABLE items_fts USING fts5(
+  title,
+  description,
+  content,
+  content='items',
+  content_rowid='rowid',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER trg_items_fts_insert
+AFTER INSERT ON items
+BEGIN
+  INSERT INTO items_fts (rowid, title, description, content) VALUES (NEW.rowid, NEW.title, NEW.description, NEW.content);
+END;
+
+CREATE TRIGGER trg_items_fts_delete
+AFTER DELETE ON items
+BEGIN
+  INSERT INTO items_fts (items_fts, rowid, title, description, content) VALUES ('delete', OLD.rowid, OLD.title, OLD.description, OLD.content);
+END;
+
+CREATE TRIGGER trg_items_fts_update
+AFTER UPDATE OF title, description, content ON items
+BEGIN
+  INSERT INTO items_fts (items_fts, rowid, title, description, content) VALUES ('delete', OLD.rowid, OLD.title, OLD.description, OLD.content);
+  INSERT INTO items_fts (rowid, title, description, content) VALUES (NEW.rowid, NEW.title, NEW.description, NEW.content);
+END;
+
 CREATE TABLE ignore_windows (
   id           TEXT PRIMARY KEY,
   name         TEXT NOT NULL,
@@ -158,3 +263,374 @@ CREATE TABLE tag_ignore_windows (
 CREATE INDEX idx_feed_ignore_windows_ignore_window_id ON feed_ignore_windows(ignore_window_id);
 CREATE INDEX idx_tag_ignore_windows_ignore_window_id ON tag_ignore_windows(ignore_window_id);
 
+CREATE TABLE websub_subscriptions (
+  feed_id          TEXT PRIMARY KEY,
+  hub_url          TEXT NOT NULL,
+  topic_url        TEXT NOT NULL,
+  secret           TEXT NOT NULL,
+  state            TEXT NOT NULL DEFAULT 'pending',
+  lease_seconds    INTEGER,
+  lease_expires_at TEXT,
+  created_at       TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at       TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_websub_subscriptions_lease_expires_at ON websub_subscriptions(lease_expires_at);
+
+CREATE TABLE feed_credentials (
+  feed_id        TEXT PRIMARY KEY,
+  auth_type      TEXT NOT NULL,
+  encrypted_data TEXT NOT NULL,
+  created_at     TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at     TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+);
+
+CREATE TABLE feed_scrapers (
+  feed_id          TEXT PRIMARY KEY,
+  item_selector    TEXT NOT NULL,
+  title_selector   TEXT,
+  link_selector    TEXT,
+  date_selector    TEXT,
+  content_selector TEXT,
+  created_at       TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at       TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+);
+
+CREATE TABLE feed_icons (
+  feed_id     TEXT PRIMARY KEY,
+  source_url  TEXT,
+  mime_type   TEXT,
+  data        BLOB,
+  error       TEXT,
+  fetched_at  TEXT NOT NULL,
+  created_at  TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at  TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+);
+
+CREATE TABLE transport_profiles (
+  id                   TEXT PRIMARY KEY,
+  name                 TEXT NOT NULL UNIQUE,
+  proxy_url            TEXT,
+  ca_bundle            TEXT,
+  client_cert          TEXT,
+  client_key           TEXT,
+  insecure_skip_verify INTEGER NOT NULL DEFAULT 0,
+  timeout_seconds      INTEGER,
+  retry_max            INTEGER,
+  created_at           TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at           TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now'))
+);
+
+CREATE TABLE feed_transport_profiles (
+  feed_id              TEXT PRIMARY KEY,
+  transport_profile_id TEXT NOT NULL,
+  created_at           TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at           TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE,
+  FOREIGN KEY (transport_profile_id) REFERENCES transport_profiles(id) ON DELETE CASCADE
+);
+
+CREATE TABLE tag_transport_profiles (
+  tag_id               TEXT PRIMARY KEY,
+  transport_profile_id TEXT NOT NULL,
+  created_at           TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at           TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE,
+  FOREIGN KEY (transport_profile_id) REFERENCES transport_profiles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_feed_transport_profiles_transport_profile_id ON feed_transport_profiles(transport_profile_id);
+CREATE INDEX idx_tag_transport_profiles_transport_profile_id ON tag_transport_profiles(transport_profile_id);
+
+-- Saved searches are stored filters over items. An item matches when it
+-- belongs to one of the listed feeds or to a feed with one of the listed tags
+-- (any feed when none are listed), and to every other filter that is set.
+CREATE TABLE saved_searches (
+  id           TEXT PRIMARY KEY,
+  name         TEXT NOT NULL UNIQUE,
+  query        TEXT,
+  match_query  TEXT,
+  is_read      INTEGER,
+  is_starred   INTEGER,
+  author       TEXT,
+  since        TEXT,
+  until        TEXT,
+  max_age_days INTEGER,
+  created_at   TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at   TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now'))
+);
+
+CREATE TABLE saved_search_feeds (
+  saved_search_id TEXT NOT NULL,
+  feed_id         TEXT NOT NULL,
+  created_at      TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  PRIMARY KEY (saved_search_id, feed_id),
+  FOREIGN KEY (saved_search_id) REFERENCES saved_searches(id) ON DELETE CASCADE,
+  FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+);
+
+CREATE TABLE saved_search_tags (
+  saved_search_id TEXT NOT NULL,
+  tag_id          TEXT NOT NULL,
+  created_at      TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  PRIMARY KEY (saved_search_id, tag_id),
+  FOREIGN KEY (saved_search_id) REFERENCES saved_searches(id) ON DELETE CASCADE,
+  FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_saved_search_feeds_feed_id ON saved_search_feeds(feed_id);
+CREATE INDEX idx_saved_search_tags_tag_id ON saved_search_tags(tag_id);
+
+-- Starred items. Unstarring keeps the row with is_starred = 0, like
+-- item_reads, so that clients syncing changes by updated_at see it.
+CREATE TABLE item_stars (
+  item_id    TEXT NOT NULL,
+  is_starred INTEGER NOT NULL DEFAULT 0,
+  starred_at TEXT,
+  note       TEXT,
+  user_tags  TEXT,
+  created_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  updated_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  PRIMARY KEY (item_id),
+  FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_item_stars_updated_at_item_id ON item_stars(updated_at, item_id);
+CREATE INDEX idx_item_stars_is_starred ON item_stars(is_starred);
+
+-- Starred items are kept: any retention or cleanup that deletes items has to
+-- skip them.
+CREATE TRIGGER trg_items_keep_starred
+BEFORE DELETE ON items
+WHEN EXISTS (SELECT 1 FROM item_stars WHERE item_id = OLD.id AND is_starred = 1)
+BEGIN
+  SELECT RAISE(ABORT, 'starred items cannot be deleted');
+END;
+
+-- saved_search_items pairs saved searches with the items they match, for the
+-- item queries to share the saved search filter. Like those queries, callers
+-- leave out items without a feed or blocked items themselves.
+CREATE VIEW saved_search_items AS
+SELECT
+  ss.id AS saved_search_id,
+  i.id AS item_id
+FROM
+  saved_searches ss
+JOIN
+  items i
+WHERE
+  (
+    (
+      NOT EXISTS (SELECT 1 FROM saved_search_feeds ssf WHERE ssf.saved_search_id = ss.id) AND
+      NOT EXISTS (SELECT 1 FROM saved_search_tags sst WHERE sst.saved_search_id = ss.id)
+    ) OR
+    EXISTS (
+      SELECT 1 FROM feed_items sfi
+      JOIN saved_search_feeds ssf ON ssf.feed_id = sfi.feed_id
+      WHERE sfi.item_id = i.id AND ssf.saved_search_id = ss.id
+    ) OR
+    EXISTS (
+      SELECT 1 FROM feed_items sfi
+      JOIN feed_tags sft ON sft.feed_id = sfi.feed_id
+      JOIN saved_search_tags sst ON sst.tag_id = sft.tag_id
+      WHERE sfi.item_id = i.id AND sst.saved_search_id = ss.id
+    )
+  ) AND
+  (ss.is_read IS NULL OR COALESCE((SELECT sir.is_read FROM item_reads sir WHERE sir.item_id = i.id), 0) = ss.is_read) AND
+  (ss.is_starred IS NULL OR COALESCE((SELECT sst.is_starred FROM item_stars sst WHERE sst.item_id = i.id), 0) = ss.is_starred) AND
+  (ss.author IS NULL OR i.author = ss.author COLLATE NOCASE) AND
+  (ss.since IS NULL OR i.created_at >= ss.since) AND
+  (ss.until IS NULL OR i.created_at < ss.until) AND
+  (ss.max_age_days IS NULL OR i.created_at >= strftime('%FT%TZ', 'now', '-' || ss.max_age_days || ' days')) AND
+  (ss.match_query IS NULL OR EXISTS (SELECT 1 FROM items_fts(ss.match_query) f WHERE f.rowid = i.rowid));
+
+-- Categories of items, normalized from items.categories so that they can be
+-- filtered on, counted and blocked. Names are unique regardless of case.
+CREATE TABLE categories (
+  id         TEXT PRIMARY KEY,
+  name       TEXT NOT NULL UNIQUE COLLATE NOCASE,
+  created_at TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now'))
+);
+
+CREATE TABLE item_categories (
+  item_id     TEXT NOT NULL,
+  category_id TEXT NOT NULL,
+  created_at  TEXT NOT NULL DEFAULT (strftime('%FT%TZ', 'now')),
+  PRIMARY KEY (item_id, category_id),
+  FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE,
+  FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_item_categories_category_id ON item_categories(category_id);
+
+-- item_counts_version changes whenever item counts may have, so that clients
+-- already holding the current counts can be told so without counting them
+-- again. Its single row is added by the migration, and the triggers below bump
+-- it on every change the counts depend on.
+CREATE TABLE item_counts_version (
+  id      INTEGER PRIMARY KEY,
+  version INTEGER NOT NULL
+);
+
+CREATE TRIGGER trg_feeds_insert_item_counts_version
+AFTER INSERT ON feeds
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_feeds_delete_item_counts_version
+AFTER DELETE ON feeds
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_tags_insert_item_counts_version
+AFTER INSERT ON tags
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_tags_delete_item_counts_version
+AFTER DELETE ON tags
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_feed_tags_insert_item_counts_version
+AFTER INSERT ON feed_tags
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_feed_tags_delete_item_counts_version
+AFTER DELETE ON feed_tags
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_feed_items_insert_item_counts_version
+AFTER INSERT ON feed_items
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_feed_items_delete_item_counts_version
+AFTER DELETE ON feed_items
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_items_insert_item_counts_version
+AFTER INSERT ON items
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_items_delete_item_counts_version
+AFTER DELETE ON items
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_items_update_item_counts_version
+AFTER UPDATE OF author, title, description, content, created_at ON items
+WHEN OLD.author IS NOT NEW.author OR OLD.title IS NOT NEW.title OR OLD.description IS NOT NEW.description OR OLD.content IS NOT NEW.content OR OLD.created_at IS NOT NEW.created_at
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_reads_insert_item_counts_version
+AFTER INSERT ON item_reads
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_reads_delete_item_counts_version
+AFTER DELETE ON item_reads
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_reads_update_item_counts_version
+AFTER UPDATE OF is_read ON item_reads
+WHEN OLD.is_read IS NOT NEW.is_read
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_stars_insert_item_counts_version
+AFTER INSERT ON item_stars
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_stars_delete_item_counts_version
+AFTER DELETE ON item_stars
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_stars_update_item_counts_version
+AFTER UPDATE OF is_starred ON item_stars
+WHEN OLD.is_starred IS NOT NEW.is_starred
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_blocks_insert_item_counts_version
+AFTER INSERT ON item_blocks
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_item_blocks_delete_item_counts_version
+AFTER DELETE ON item_blocks
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_saved_searches_insert_item_counts_version
+AFTER INSERT ON saved_searches
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_saved_searches_update_item_counts_version
+AFTER UPDATE ON saved_searches
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_saved_searches_delete_item_counts_version
+AFTER DELETE ON saved_searches
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_saved_search_feeds_insert_item_counts_version
+AFTER INSERT ON saved_search_feeds
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_saved_search_feeds_delete_item_counts_version
+AFTER DELETE ON saved_search_feeds
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_saved_search_tags_insert_item_counts_version
+AFTER INSERT ON saved_search_tags
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
+
+CREATE TRIGGER trg_saved_search_tags_delete_item_counts_version
+AFTER DELETE ON saved_search_tags
+BEGIN
+  UPDATE item_counts_version SET version = version + 1;
+END;
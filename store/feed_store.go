@@ -7,8 +7,6 @@ import (
 	"net/url"
 	"strings"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 type ListFeedsParams struct {
@@ -209,6 +207,14 @@ type SaveFetchedItemParams struct {
 	Content     *string
 	ImageUrl    *string
 	Categories  *string
+	Enclosures  []FetchedEnclosure
+	// DescriptionHtml and ContentHtml are the sanitized HTML that
+	// Description and Content were converted from.
+	DescriptionHtml *string
+	ContentHtml     *string
+	// SourceHash is the ItemContentHash of the text the feed sent, before it
+	// was converted. When empty, revisions hash the saved text.
+	SourceHash string
 }
 
 func ValidateSaveFetchedItemParams(params SaveFetchedItemParams) error {
@@ -232,21 +238,9 @@ func (s *Store) SaveFetchedItem(ctx context.Context, params SaveFetchedItemParam
 	}
 	return s.WithTransaction(ctx, func(qtx *Queries) error {
 		// 1. Upsert Item
-		newID := uuid.NewString()
-		item, err := qtx.CreateItem(ctx, CreateItemParams{
-			ID:          newID,
-			Url:         params.Url,
-			Title:       params.Title,
-			Description: params.Description,
-			PublishedAt: params.PublishedAt,
-			Author:      params.Author,
-			Guid:        params.Guid,
-			Content:     params.Content,
-			ImageUrl:    params.ImageUrl,
-			Categories:  params.Categories,
-		})
+		item, previous, err := UpsertFetchedItem(ctx, qtx, params)
 		if err != nil {
-			return fmt.Errorf("failed to create/update item: %w", err)
+			return err
 		}
 
 		// 2. Link to Feed
@@ -265,6 +259,32 @@ func (s *Store) SaveFetchedItem(ctx context.Context, params SaveFetchedItemParam
 			return fmt.Errorf("failed to initialize read status: %w", err)
 		}
 
+		// 3.25. Save Enclosures
+		if err := SaveItemEnclosures(ctx, qtx, item.ID, params.Enclosures); err != nil {
+			return err
+		}
+
+		// 3.3. Save Categories
+		if err := SaveItemCategories(ctx, qtx, item.ID, item.Categories); err != nil {
+			return err
+		}
+
+		// 3.5. Record Revision
+		feed, err := qtx.GetFeed(ctx, params.FeedID)
+		if err != nil {
+			return fmt.Errorf("failed to get feed: %w", err)
+		}
+		_, err = RecordItemRevision(ctx, qtx, RecordItemRevisionParams{
+			FeedID:           params.FeedID,
+			Previous:         previous,
+			Item:             item,
+			SourceHash:       params.SourceHash,
+			UnreadOnRevision: feed.UnreadOnRevision != 0,
+		})
+		if err != nil {
+			return err
+		}
+
 		// 4. Check for blocking rules
 		// We fetch rules inside the transaction for consistency,
 		// but for performance with many items we might want to cache these outside.
@@ -284,10 +304,11 @@ func (s *Store) SaveFetchedItem(ctx context.Context, params SaveFetchedItemParam
 			extractedUser, extractedDomain := extractUserInfoLocally(item.Url, urlRules)
 
 			fullItem := FullItem{
-				ID:      item.ID,
-				Url:     item.Url,
-				Title:   item.Title,
-				Content: item.Content,
+				ID:         item.ID,
+				Url:        item.Url,
+				Title:      item.Title,
+				Content:    item.Content,
+				Categories: item.Categories,
 			}
 
 			for _, rule := range blockRules {
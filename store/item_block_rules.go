@@ -110,6 +110,14 @@ func ShouldBlockItem(item FullItem, rule ItemBlockRule, extractedUser *string, e
 			return true
 		}
 		return false
+	case "category":
+		// Match one of the item's categories, regardless of case
+		for _, name := range DecodeCategories(item.Categories) {
+			if strings.EqualFold(name, strings.TrimSpace(rule.RuleValue)) {
+				return true
+			}
+		}
+		return false
 	}
 	return false
 }
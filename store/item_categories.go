@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// DecodeCategories returns the category names of items.categories, a JSON
+// array of strings. Blank and repeated names, compared regardless of case,
+// are skipped, and categories that are not a JSON array of strings have no
+// names.
+func DecodeCategories(categories *string) []string {
+	if categories == nil {
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(*categories), &values); err != nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(values))
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		name := strings.TrimSpace(value)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// SaveItemCategories replaces the categories of an item with the ones in
+// categories, the JSON array stored in items.categories.
+func SaveItemCategories(ctx context.Context, q *Queries, itemID string, categories *string) error {
+	if err := q.DeleteItemCategories(ctx, itemID); err != nil {
+		return fmt.Errorf("failed to delete item categories: %w", err)
+	}
+	for _, name := range DecodeCategories(categories) {
+		categoryID, err := q.UpsertCategory(ctx, UpsertCategoryParams{ID: uuid.NewString(), Name: name})
+		if err != nil {
+			return fmt.Errorf("failed to save category: %w", err)
+		}
+		err = q.CreateItemCategory(ctx, CreateItemCategoryParams{ItemID: itemID, CategoryID: categoryID})
+		if err != nil {
+			return fmt.Errorf("failed to create item category: %w", err)
+		}
+	}
+	return nil
+}
+
+// BackfillItemCategories normalizes the categories of items saved before
+// they were recorded in item_categories.
+func (s *Store) BackfillItemCategories(ctx context.Context) (int, error) {
+	const batchSize = 500
+	var afterID string
+	var total int
+	for {
+		rows, err := s.ListItemsWithoutCategories(ctx, ListItemsWithoutCategoriesParams{
+			AfterID: afterID,
+			Limit:   batchSize,
+		})
+		if err != nil {
+			return total, fmt.Errorf("failed to list items without categories: %w", err)
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+
+		err = s.WithTransaction(ctx, func(qtx *Queries) error {
+			for _, row := range rows {
+				if err := SaveItemCategories(ctx, qtx, row.ID, row.Categories); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += len(rows)
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+// CategoryFacetsParams restricts category facets to the items of a feed or
+// of the feeds with a tag.
+type CategoryFacetsParams struct {
+	FeedID string
+	TagID  string
+	Limit  int64
+}
+
+// ListCategoryFacets returns categories with their item and unread counts,
+// the largest first.
+func (s *Store) ListCategoryFacets(ctx context.Context, params CategoryFacetsParams) ([]ListCategoryFacetsRow, error) {
+	var feedID, tagID interface{}
+	if params.FeedID != "" {
+		feedID = params.FeedID
+	}
+	if params.TagID != "" {
+		tagID = params.TagID
+	}
+	return s.Queries.ListCategoryFacets(ctx, ListCategoryFacetsParams{
+		FeedID: feedID,
+		TagID:  tagID,
+		Limit:  params.Limit,
+	})
+}
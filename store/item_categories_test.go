@@ -0,0 +1,136 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestDecodeCategories(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		categories *string
+		want       []string
+	}{
+		{"nil", nil, nil},
+		{"invalid JSON", ptrString("Go"), nil},
+		{"not strings", ptrString(`[1, 2]`), nil},
+		{"blank and repeated names", ptrString(`[" Go ", "", "go", "Rust"]`), []string{"Go", "Rust"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.DeepEqual(t, store.DecodeCategories(tc.categories), tc.want)
+		})
+	}
+}
+
+func TestStore_ItemCategories(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+
+	for _, id := range []string{"feed-1", "feed-2"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: "https://example.com/" + id + ".xml"})
+		assert.NilError(t, err)
+	}
+	tagID := uuid.NewString()
+	_, err := s.CreateTag(ctx, store.CreateTagParams{ID: tagID, Name: "news"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateFeedTag(ctx, store.CreateFeedTagParams{FeedID: "feed-2", TagID: tagID}))
+
+	for _, item := range []struct{ feedID, url, categories string }{
+		{"feed-1", "https://example.com/1", `["Go", "Databases"]`},
+		{"feed-1", "https://example.com/2", `["go"]`},
+		{"feed-2", "https://example.com/3", `["GO", "Rust"]`},
+	} {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: item.feedID, Url: item.url, Categories: ptrString(item.categories)}))
+	}
+
+	facets := func(t *testing.T, params store.CategoryFacetsParams) map[string][2]int64 {
+		t.Helper()
+		params.Limit = 10
+		rows, err := s.ListCategoryFacets(ctx, params)
+		assert.NilError(t, err)
+		got := make(map[string][2]int64, len(rows))
+		for _, row := range rows {
+			got[row.Name] = [2]int64{row.ItemCount, row.UnreadCount}
+		}
+		return got
+	}
+
+	t.Run("Names are shared regardless of case", func(t *testing.T) {
+		var count int
+		assert.NilError(t, s.DB.QueryRowContext(ctx, "SELECT count(*) FROM categories").Scan(&count))
+		assert.Equal(t, count, 3)
+		assert.DeepEqual(t, facets(t, store.CategoryFacetsParams{}), map[string][2]int64{
+			"Go": {3, 3}, "Databases": {1, 1}, "Rust": {1, 1},
+		})
+	})
+
+	t.Run("Facets per feed and tag", func(t *testing.T) {
+		rows, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: "feed-1", Category: "databases", Limit: 10})
+		assert.NilError(t, err)
+		assert.Equal(t, len(rows), 1)
+		_, err = s.SetItemRead(ctx, store.SetItemReadParams{ItemID: rows[0].ID, IsRead: 1})
+		assert.NilError(t, err)
+
+		assert.DeepEqual(t, facets(t, store.CategoryFacetsParams{FeedID: "feed-1"}), map[string][2]int64{
+			"Go": {2, 1}, "Databases": {1, 0},
+		})
+		assert.DeepEqual(t, facets(t, store.CategoryFacetsParams{TagID: tagID}), map[string][2]int64{
+			"Go": {1, 1}, "Rust": {1, 1},
+		})
+	})
+
+	t.Run("Saving an item again replaces its categories", func(t *testing.T) {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-2", Url: "https://example.com/3", Categories: ptrString(`["Rust"]`)}))
+		assert.DeepEqual(t, facets(t, store.CategoryFacetsParams{FeedID: "feed-2"}), map[string][2]int64{
+			"Rust": {1, 1},
+		})
+	})
+
+	t.Run("Category block rules", func(t *testing.T) {
+		rules, err := s.CreateItemBlockRules(ctx, []store.CreateItemBlockRuleParams{{ID: uuid.NewString(), RuleType: "category", RuleValue: "rust"}})
+		assert.NilError(t, err)
+		items, err := s.ListItemsForBlocking(ctx)
+		assert.NilError(t, err)
+		assert.NilError(t, s.PopulateItemBlocksForRule(ctx, rules[0], items, nil))
+
+		assert.DeepEqual(t, facets(t, store.CategoryFacetsParams{FeedID: "feed-2"}), map[string][2]int64{})
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: "feed-1", Url: "https://example.com/4", Categories: ptrString(`["Rust"]`)}))
+		count, err := s.CountItems(ctx, store.StoreCountItemsParams{Category: "Rust", IsBlocked: false})
+		assert.NilError(t, err)
+		assert.Equal(t, count, int64(0))
+	})
+}
+
+func TestStore_BackfillItemCategories(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+
+	for _, params := range []store.CreateItemParams{
+		{ID: "item-1", Url: "https://example.com/1", Categories: ptrString(`["Go"]`)},
+		{ID: "item-2", Url: "https://example.com/2", Categories: ptrString(`[""]`)},
+		{ID: "item-3", Url: "https://example.com/3", Categories: ptrString("not json")},
+	} {
+		_, err := s.CreateItem(ctx, params)
+		assert.NilError(t, err)
+	}
+
+	count, err := s.BackfillItemCategories(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 1)
+	count, err = s.BackfillItemCategories(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 0)
+
+	var name string
+	err = s.DB.QueryRowContext(ctx, "SELECT c.name FROM item_categories ic JOIN categories c ON c.id = ic.category_id WHERE ic.item_id = 'item-1'").Scan(&name)
+	assert.NilError(t, err)
+	assert.Equal(t, name, "Go")
+}
+
+func ptrString(value string) *string {
+	return &value
+}
@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ItemCounts are the numbers of items, unread items and starred items in a
+// feed, a tag, a saved search or overall. Blocked items are not counted.
+type ItemCounts struct {
+	TotalCount   int64
+	UnreadCount  int64
+	StarredCount int64
+}
+
+// AllItemCounts are the item counts shown next to feeds, tags and saved
+// searches, keyed by their IDs. Every feed, tag and saved search has an
+// entry, even when it has no items.
+type AllItemCounts struct {
+	Total         ItemCounts
+	Feeds         map[string]ItemCounts
+	Tags          map[string]ItemCounts
+	SavedSearches map[string]ItemCounts
+	// The counts stay the same while the item counts version is Version and,
+	// unless it is zero, until ExpiresAt, when an item gets too old for a
+	// saved search with a maximum age. Version is zero when the database has
+	// none.
+	Version   int64
+	ExpiresAt time.Time
+}
+
+// ItemCountsVersion returns the version item counts are at, or zero when the
+// database was not seeded with one.
+func (s *Store) ItemCountsVersion(ctx context.Context) (int64, error) {
+	return itemCountsVersion(ctx, s.Queries)
+}
+
+func itemCountsVersion(ctx context.Context, q *Queries) (int64, error) {
+	version, err := q.GetItemCountsVersion(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get item counts version: %w", err)
+	}
+	return version, nil
+}
+
+// CountAllItems returns every item count, read in one transaction so that
+// they agree with each other.
+func (s *Store) CountAllItems(ctx context.Context) (AllItemCounts, error) {
+	var counts AllItemCounts
+	err := s.WithTransaction(ctx, func(qtx *Queries) error {
+		version, err := itemCountsVersion(ctx, qtx)
+		if err != nil {
+			return err
+		}
+		expiresAt, err := qtx.GetSavedSearchCountsExpiry(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get saved search counts expiry: %w", err)
+		}
+		total, err := qtx.CountAllItems(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count items: %w", err)
+		}
+		counts = AllItemCounts{
+			Total:         ItemCounts(total),
+			Feeds:         make(map[string]ItemCounts),
+			Tags:          make(map[string]ItemCounts),
+			SavedSearches: make(map[string]ItemCounts),
+			Version:       version,
+		}
+		if expiresAt != "" {
+			counts.ExpiresAt, err = time.Parse(time.RFC3339, expiresAt)
+			if err != nil {
+				return fmt.Errorf("failed to parse saved search counts expiry: %w", err)
+			}
+		}
+
+		feeds, err := qtx.CountItemsPerFeed(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count items per feed: %w", err)
+		}
+		for _, row := range feeds {
+			counts.Feeds[row.FeedID] = ItemCounts{TotalCount: row.TotalCount, UnreadCount: row.UnreadCount, StarredCount: row.StarredCount}
+		}
+
+		tags, err := qtx.CountItemsPerTag(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count items per tag: %w", err)
+		}
+		for _, row := range tags {
+			counts.Tags[row.TagID] = ItemCounts{TotalCount: row.TotalCount, UnreadCount: row.UnreadCount, StarredCount: row.StarredCount}
+		}
+
+		// Saved searches matching no items have no count row.
+		searches, err := qtx.ListSavedSearches(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list saved searches: %w", err)
+		}
+		for _, search := range searches {
+			counts.SavedSearches[search.ID] = ItemCounts{}
+		}
+		savedSearches, err := qtx.CountItemsPerSavedSearch(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count items per saved search: %w", err)
+		}
+		for _, row := range savedSearches {
+			counts.SavedSearches[row.SavedSearchID] = ItemCounts{TotalCount: row.TotalCount, UnreadCount: row.UnreadCount, StarredCount: row.StarredCount}
+		}
+		return nil
+	})
+	return counts, err
+}
@@ -0,0 +1,98 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestStore_CountAllItems(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+	// The migration seeds the version.
+	_, err := s.DB.ExecContext(ctx, "INSERT INTO item_counts_version (id, version) VALUES (1, 1)")
+	assert.NilError(t, err)
+
+	for _, id := range []string{"feed-1", "feed-2", "feed-3"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: "https://example.com/" + id + ".xml"})
+		assert.NilError(t, err)
+	}
+	for _, id := range []string{"tag-1", "tag-2"} {
+		_, err := s.CreateTag(ctx, store.CreateTagParams{ID: id, Name: id})
+		assert.NilError(t, err)
+	}
+	for _, feedID := range []string{"feed-1", "feed-2"} {
+		assert.NilError(t, s.CreateFeedTag(ctx, store.CreateFeedTagParams{FeedID: feedID, TagID: "tag-1"}))
+	}
+	// The shared item is in both tagged feeds.
+	for _, item := range []struct{ feedID, url string }{
+		{"feed-1", "https://example.com/1"},
+		{"feed-1", "https://example.com/shared"},
+		{"feed-2", "https://example.com/shared"},
+		{"feed-2", "https://example.com/blocked"},
+	} {
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{FeedID: item.feedID, Url: item.url}))
+	}
+	ids := make(map[string]string)
+	rows, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10})
+	assert.NilError(t, err)
+	for _, row := range rows {
+		ids[row.Url] = row.ID
+	}
+	_, err = s.SetItemRead(ctx, store.SetItemReadParams{ItemID: ids["https://example.com/1"], IsRead: 1})
+	assert.NilError(t, err)
+	_, err = s.StarItem(ctx, store.StoreStarItemParams{ItemID: ids["https://example.com/shared"]})
+	assert.NilError(t, err)
+	_, err = s.CreateItemBlockRule(ctx, store.CreateItemBlockRuleParams{ID: "rule-1", RuleType: "keyword", RuleValue: "blocked"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateItemBlock(ctx, store.CreateItemBlockParams{ItemID: ids["https://example.com/blocked"], RuleID: "rule-1"}))
+	isStarred := int64(1)
+	starred, err := s.CreateSavedSearch(ctx, "saved-1", store.SavedSearchParams{Name: "Starred", IsStarred: &isStarred})
+	assert.NilError(t, err)
+	author := "nobody"
+	empty, err := s.CreateSavedSearch(ctx, "saved-2", store.SavedSearchParams{Name: "Empty", Author: &author})
+	assert.NilError(t, err)
+
+	counts, err := s.CountAllItems(ctx)
+	assert.NilError(t, err)
+	version := counts.Version
+	assert.Assert(t, version > 0)
+	counts.Version = 0
+	assert.DeepEqual(t, counts, store.AllItemCounts{
+		Total: store.ItemCounts{TotalCount: 2, UnreadCount: 1, StarredCount: 1},
+		Feeds: map[string]store.ItemCounts{
+			"feed-1": {TotalCount: 2, UnreadCount: 1, StarredCount: 1},
+			"feed-2": {TotalCount: 1, UnreadCount: 1, StarredCount: 1},
+			"feed-3": {},
+		},
+		Tags: map[string]store.ItemCounts{
+			"tag-1": {TotalCount: 2, UnreadCount: 1, StarredCount: 1},
+			"tag-2": {},
+		},
+		SavedSearches: map[string]store.ItemCounts{
+			starred.ID: {TotalCount: 1, UnreadCount: 1, StarredCount: 1},
+			empty.ID:   {},
+		},
+	})
+
+	t.Run("Changes move the version", func(t *testing.T) {
+		_, err := s.SetItemRead(ctx, store.SetItemReadParams{ItemID: ids["https://example.com/shared"], IsRead: 1})
+		assert.NilError(t, err)
+		counts, err := s.CountAllItems(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, counts.Version > version)
+	})
+
+	t.Run("Counts expire when items get too old for a saved search", func(t *testing.T) {
+		maxAgeDays := int64(7)
+		_, err := s.CreateSavedSearch(ctx, "saved-3", store.SavedSearchParams{Name: "Recent", MaxAgeDays: &maxAgeDays})
+		assert.NilError(t, err)
+		counts, err := s.CountAllItems(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, counts.ExpiresAt.After(time.Now().AddDate(0, 0, 6)), counts.ExpiresAt)
+		assert.Assert(t, counts.ExpiresAt.Before(time.Now().AddDate(0, 0, 8)), counts.ExpiresAt)
+	})
+}
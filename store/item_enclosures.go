@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// FetchedEnclosure is a media file attached to a fetched item, such as the
+// audio of a podcast episode.
+type FetchedEnclosure struct {
+	URL             string
+	MimeType        *string
+	Length          *int64
+	DurationSeconds *int64
+	ThumbnailURL    *string
+}
+
+// SaveItemEnclosures replaces the enclosures of an item with the ones it was
+// last fetched with. Enclosures without a URL and repeated URLs are skipped.
+func SaveItemEnclosures(ctx context.Context, q *Queries, itemID string, enclosures []FetchedEnclosure) error {
+	if err := q.DeleteItemEnclosures(ctx, itemID); err != nil {
+		return fmt.Errorf("failed to delete item enclosures: %w", err)
+	}
+	seen := make(map[string]bool, len(enclosures))
+	var position int64
+	for _, enclosure := range enclosures {
+		if enclosure.URL == "" || seen[enclosure.URL] {
+			continue
+		}
+		seen[enclosure.URL] = true
+		err := q.CreateItemEnclosure(ctx, CreateItemEnclosureParams{
+			ItemID:          itemID,
+			Position:        position,
+			Url:             enclosure.URL,
+			MimeType:        enclosure.MimeType,
+			Length:          enclosure.Length,
+			DurationSeconds: enclosure.DurationSeconds,
+			ThumbnailUrl:    enclosure.ThumbnailURL,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create item enclosure: %w", err)
+		}
+		position++
+	}
+	return nil
+}
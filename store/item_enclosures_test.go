@@ -0,0 +1,90 @@
+package store_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestStore_SaveFetchedItem_Enclosures(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	audio := "audio/mpeg"
+	video := "video/mp4"
+	length := int64(1000)
+	save := func(url string, enclosures ...store.FetchedEnclosure) {
+		t.Helper()
+		title := url
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+			FeedID:     "feed-1",
+			Url:        url,
+			Title:      &title,
+			Enclosures: enclosures,
+		}))
+	}
+	save("https://example.com/episode",
+		store.FetchedEnclosure{URL: "https://cdn.example.com/episode.mp3", MimeType: &audio, Length: &length},
+		store.FetchedEnclosure{URL: "https://cdn.example.com/episode.mp3", MimeType: &audio},
+	)
+	save("https://example.com/clip", store.FetchedEnclosure{URL: "https://cdn.example.com/clip.mp4", MimeType: &video})
+	save("https://example.com/post")
+
+	list := func(hasEnclosure, mediaType any) []string {
+		t.Helper()
+		items, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10, HasEnclosure: hasEnclosure, MediaType: mediaType})
+		assert.NilError(t, err)
+		count, err := s.CountItems(ctx, store.StoreCountItemsParams{HasEnclosure: hasEnclosure, MediaType: mediaType})
+		assert.NilError(t, err)
+		assert.Equal(t, count, int64(len(items)))
+		urls := make([]string, 0, len(items))
+		for _, item := range items {
+			urls = append(urls, item.Url)
+		}
+		slices.Sort(urls)
+		return urls
+	}
+
+	assert.Equal(t, len(list(nil, nil)), 3)
+	assert.DeepEqual(t, list(int64(1), nil), []string{"https://example.com/clip", "https://example.com/episode"})
+	assert.DeepEqual(t, list(int64(0), nil), []string{"https://example.com/post"})
+	assert.DeepEqual(t, list(nil, "audio"), []string{"https://example.com/episode"})
+	assert.DeepEqual(t, list(nil, "video/mp4"), []string{"https://example.com/clip"})
+	assert.Equal(t, len(list(nil, "video/webm")), 0)
+
+	items, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10, MediaType: "audio"})
+	assert.NilError(t, err)
+	enclosures, err := s.ListItemEnclosures(ctx, items[0].ID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(enclosures), 1)
+	assert.Equal(t, *enclosures[0].Length, length)
+
+	t.Run("refetching replaces enclosures", func(t *testing.T) {
+		save("https://example.com/episode")
+		enclosures, err := s.ListItemEnclosures(ctx, items[0].ID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(enclosures), 0)
+	})
+
+	t.Run("podcast feeds have audio enclosures", func(t *testing.T) {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-2", Url: "https://example.com/podcast.xml"})
+		assert.NilError(t, err)
+		title := "Episode 2"
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+			FeedID:     "feed-2",
+			Url:        "https://example.com/episode-2",
+			Title:      &title,
+			Enclosures: []store.FetchedEnclosure{{URL: "https://cdn.example.com/episode-2.mp3", MimeType: &audio}},
+		}))
+
+		ids, err := s.ListPodcastFeedIDs(ctx, []string{"feed-1", "feed-2"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, ids, []string{"feed-2"})
+	})
+}
@@ -0,0 +1,125 @@
+package store_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestStore_ItemFilters(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	feeds := make(map[string]string)
+	for _, name := range []string{"a", "b", "c"} {
+		feeds[name] = uuid.NewString()
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: feeds[name], Url: "http://example.com/" + name + ".xml"})
+		assert.NilError(t, err)
+	}
+	tagID := uuid.NewString()
+	_, err := s.CreateTag(ctx, store.CreateTagParams{ID: tagID, Name: "news"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateFeedTag(ctx, store.CreateFeedTagParams{FeedID: feeds["c"], TagID: tagID}))
+
+	ptr := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+	ids := make(map[string]string)
+	for _, item := range []struct {
+		title, feed, publishedAt, createdAt, author, categories, imageURL string
+	}{
+		{"One", "a", "2026-01-01T00:00:00Z", "2026-01-10T00:00:00Z", "Alice", `["Go","Databases"]`, "http://example.com/1.png"},
+		{"Two", "b", "", "2026-01-05T00:00:00Z", "bob", `["Rust"]`, ""},
+		{"Three", "c", "2026-01-07T00:00:00+09:00", "2026-01-08T00:00:00Z", "", "not json", ""},
+		{"Four", "c", "2026-02-01T00:00:00Z", "2026-02-01T00:00:00Z", "ALICE", `["go"]`, "http://example.com/4.png"},
+	} {
+		id := uuid.NewString()
+		ids[item.title] = id
+		_, err := s.CreateItem(ctx, store.CreateItemParams{
+			ID:          id,
+			Url:         "http://example.com/" + item.title,
+			Title:       ptr(item.title),
+			PublishedAt: ptr(item.publishedAt),
+			Author:      ptr(item.author),
+			Categories:  ptr(item.categories),
+			ImageUrl:    ptr(item.imageURL),
+		})
+		assert.NilError(t, err)
+		assert.NilError(t, s.CreateFeedItem(ctx, store.CreateFeedItemParams{FeedID: feeds[item.feed], ItemID: id}))
+		_, err = s.DB.ExecContext(ctx, "UPDATE items SET created_at = ? WHERE id = ?", item.createdAt, id)
+		assert.NilError(t, err)
+	}
+	// Items created directly only have their categories as JSON.
+	_, err = s.BackfillItemCategories(ctx)
+	assert.NilError(t, err)
+	ruleID := uuid.NewString()
+	_, err = s.CreateItemBlockRule(ctx, store.CreateItemBlockRuleParams{ID: ruleID, RuleType: "keyword", RuleValue: "Four"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateItemBlock(ctx, store.CreateItemBlockParams{ItemID: ids["Four"], RuleID: ruleID}))
+
+	// list returns the sorted titles matching params, and checks that
+	// CountItems agrees.
+	list := func(t *testing.T, params store.StoreListItemsParams) []string {
+		t.Helper()
+		params.Limit = 10
+		rows, err := s.ListItems(ctx, params)
+		assert.NilError(t, err)
+		titles := make([]string, 0, len(rows))
+		for _, row := range rows {
+			titles = append(titles, *row.Title)
+		}
+		count, err := s.CountItems(ctx, store.StoreCountItemsParams{
+			FeedID:         params.FeedID,
+			IsRead:         params.IsRead,
+			IsStarred:      params.IsStarred,
+			TagID:          params.TagID,
+			Since:          params.Since,
+			Until:          params.Until,
+			PublishedSince: params.PublishedSince,
+			PublishedUntil: params.PublishedUntil,
+			Author:         params.Author,
+			Category:       params.Category,
+			HasImage:       params.HasImage,
+			FeedIDs:        params.FeedIDs,
+			TagIDs:         params.TagIDs,
+			IsBlocked:      params.IsBlocked,
+			HasEnclosure:   params.HasEnclosure,
+			MediaType:      params.MediaType,
+			SavedSearchID:  params.SavedSearchID,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, count, int64(len(titles)))
+		slices.Sort(titles)
+		return titles
+	}
+
+	for _, tc := range []struct {
+		name   string
+		params store.StoreListItemsParams
+		want   []string
+	}{
+		{"blocked items are included without IsBlocked", store.StoreListItemsParams{}, []string{"Four", "One", "Three", "Two"}},
+		{"blocked items are left out", store.StoreListItemsParams{IsBlocked: false}, []string{"One", "Three", "Two"}},
+		{"until", store.StoreListItemsParams{Since: "2026-01-05T00:00:00Z", Until: "2026-01-10T00:00:00Z"}, []string{"Three", "Two"}},
+		// Two has no publication date and falls back to when it was saved;
+		// Three was published at 2026-01-06T15:00:00Z.
+		{"published range", store.StoreListItemsParams{PublishedSince: "2026-01-05T00:00:00Z", PublishedUntil: "2026-01-07T00:00:00Z"}, []string{"Three", "Two"}},
+		{"author", store.StoreListItemsParams{Author: "alice"}, []string{"Four", "One"}},
+		{"category", store.StoreListItemsParams{Category: "GO"}, []string{"Four", "One"}},
+		{"has image", store.StoreListItemsParams{HasImage: int64(1)}, []string{"Four", "One"}},
+		{"has no image", store.StoreListItemsParams{HasImage: int64(0)}, []string{"Three", "Two"}},
+		{"feeds", store.StoreListItemsParams{FeedIDs: []string{feeds["a"], feeds["b"]}}, []string{"One", "Two"}},
+		{"feeds or tags", store.StoreListItemsParams{FeedIDs: []string{feeds["a"]}, TagIDs: []string{tagID}, IsBlocked: false}, []string{"One", "Three"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.DeepEqual(t, list(t, tc.params), tc.want)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// FetchedItemIdentity returns the key identifying a fetched item within its feed:
+// its GUID, or its cleaned URL when the feed provides no GUID, or the hash of
+// its text when it has neither.
+func FetchedItemIdentity(guid *string, url string, title, description, content *string) string {
+	if guid != nil && strings.TrimSpace(*guid) != "" {
+		return "guid:" + strings.TrimSpace(*guid)
+	}
+	if url != "" {
+		return "url:" + url
+	}
+	return "hash:" + ItemContentHash(title, description, content)
+}
+
+// UpsertFetchedItem creates or updates the item matching the identity of
+// params in its feed. An item that is new to the feed reuses an item with
+// the same URL from another feed, so that an article published in several
+// feeds is stored once. params.Url must already be cleaned. It also returns
+// the item as it was before the update, or nil when a new item was created.
+func UpsertFetchedItem(ctx context.Context, q *Queries, params SaveFetchedItemParams) (Item, *Item, error) {
+	identity := FetchedItemIdentity(params.Guid, params.Url, params.Title, params.Description, params.Content)
+
+	itemID, err := q.GetItemIDByIdentity(ctx, GetItemIDByIdentityParams{FeedID: params.FeedID, Identity: identity})
+	if errors.Is(err, sql.ErrNoRows) && params.Url != "" {
+		itemID, err = q.FindSharedItemByURL(ctx, FindSharedItemByURLParams{Url: params.Url, FeedID: params.FeedID})
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Item{}, nil, fmt.Errorf("failed to resolve item identity: %w", err)
+	}
+
+	var previous *Item
+	if itemID == "" {
+		itemID = uuid.NewString()
+	} else {
+		saved, err := q.GetSavedItem(ctx, itemID)
+		if err != nil {
+			return Item{}, nil, fmt.Errorf("failed to get saved item: %w", err)
+		}
+		previous = &saved
+	}
+	item, err := q.CreateItem(ctx, CreateItemParams{
+		ID:              itemID,
+		Url:             params.Url,
+		Title:           params.Title,
+		Description:     params.Description,
+		PublishedAt:     params.PublishedAt,
+		Author:          params.Author,
+		Guid:            params.Guid,
+		Content:         params.Content,
+		ImageUrl:        params.ImageUrl,
+		Categories:      params.Categories,
+		DescriptionHtml: params.DescriptionHtml,
+		ContentHtml:     params.ContentHtml,
+	})
+	if err != nil {
+		return Item{}, nil, fmt.Errorf("failed to create/update item: %w", err)
+	}
+
+	err = q.CreateItemIdentity(ctx, CreateItemIdentityParams{
+		FeedID:   params.FeedID,
+		Identity: identity,
+		ItemID:   item.ID,
+	})
+	if err != nil {
+		return Item{}, nil, fmt.Errorf("failed to create item identity: %w", err)
+	}
+	return item, previous, nil
+}
+
+// BackfillItemIdentities records the identity of feed items saved before
+// items were identified per feed. Items already sharing an identity within a
+// feed are left as they are.
+func (s *Store) BackfillItemIdentities(ctx context.Context) (int, error) {
+	const batchSize = 500
+	var afterFeedID, afterItemID string
+	var total int
+	for {
+		rows, err := s.ListFeedItemsWithoutIdentity(ctx, ListFeedItemsWithoutIdentityParams{
+			AfterFeedID: afterFeedID,
+			AfterItemID: afterItemID,
+			Limit:       batchSize,
+		})
+		if err != nil {
+			return total, fmt.Errorf("failed to list feed items without identity: %w", err)
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+
+		err = s.WithTransaction(ctx, func(qtx *Queries) error {
+			for _, row := range rows {
+				err := qtx.CreateItemIdentity(ctx, CreateItemIdentityParams{
+					FeedID:   row.FeedID,
+					Identity: FetchedItemIdentity(row.Guid, row.Url, row.Title, row.Description, row.Content),
+					ItemID:   row.ID,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create item identity: %w", err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += len(rows)
+		last := rows[len(rows)-1]
+		afterFeedID, afterItemID = last.FeedID, last.ID
+	}
+}
@@ -0,0 +1,108 @@
+package store_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestStore_SaveFetchedItem_Identity(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+
+	for _, id := range []string{"changelog", "podcast", "blog", "planet"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: "https://example.com/" + id + ".xml"})
+		assert.NilError(t, err)
+	}
+	save := func(feedID, url, guid, title string) {
+		t.Helper()
+		params := store.SaveFetchedItemParams{FeedID: feedID, Url: url, Title: &title}
+		if guid != "" {
+			params.Guid = &guid
+		}
+		assert.NilError(t, s.SaveFetchedItem(ctx, params))
+	}
+	titles := func(feedID string) []string {
+		t.Helper()
+		items, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: feedID, Limit: 10})
+		assert.NilError(t, err)
+		result := make([]string, 0, len(items))
+		for _, item := range items {
+			result = append(result, *item.Title)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	t.Run("entries sharing a link are kept apart by their guid", func(t *testing.T) {
+		save("changelog", "https://example.com/changelog", "v1", "Version 1")
+		save("changelog", "https://example.com/changelog", "v2", "Version 2")
+		save("changelog", "https://example.com/changelog", "v2", "Version 2 (fixed)")
+		assert.DeepEqual(t, titles("changelog"), []string{"Version 1", "Version 2 (fixed)"})
+	})
+
+	t.Run("a changed link keeps the item of its guid", func(t *testing.T) {
+		save("podcast", "https://example.com/ep1?session=a", "ep1", "Episode 1")
+		save("podcast", "https://example.com/ep1?session=b", "ep1", "Episode 1")
+		items, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: "podcast", Limit: 10})
+		assert.NilError(t, err)
+		assert.Equal(t, len(items), 1)
+		assert.Equal(t, items[0].Url, "https://example.com/ep1?session=b")
+	})
+
+	t.Run("items without guid or link are identified by their text", func(t *testing.T) {
+		save("blog", "", "", "Note 1")
+		save("blog", "", "", "Note 2")
+		save("blog", "", "", "Note 1")
+		assert.Equal(t, len(titles("blog")), 2)
+	})
+
+	t.Run("an article in several feeds is stored once", func(t *testing.T) {
+		save("blog", "https://example.com/article", "blog-1", "Article")
+		save("planet", "https://example.com/article", "planet-9", "Article")
+		items, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 100})
+		assert.NilError(t, err)
+		var count int
+		for _, item := range items {
+			if item.Url == "https://example.com/article" {
+				count++
+			}
+		}
+		assert.Equal(t, count, 1)
+		assert.DeepEqual(t, titles("planet"), []string{"Article"})
+	})
+}
+
+func TestStore_BackfillItemIdentities(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+	guid := "post-1"
+	for _, params := range []store.CreateItemParams{
+		{ID: "item-1", Url: "https://example.com/post-1", Guid: &guid},
+		{ID: "item-2", Url: "https://example.com/post-2"},
+	} {
+		_, err := s.CreateItem(ctx, params)
+		assert.NilError(t, err)
+		assert.NilError(t, s.CreateFeedItem(ctx, store.CreateFeedItemParams{FeedID: "feed-1", ItemID: params.ID}))
+	}
+
+	count, err := s.BackfillItemIdentities(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 2)
+	count, err = s.BackfillItemIdentities(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 0)
+
+	itemID, err := s.GetItemIDByIdentity(ctx, store.GetItemIDByIdentityParams{FeedID: "feed-1", Identity: "guid:post-1"})
+	assert.NilError(t, err)
+	assert.Equal(t, itemID, "item-1")
+	itemID, err = s.GetItemIDByIdentity(ctx, store.GetItemIDByIdentityParams{FeedID: "feed-1", Identity: "url:https://example.com/post-2"})
+	assert.NilError(t, err)
+	assert.Equal(t, itemID, "item-2")
+}
@@ -0,0 +1,223 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// The item list queries are written by hand so that ListItems and CountItems
+// share one filter, and the orders of ListItems differ only in their sort key.
+
+// ListItemsRow is an item as listed by ListItems. Description is cut to its
+// first 140 characters, and HybridDate is the publication date of the item,
+// or the time it was saved when it has none.
+type ListItemsRow struct {
+	ID              string  `json:"id"`
+	Url             string  `json:"url"`
+	Title           *string `json:"title"`
+	Description     string  `json:"description"`
+	PublishedAt     *string `json:"published_at"`
+	Author          *string `json:"author"`
+	Guid            *string `json:"guid"`
+	Content         *string `json:"content"`
+	ImageUrl        *string `json:"image_url"`
+	Categories      *string `json:"categories"`
+	CreatedAt       string  `json:"created_at"`
+	RevisedAt       *string `json:"revised_at"`
+	DescriptionHtml *string `json:"description_html"`
+	ContentHtml     *string `json:"content_html"`
+	FeedID          string  `json:"feed_id"`
+	IsRead          int64   `json:"is_read"`
+	IsStarred       int64   `json:"is_starred"`
+	StarredAt       *string `json:"starred_at"`
+	Note            *string `json:"note"`
+	UserTags        *string `json:"user_tags"`
+	HybridDate      string  `json:"hybrid_date"`
+}
+
+// itemHybridDate is the sort key of the published orders. It matches
+// idx_items_hybrid_date_id, as the created orders match
+// idx_items_created_at_id.
+const itemHybridDate = "strftime('%FT%TZ', datetime(COALESCE(i.published_at, i.created_at)))"
+
+const listItems = `
+SELECT
+  i.id,
+  i.url,
+  i.title,
+  CAST(COALESCE(SUBSTR(i.description, 1, 140), '') AS TEXT) AS description,
+  i.published_at,
+  i.author,
+  i.guid,
+  i.content,
+  i.image_url,
+  i.categories,
+  i.created_at,
+  i.revised_at,
+  i.description_html,
+  i.content_html,
+  CAST((SELECT fi.feed_id FROM feed_items fi WHERE fi.item_id = i.id LIMIT 1) AS TEXT) AS feed_id,
+  CAST(COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) AS INTEGER) AS is_read,
+  CAST(COALESCE(st.is_starred, 0) AS INTEGER) AS is_starred,
+  st.starred_at,
+  st.note,
+  st.user_tags,
+  CAST(` + itemHybridDate + ` AS TEXT) AS hybrid_date
+FROM
+  items i
+LEFT JOIN
+  item_stars st ON i.id = st.item_id
+` + itemFilter
+
+const countItems = `
+SELECT
+  COUNT(*)
+FROM
+  items i
+LEFT JOIN
+  item_stars st ON i.id = st.item_id
+` + itemFilter
+
+// itemFilter is the WHERE clause of the item list queries. Its parameters are
+// the arguments returned by itemFilterArgs.
+const itemFilter = `WHERE
+  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id) AND
+  (?1 IS NULL OR EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id AND fi.feed_id = ?1)) AND
+  (?2 IS NULL OR COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) = ?2) AND
+  (?3 IS NULL OR COALESCE(st.is_starred, 0) = ?3) AND
+  (?4 IS NULL OR EXISTS (
+    SELECT 1 FROM feed_items fi
+    JOIN feed_tags ft ON fi.feed_id = ft.feed_id
+    WHERE fi.item_id = i.id AND ft.tag_id = ?4
+  )) AND
+  (?5 IS NULL OR i.created_at >= ?5) AND
+  (?6 IS NULL OR i.created_at < ?6) AND
+  (?7 IS NULL OR ` + itemHybridDate + ` >= ?7) AND
+  (?8 IS NULL OR ` + itemHybridDate + ` < ?8) AND
+  (?9 IS NULL OR i.author = ?9 COLLATE NOCASE) AND
+  (?10 IS NULL OR EXISTS (
+    SELECT 1 FROM item_categories ic
+    JOIN categories c ON c.id = ic.category_id
+    WHERE ic.item_id = i.id AND c.name = ?10
+  )) AND
+  (?11 IS NULL OR (CASE WHEN COALESCE(i.image_url, '') != '' THEN 1 ELSE 0 END = ?11)) AND
+  -- ?12 and ?13 are JSON arrays of feed and tag IDs; an item matches when it
+  -- belongs to one of the feeds or to a feed with one of the tags.
+  (
+    (?12 IS NULL AND ?13 IS NULL) OR
+    EXISTS (
+      SELECT 1 FROM feed_items ofi
+      WHERE ofi.item_id = i.id AND (
+        ofi.feed_id IN (SELECT value FROM json_each(?12)) OR
+        EXISTS (
+          SELECT 1 FROM feed_tags oft
+          WHERE oft.feed_id = ofi.feed_id AND oft.tag_id IN (SELECT value FROM json_each(?13))
+        )
+      )
+    )
+  ) AND
+  (?14 IS NULL OR (CASE WHEN EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = i.id) THEN 1 ELSE 0 END = ?14)) AND
+  (?15 IS NULL OR (CASE WHEN EXISTS (SELECT 1 FROM item_enclosures ie WHERE ie.item_id = i.id) THEN 1 ELSE 0 END = ?15)) AND
+  (?16 IS NULL OR EXISTS (
+    SELECT 1 FROM item_enclosures ie
+    WHERE ie.item_id = i.id AND (ie.mime_type = ?16 OR ie.mime_type LIKE ?16 || '/%')
+  )) AND
+  (?17 IS NULL OR EXISTS (
+    SELECT 1 FROM saved_search_items ssi WHERE ssi.saved_search_id = ?17 AND ssi.item_id = i.id
+  ))`
+
+// itemFilterArgs returns the arguments of itemFilter.
+func itemFilterArgs(params StoreCountItemsParams) []interface{} {
+	return []interface{}{
+		params.FeedID,
+		params.IsRead,
+		params.IsStarred,
+		params.TagID,
+		params.Since,
+		params.Until,
+		params.PublishedSince,
+		params.PublishedUntil,
+		params.Author,
+		params.Category,
+		params.HasImage,
+		jsonIDs(params.FeedIDs),
+		jsonIDs(params.TagIDs),
+		params.IsBlocked,
+		params.HasEnclosure,
+		params.MediaType,
+		params.SavedSearchID,
+	}
+}
+
+// listItemsQuery returns the list query for order: the sort key, the keyset
+// comparison continuing from a cursor, and the direction of the ORDER BY.
+func listItemsQuery(order ItemOrder) (string, error) {
+	key, cmp, dir := "i.created_at", ">", "ASC"
+	switch order {
+	case "", ItemOrderCreatedAsc:
+	case ItemOrderCreatedDesc:
+		cmp, dir = "<", "DESC"
+	case ItemOrderPublishedAsc:
+		key = itemHybridDate
+	case ItemOrderPublishedDesc:
+		key, cmp, dir = itemHybridDate, "<", "DESC"
+	default:
+		return "", fmt.Errorf("unknown item order %q", order)
+	}
+	return listItems + ` AND
+  (
+    (?18 IS NULL AND ?19 IS NULL) OR
+    (` + key + `, i.id) ` + cmp + ` (?18, ?19)
+  )
+ORDER BY
+  ` + key + ` ` + dir + `,
+  i.id ` + dir + `
+LIMIT ?20`, nil
+}
+
+func (s *Store) listItems(ctx context.Context, order ItemOrder, filter StoreCountItemsParams, dateCursor, idCursor interface{}, limit int64) ([]ListItemsRow, error) {
+	query, err := listItemsQuery(order)
+	if err != nil {
+		return nil, err
+	}
+	args := append(itemFilterArgs(filter), dateCursor, idCursor, limit)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var items []ListItemsRow
+	for rows.Next() {
+		var i ListItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Title,
+			&i.Description,
+			&i.PublishedAt,
+			&i.Author,
+			&i.Guid,
+			&i.Content,
+			&i.ImageUrl,
+			&i.Categories,
+			&i.CreatedAt,
+			&i.RevisedAt,
+			&i.DescriptionHtml,
+			&i.ContentHtml,
+			&i.FeedID,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.StarredAt,
+			&i.Note,
+			&i.UserTags,
+			&i.HybridDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
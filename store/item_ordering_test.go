@@ -107,6 +107,91 @@ func TestStore_ItemOrdering(t *testing.T) {
 	})
 }
 
+func TestStore_ItemOrders(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	feedID := uuid.NewString()
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: feedID, Url: "http://orders.example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	// Hybrid dates: A 2026-02-01, B 2025-01-01, C 2026-01-03 (saved),
+	// D 2026-01-02T15:00:00Z (published with an offset), E 2026-01-03 (saved,
+	// tied with C).
+	items := []struct {
+		title       string
+		publishedAt string
+		createdAt   string
+	}{
+		{"A", "2026-02-01T00:00:00Z", "2026-01-01T00:00:00Z"},
+		{"B", "2025-01-01T00:00:00Z", "2026-01-02T00:00:00Z"},
+		{"C", "", "2026-01-03T00:00:00Z"},
+		{"D", "2026-01-03T00:00:00+09:00", "2026-01-04T00:00:00Z"},
+		{"E", "", "2026-01-03T00:00:00Z"},
+	}
+	ids := make(map[string]string)
+	for _, item := range items {
+		id := uuid.NewString()
+		ids[item.title] = id
+		var publishedAt *string
+		if item.publishedAt != "" {
+			publishedAt = &item.publishedAt
+		}
+		_, err := s.CreateItem(ctx, store.CreateItemParams{ID: id, Url: "http://orders.example.com/" + item.title, Title: &item.title, PublishedAt: publishedAt})
+		assert.NilError(t, err)
+		assert.NilError(t, s.CreateFeedItem(ctx, store.CreateFeedItemParams{FeedID: feedID, ItemID: id}))
+		_, err = s.DB.ExecContext(ctx, "UPDATE items SET created_at = ? WHERE id = ?", item.createdAt, id)
+		assert.NilError(t, err)
+	}
+	// C and E tie on both dates, so they are ordered by ID.
+	tied := []string{"C", "E"}
+	if ids["E"] < ids["C"] {
+		tied = []string{"E", "C"}
+	}
+
+	// listAll pages through an order two items at a time.
+	listAll := func(t *testing.T, order store.ItemOrder) []string {
+		t.Helper()
+		params := store.StoreListItemsParams{Order: order, Limit: 2}
+		var titles []string
+		for {
+			rows, err := s.ListItems(ctx, params)
+			assert.NilError(t, err)
+			for _, row := range rows {
+				titles = append(titles, *row.Title)
+			}
+			if len(rows) < 2 {
+				return titles
+			}
+			last := rows[len(rows)-1]
+			params.IDCursor = last.ID
+			if order.IsPublished() {
+				params.HybridDateCursor = last.HybridDate
+			} else {
+				params.CreatedAtCursor = last.CreatedAt
+			}
+		}
+	}
+
+	createdAsc := append(append([]string{"A", "B"}, tied...), "D")
+	assert.DeepEqual(t, listAll(t, store.ItemOrderCreatedAsc), createdAsc)
+	assert.DeepEqual(t, listAll(t, store.ItemOrderCreatedDesc), reversed(createdAsc))
+	publishedAsc := append(append([]string{"B", "D"}, tied...), "A")
+	assert.DeepEqual(t, listAll(t, store.ItemOrderPublishedAsc), publishedAsc)
+	assert.DeepEqual(t, listAll(t, store.ItemOrderPublishedDesc), reversed(publishedAsc))
+
+	_, err = store.ParseItemOrder("newest")
+	assert.ErrorContains(t, err, "order must be")
+}
+
+func reversed(values []string) []string {
+	result := make([]string, 0, len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		result = append(result, values[i])
+	}
+	return result
+}
+
 func TestStore_ItemOrdering_PBT(t *testing.T) {
 	rapid.Check(t, func(t *rapid.T) {
 		s := setupStoreForRapid(t)
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nakatanakatana/feed-reader/internal/textdiff"
+)
+
+// SignificantChangeRatio is the share of changed words in the description and
+// content above which a revision marks an item unread again.
+const SignificantChangeRatio = 0.1
+
+// ItemContentHash identifies the publisher-provided text of an item.
+func ItemContentHash(title, description, content *string) string {
+	h := sha256.New()
+	for _, v := range []*string{title, description, content} {
+		if v != nil {
+			h.Write([]byte(*v))
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// RecordItemRevisionParams describe an item that was just saved from a feed.
+type RecordItemRevisionParams struct {
+	FeedID string
+	// Previous is the item as it was before it was saved, or nil for a new
+	// item.
+	Previous *Item
+	Item     Item
+	// SourceHash is the ItemContentHash of the text the feed sent before it
+	// was converted. When empty, the saved text is hashed instead.
+	SourceHash       string
+	UnreadOnRevision bool
+}
+
+// RecordItemRevision records a revision of a saved item when its text differs
+// from the latest revision. Items are compared by their source hash, so that
+// converter changes are not taken for revisions. An item shared by several
+// feeds is only revised by the feed its latest revision was saved from, as
+// the others may publish it with different text.
+//
+// The first save only records the hash as the baseline, and the text of the
+// baseline is kept once the item is revised. A later revision sets revised_at
+// and, when UnreadOnRevision is set and the change is significant, marks the
+// item unread again. It reports whether the item was revised.
+func RecordItemRevision(ctx context.Context, q *Queries, params RecordItemRevisionParams) (bool, error) {
+	item, previous := params.Item, params.Previous
+	hash := params.SourceHash
+	if hash == "" {
+		hash = ItemContentHash(item.Title, item.Description, item.Content)
+	}
+
+	latest, err := q.GetLatestItemRevision(ctx, item.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		err := q.CreateItemRevision(ctx, CreateItemRevisionParams{ItemID: item.ID, Revision: 1, ContentHash: hash, FeedID: &params.FeedID})
+		if err != nil {
+			return false, fmt.Errorf("failed to create item revision: %w", err)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get latest item revision: %w", err)
+	}
+	if latest.FeedID != nil && *latest.FeedID != params.FeedID {
+		return false, nil
+	}
+	if latest.ContentHash == hash {
+		return false, nil
+	}
+	if params.SourceHash != "" && latest.ContentHash == ItemContentHash(item.Title, item.Description, item.Content) {
+		// Revisions recorded before source hashes were kept hash the saved
+		// text. While it is unchanged, the source hash becomes the baseline.
+		err := q.UpdateItemRevisionHash(ctx, UpdateItemRevisionHashParams{ContentHash: hash, ItemID: item.ID, Revision: latest.Revision})
+		if err != nil {
+			return false, fmt.Errorf("failed to update item revision hash: %w", err)
+		}
+		return false, nil
+	}
+	if latest.FeedID == nil {
+		// Revisions recorded before their feed was kept may come from any
+		// feed sharing the item, so the saving feed takes over from them.
+		err := q.ClaimItemRevision(ctx, ClaimItemRevisionParams{FeedID: &params.FeedID, ContentHash: hash, ItemID: item.ID, Revision: latest.Revision})
+		if err != nil {
+			return false, fmt.Errorf("failed to claim item revision: %w", err)
+		}
+		return false, nil
+	}
+
+	if latest.Title == nil && latest.Description == nil && latest.Content == nil && previous != nil {
+		err := q.UpdateItemRevisionText(ctx, UpdateItemRevisionTextParams{
+			Title:       previous.Title,
+			Description: previous.Description,
+			Content:     previous.Content,
+			ItemID:      item.ID,
+			Revision:    latest.Revision,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to keep item revision text: %w", err)
+		}
+		latest.Title, latest.Description, latest.Content = previous.Title, previous.Description, previous.Content
+	}
+	err = q.CreateItemRevision(ctx, CreateItemRevisionParams{
+		ItemID:      item.ID,
+		Revision:    latest.Revision + 1,
+		ContentHash: hash,
+		Title:       item.Title,
+		Description: item.Description,
+		Content:     item.Content,
+		FeedID:      &params.FeedID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create item revision: %w", err)
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	if err := q.MarkItemRevised(ctx, MarkItemRevisedParams{ID: item.ID, RevisedAt: &now}); err != nil {
+		return false, fmt.Errorf("failed to mark item revised: %w", err)
+	}
+	if params.UnreadOnRevision && IsSignificantRevision(latest, item) {
+		if err := q.MarkItemUnread(ctx, item.ID); err != nil {
+			return false, fmt.Errorf("failed to mark revised item unread: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// IsSignificantRevision reports whether item changed enough since the previous
+// revision to be worth reading again: its title changed, or more than
+// SignificantChangeRatio of the words of its description and content did.
+// Whitespace-only changes are never significant.
+func IsSignificantRevision(previous ItemRevision, item Item) bool {
+	if strings.Join(strings.Fields(derefString(previous.Title)), " ") != strings.Join(strings.Fields(derefString(item.Title)), " ") {
+		return true
+	}
+	before := derefString(previous.Description) + "\n" + derefString(previous.Content)
+	after := derefString(item.Description) + "\n" + derefString(item.Content)
+	return textdiff.ChangeRatio(before, after) > SignificantChangeRatio
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
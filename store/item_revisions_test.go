@@ -0,0 +1,196 @@
+package store_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestStore_SaveFetchedItem_Revisions(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+
+	feedURL := "https://example.com/feed.xml"
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: feedURL})
+	assert.NilError(t, err)
+
+	itemURL := "https://example.com/post"
+	body := strings.Repeat("word ", 50)
+	save := func(title, content string) store.GetItemRow {
+		t.Helper()
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+			FeedID:  "feed-1",
+			Url:     itemURL,
+			Title:   &title,
+			Content: &content,
+		}))
+		items, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: "feed-1", Limit: 10})
+		assert.NilError(t, err)
+		assert.Equal(t, len(items), 1)
+		item, err := s.GetItem(ctx, items[0].ID)
+		assert.NilError(t, err)
+		return item
+	}
+	markRead := func(itemID string) {
+		t.Helper()
+		_, err := s.SetItemRead(ctx, store.SetItemReadParams{ItemID: itemID, IsRead: 1})
+		assert.NilError(t, err)
+	}
+
+	item := save("Post", body)
+	assert.Assert(t, item.RevisedAt == nil)
+	revisions, err := s.ListItemRevisions(ctx, item.ID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(revisions), 1)
+	assert.Equal(t, revisions[0].Revision, int64(1))
+	assert.Assert(t, revisions[0].Title == nil && revisions[0].Content == nil)
+
+	t.Run("unchanged items are not revised", func(t *testing.T) {
+		item := save("Post", body)
+		assert.Assert(t, item.RevisedAt == nil)
+		revisions, err := s.ListItemRevisions(ctx, item.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(revisions), 1)
+	})
+
+	t.Run("changes are recorded without the policy", func(t *testing.T) {
+		markRead(item.ID)
+		item := save("Post (updated)", body)
+		assert.Assert(t, item.RevisedAt != nil)
+		assert.Equal(t, item.IsRead, int64(1))
+		revisions, err := s.ListItemRevisions(ctx, item.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(revisions), 2)
+		assert.Equal(t, revisions[0].Revision, int64(2))
+		assert.Equal(t, *revisions[0].Title, "Post (updated)")
+		// The baseline keeps the text it was revised from.
+		assert.Equal(t, *revisions[1].Title, "Post")
+		assert.Equal(t, *revisions[1].Content, body)
+	})
+
+	assert.NilError(t, s.SetFeedUnreadOnRevision(ctx, store.SetFeedUnreadOnRevisionParams{ID: "feed-1", UnreadOnRevision: 1}))
+
+	t.Run("minor changes keep the item read", func(t *testing.T) {
+		markRead(item.ID)
+		item := save("Post (updated)", body+"typo")
+		assert.Equal(t, item.IsRead, int64(1))
+		revisions, err := s.ListItemRevisions(ctx, item.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(revisions), 3)
+	})
+
+	t.Run("significant changes mark the item unread", func(t *testing.T) {
+		markRead(item.ID)
+		item := save("Post (updated)", body+"typo "+strings.Repeat("correction ", 20))
+		assert.Equal(t, item.IsRead, int64(0))
+		revisions, err := s.ListItemRevisions(ctx, item.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(revisions), 4)
+	})
+}
+
+func TestStore_SaveFetchedItem_SourceHashRevisions(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: "feed-1", Url: "https://example.com/feed.xml"})
+	assert.NilError(t, err)
+
+	title, source := "Post", "<p>Body</p>"
+	sourceHash := store.ItemContentHash(&title, nil, &source)
+	save := func(content, sourceHash string) []store.ItemRevision {
+		t.Helper()
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+			FeedID:     "feed-1",
+			Url:        "https://example.com/post",
+			Title:      &title,
+			Content:    &content,
+			SourceHash: sourceHash,
+		}))
+		items, err := s.ListItems(ctx, store.StoreListItemsParams{FeedID: "feed-1", Limit: 10})
+		assert.NilError(t, err)
+		assert.Equal(t, len(items), 1)
+		revisions, err := s.ListItemRevisions(ctx, items[0].ID)
+		assert.NilError(t, err)
+		return revisions
+	}
+
+	// A baseline hashing the saved text moves to the source hash.
+	save("Body", "")
+	revisions := save("Body", sourceHash)
+	assert.Equal(t, len(revisions), 1)
+	assert.Equal(t, revisions[0].ContentHash, sourceHash)
+
+	t.Run("converter changes are not revisions", func(t *testing.T) {
+		revisions := save("**Body**", sourceHash)
+		assert.Equal(t, len(revisions), 1)
+	})
+
+	t.Run("source changes are revisions", func(t *testing.T) {
+		source := "<p>New body</p>"
+		revisions := save("New body", store.ItemContentHash(&title, nil, &source))
+		assert.Equal(t, len(revisions), 2)
+	})
+}
+
+func TestStore_SaveFetchedItem_SharedItemRevisions(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+	for _, id := range []string{"feed-1", "feed-2"} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: "https://example.com/" + id + ".xml"})
+		assert.NilError(t, err)
+		assert.NilError(t, s.SetFeedUnreadOnRevision(ctx, store.SetFeedUnreadOnRevisionParams{ID: id, UnreadOnRevision: 1}))
+	}
+
+	title := "Post"
+	save := func(feedID, content string) {
+		t.Helper()
+		assert.NilError(t, s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+			FeedID:  feedID,
+			Url:     "https://example.com/post",
+			Title:   &title,
+			Content: &content,
+		}))
+	}
+	save("feed-1", "The full text of the post, as the first feed publishes it.")
+	items, err := s.ListItems(ctx, store.StoreListItemsParams{Limit: 10})
+	assert.NilError(t, err)
+	assert.Equal(t, len(items), 1)
+	itemID := items[0].ID
+	_, err = s.SetItemRead(ctx, store.SetItemReadParams{ItemID: itemID, IsRead: 1})
+	assert.NilError(t, err)
+
+	// The feeds publish the item with different text on every poll.
+	for range 3 {
+		save("feed-2", "A short summary.")
+		save("feed-1", "The full text of the post, as the first feed publishes it.")
+	}
+	revisions, err := s.ListItemRevisions(ctx, itemID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(revisions), 1)
+	item, err := s.GetItem(ctx, itemID)
+	assert.NilError(t, err)
+	assert.Equal(t, item.IsRead, int64(1))
+	assert.Assert(t, item.RevisedAt == nil)
+
+	t.Run("the feed of the baseline still revises the item", func(t *testing.T) {
+		save("feed-1", "The post was rewritten entirely, and the first feed publishes the new text.")
+		revisions, err := s.ListItemRevisions(ctx, itemID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(revisions), 2)
+		assert.Equal(t, *revisions[0].FeedID, "feed-1")
+	})
+}
+
+func TestIsSignificantRevision(t *testing.T) {
+	str := func(s string) *string { return &s }
+	text := strings.Repeat("lorem ipsum dolor sit amet ", 5)
+	previous := store.ItemRevision{Title: str("Title"), Content: str(text)}
+
+	assert.Assert(t, !store.IsSignificantRevision(previous, store.Item{Title: str(" Title "), Content: str(text + "\n")}))
+	assert.Assert(t, !store.IsSignificantRevision(previous, store.Item{Title: str("Title"), Content: str(strings.Replace(text, "dolor", "dolore", 1))}))
+	assert.Assert(t, store.IsSignificantRevision(previous, store.Item{Title: str("New title"), Content: str(text)}))
+	assert.Assert(t, store.IsSignificantRevision(previous, store.Item{Title: str("Title"), Content: str(text + strings.Repeat("consectetur ", 5))}))
+}
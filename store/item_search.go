@@ -0,0 +1,164 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// The search queries are written by hand because sqlc cannot parse FTS5
+// MATCH expressions or the functions of FTS5 tables.
+
+// SearchHighlightStart and SearchHighlightEnd surround matches in the
+// highlights and snippets of search results. They are private use
+// characters, so that callers can escape the text before marking matches.
+const (
+	SearchHighlightStart = "\ue000"
+	SearchHighlightEnd   = "\ue001"
+)
+
+// searchSnippetTokens is the number of tokens in a search snippet.
+const searchSnippetTokens = 24
+
+const searchItems = `
+SELECT
+  i.id,
+  i.url,
+  i.title,
+  CAST(COALESCE(SUBSTR(i.description, 1, 140), '') AS TEXT) AS description,
+  i.published_at,
+  i.author,
+  i.guid,
+  i.content,
+  i.image_url,
+  i.categories,
+  i.created_at,
+  i.revised_at,
+  i.description_html,
+  i.content_html,
+  CAST((SELECT fi.feed_id FROM feed_items fi WHERE fi.item_id = i.id LIMIT 1) AS TEXT) AS feed_id,
+  CAST(COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) AS INTEGER) AS is_read,
+  CAST(COALESCE(st.is_starred, 0) AS INTEGER) AS is_starred,
+  st.starred_at,
+  st.note,
+  st.user_tags,
+  CAST(strftime('%FT%TZ', datetime(COALESCE(i.published_at, i.created_at))) AS TEXT) AS hybrid_date,
+  COALESCE(highlight(items_fts, 0, char(57344), char(57345)), '') AS title_highlight,
+  COALESCE(snippet(items_fts, -1, char(57344), char(57345), '…', ?1), '') AS snippet,
+  -bm25(items_fts, 10.0, 2.0, 1.0) AS score
+FROM
+  items_fts
+  JOIN items i ON i.rowid = items_fts.rowid
+  LEFT JOIN item_stars st ON st.item_id = i.id
+WHERE
+  items_fts MATCH ?2 AND
+  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id) AND
+  NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = i.id) AND
+  (?3 IS NULL OR EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id AND fi.feed_id = ?3)) AND
+  (?4 IS NULL OR COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) = ?4) AND
+  (?5 IS NULL OR EXISTS (
+    SELECT 1 FROM feed_items fi
+    JOIN feed_tags ft ON fi.feed_id = ft.feed_id
+    WHERE fi.item_id = i.id AND ft.tag_id = ?5
+  ))
+ORDER BY
+  score DESC,
+  i.id ASC
+LIMIT ?6 OFFSET ?7
+`
+
+// SearchItemsParams filters a search. Match is an FTS5 query, as returned by
+// ParseSearchQuery.
+type SearchItemsParams struct {
+	Match  string
+	FeedID interface{}
+	IsRead interface{}
+	TagID  interface{}
+	Limit  int64
+	Offset int64
+}
+
+// SearchItemsRow is an item matching a search. TitleHighlight is the title
+// with matches marked, and Snippet the best matching fragment of the title,
+// description or content; both use SearchHighlightStart and
+// SearchHighlightEnd. Score is higher for more relevant items.
+type SearchItemsRow struct {
+	ListItemsRow
+	TitleHighlight string
+	Snippet        string
+	Score          float64
+}
+
+// SearchItems returns the items matching a full-text search, most relevant
+// first. Blocked items are left out.
+func (s *Store) SearchItems(ctx context.Context, params SearchItemsParams) ([]SearchItemsRow, error) {
+	rows, err := s.db.QueryContext(ctx, searchItems,
+		searchSnippetTokens,
+		params.Match,
+		params.FeedID,
+		params.IsRead,
+		params.TagID,
+		params.Limit,
+		params.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var items []SearchItemsRow
+	for rows.Next() {
+		var i SearchItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Title,
+			&i.Description,
+			&i.PublishedAt,
+			&i.Author,
+			&i.Guid,
+			&i.Content,
+			&i.ImageUrl,
+			&i.Categories,
+			&i.CreatedAt,
+			&i.RevisedAt,
+			&i.DescriptionHtml,
+			&i.ContentHtml,
+			&i.FeedID,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.StarredAt,
+			&i.Note,
+			&i.UserTags,
+			&i.HybridDate,
+			&i.TitleHighlight,
+			&i.Snippet,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// BackfillItemSearchIndex rebuilds the search index when it does not hold
+// every item, as in databases created before items were indexed. It reports
+// whether the index was rebuilt.
+func (s *Store) BackfillItemSearchIndex(ctx context.Context) (bool, error) {
+	var items, indexed int64
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM items").Scan(&items); err != nil {
+		return false, fmt.Errorf("failed to count items: %w", err)
+	}
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM items_fts_docsize").Scan(&indexed); err != nil {
+		return false, fmt.Errorf("failed to count indexed items: %w", err)
+	}
+	if items == indexed {
+		return false, nil
+	}
+	if _, err := s.db.ExecContext(ctx, "INSERT INTO items_fts (items_fts) VALUES ('rebuild')"); err != nil {
+		return false, fmt.Errorf("failed to rebuild the search index: %w", err)
+	}
+	return true, nil
+}
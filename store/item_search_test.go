@@ -0,0 +1,170 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func createSearchTestItem(t *testing.T, s *store.Store, ctx context.Context, feedID, url, title, description, content string) string {
+	t.Helper()
+	guid := uuid.NewString()
+	err := s.SaveFetchedItem(ctx, store.SaveFetchedItemParams{
+		FeedID:      feedID,
+		Url:         url,
+		Title:       &title,
+		Description: &description,
+		Content:     &content,
+		Guid:        &guid,
+	})
+	assert.NilError(t, err)
+
+	var id string
+	err = s.DB.QueryRowContext(ctx, "SELECT id FROM items WHERE url = ?", url).Scan(&id)
+	assert.NilError(t, err)
+	return id
+}
+
+func searchTitles(t *testing.T, s *store.Store, params store.SearchItemsParams) []string {
+	t.Helper()
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+	rows, err := s.SearchItems(context.Background(), params)
+	assert.NilError(t, err)
+	titles := make([]string, 0, len(rows))
+	for _, row := range rows {
+		titles = append(titles, *row.Title)
+	}
+	return titles
+}
+
+func TestStore_SearchItems(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	feedA := uuid.NewString()
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: feedA, Url: "http://example.com/a.xml"})
+	assert.NilError(t, err)
+	feedB := uuid.NewString()
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: feedB, Url: "http://example.com/b.xml"})
+	assert.NilError(t, err)
+	tagID := uuid.NewString()
+	_, err = s.CreateTag(ctx, store.CreateTagParams{ID: tagID, Name: "tech"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateFeedTag(ctx, store.CreateFeedTagParams{FeedID: feedB, TagID: tagID}))
+
+	goTitle := createSearchTestItem(t, s, ctx, feedA, "http://example.com/1", "Go generics", "A tour of type parameters", "")
+	goBody := createSearchTestItem(t, s, ctx, feedA, "http://example.com/2", "Weekly notes", "Links", "Some notes about Go and Rust")
+	rust := createSearchTestItem(t, s, ctx, feedB, "http://example.com/3", "Rust ownership", "Borrowing explained", "")
+	_ = createSearchTestItem(t, s, ctx, feedB, "http://example.com/4", "Gardening", "Tomatoes and peppers", "")
+
+	search := func(query string) store.SearchItemsParams {
+		match, err := store.ParseSearchQuery(query)
+		assert.NilError(t, err)
+		return store.SearchItemsParams{Match: match}
+	}
+
+	t.Run("Title matches rank first", func(t *testing.T) {
+		assert.DeepEqual(t, searchTitles(t, s, search("go")), []string{"Go generics", "Weekly notes"})
+	})
+
+	t.Run("Boolean and prefix queries", func(t *testing.T) {
+		assert.DeepEqual(t, searchTitles(t, s, search("go -rust")), []string{"Go generics"})
+		assert.Assert(t, cmp.Len(searchTitles(t, s, search("generics OR ownership")), 2))
+		assert.DeepEqual(t, searchTitles(t, s, search("tomat*")), []string{"Gardening"})
+		assert.DeepEqual(t, searchTitles(t, s, search(`"type parameters"`)), []string{"Go generics"})
+		assert.Assert(t, cmp.Len(searchTitles(t, s, search(`"parameters type"`)), 0))
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		params := search("rust")
+		params.FeedID = feedA
+		assert.DeepEqual(t, searchTitles(t, s, params), []string{"Weekly notes"})
+
+		params = search("rust")
+		params.TagID = tagID
+		assert.DeepEqual(t, searchTitles(t, s, params), []string{"Rust ownership"})
+
+		_, err := s.SetItemRead(ctx, store.SetItemReadParams{ItemID: goBody, IsRead: 1})
+		assert.NilError(t, err)
+		params = search("go")
+		params.IsRead = int64(0)
+		assert.DeepEqual(t, searchTitles(t, s, params), []string{"Go generics"})
+		params.IsRead = int64(1)
+		assert.DeepEqual(t, searchTitles(t, s, params), []string{"Weekly notes"})
+	})
+
+	t.Run("Highlights and snippets", func(t *testing.T) {
+		rows, err := s.SearchItems(ctx, store.SearchItemsParams{Match: search("borrowing").Match, Limit: 10})
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Len(rows, 1))
+		assert.Equal(t, rows[0].ID, rust)
+		assert.Equal(t, rows[0].TitleHighlight, "Rust ownership")
+		assert.Equal(t, rows[0].Snippet, store.SearchHighlightStart+"Borrowing"+store.SearchHighlightEnd+" explained")
+		assert.Assert(t, rows[0].Score > 0)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		params := search("go OR rust")
+		params.Limit = 2
+		first := searchTitles(t, s, params)
+		params.Offset = 2
+		second := searchTitles(t, s, params)
+		assert.Assert(t, cmp.Len(first, 2))
+		assert.Assert(t, cmp.Len(second, 1))
+		assert.Assert(t, !cmp.Contains(first, second[0])().Success())
+	})
+
+	t.Run("Index follows updates and deletes", func(t *testing.T) {
+		title := "Go generics revisited"
+		description := "Constraints"
+		guid := uuid.NewString()
+		_, err := s.DB.ExecContext(ctx, "UPDATE items SET title = ?, description = ?, guid = ? WHERE id = ?", title, description, guid, goTitle)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, searchTitles(t, s, search("constraints")), []string{"Go generics revisited"})
+		assert.Assert(t, cmp.Len(searchTitles(t, s, search("tour")), 0))
+
+		_, err = s.DB.ExecContext(ctx, "DELETE FROM items WHERE id = ?", goTitle)
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Len(searchTitles(t, s, search("constraints")), 0))
+	})
+
+	t.Run("Blocked items are excluded", func(t *testing.T) {
+		ruleID := uuid.NewString()
+		_, err := s.CreateItemBlockRule(ctx, store.CreateItemBlockRuleParams{ID: ruleID, RuleType: "keyword", RuleValue: "rust"})
+		assert.NilError(t, err)
+		assert.NilError(t, s.CreateItemBlock(ctx, store.CreateItemBlockParams{ItemID: rust, RuleID: ruleID}))
+		assert.DeepEqual(t, searchTitles(t, s, search("ownership")), []string{})
+	})
+}
+
+func TestStore_BackfillItemSearchIndex(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	feedID := uuid.NewString()
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: feedID, Url: "http://example.com/feed.xml"})
+	assert.NilError(t, err)
+	_ = createSearchTestItem(t, s, ctx, feedID, "http://example.com/1", "Indexed item", "", "")
+
+	rebuilt, err := s.BackfillItemSearchIndex(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, !rebuilt)
+
+	// Empty the index, as in a database created before items were indexed.
+	_, err = s.DB.ExecContext(ctx, "INSERT INTO items_fts (items_fts) VALUES ('delete-all')")
+	assert.NilError(t, err)
+	match, err := store.ParseSearchQuery("indexed")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(searchTitles(t, s, store.SearchItemsParams{Match: match}), 0))
+
+	rebuilt, err = s.BackfillItemSearchIndex(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, rebuilt)
+	assert.DeepEqual(t, searchTitles(t, s, store.SearchItemsParams{Match: match}), []string{"Indexed item"})
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// StoreStarItemParams stars an item. Note and UserTags are left as they are
+// when nil; an empty note or tag list clears them.
+type StoreStarItemParams struct {
+	ItemID   string
+	Note     *string
+	UserTags []string
+}
+
+// StarItem stars an item, or updates the note and tags of a starred item.
+// It returns sql.ErrNoRows when the item does not exist.
+func (s *Store) StarItem(ctx context.Context, params StoreStarItemParams) (ItemStar, error) {
+	var star ItemStar
+	err := s.WithTransaction(ctx, func(qtx *Queries) error {
+		current, err := qtx.GetItemStar(ctx, params.ItemID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to get item star: %w", err)
+		}
+		note, userTags := current.Note, current.UserTags
+		if params.Note != nil {
+			note = nil
+			if trimmed := strings.TrimSpace(*params.Note); trimmed != "" {
+				note = &trimmed
+			}
+		}
+		if params.UserTags != nil {
+			userTags, err = encodeUserTags(params.UserTags)
+			if err != nil {
+				return err
+			}
+		}
+		starredAt := time.Now().UTC().Format(time.RFC3339)
+		star, err = qtx.StarItem(ctx, StarItemParams{
+			ItemID:    params.ItemID,
+			StarredAt: &starredAt,
+			Note:      note,
+			UserTags:  userTags,
+		})
+		return err
+	})
+	return star, err
+}
+
+// ListItemStars returns the star changes after a cursor or a time, oldest
+// first. Unstarred items are included with IsStarred 0.
+func (s *Store) ListItemStars(ctx context.Context, params ListItemStarsParams) ([]ListItemStarsRow, error) {
+	if (params.UpdatedAtCursor != nil && params.ItemIDCursor == nil) || (params.UpdatedAtCursor == nil && params.ItemIDCursor != nil) {
+		return nil, errors.New("both UpdatedAtCursor and ItemIDCursor must be provided together for pagination")
+	}
+	return s.Queries.ListItemStars(ctx, params)
+}
+
+// DecodeUserTags returns the user tags stored with a star.
+func DecodeUserTags(userTags *string) ([]string, error) {
+	if userTags == nil {
+		return []string{}, nil
+	}
+	var tags []string
+	if err := json.Unmarshal([]byte(*userTags), &tags); err != nil {
+		return nil, fmt.Errorf("invalid user tags: %w", err)
+	}
+	return tags, nil
+}
+
+func encodeUserTags(tags []string) (*string, error) {
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" && !slices.Contains(unique, tag) {
+			unique = append(unique, tag)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(unique)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode user tags: %w", err)
+	}
+	encoded := string(b)
+	return &encoded, nil
+}
@@ -0,0 +1,91 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestStore_ItemStars(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	feedID := uuid.NewString()
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: feedID, Url: "http://example.com/feed.xml"})
+	assert.NilError(t, err)
+	starred := createSearchTestItem(t, s, ctx, feedID, "http://example.com/1", "Starred", "", "")
+	_ = createSearchTestItem(t, s, ctx, feedID, "http://example.com/2", "Plain", "", "")
+
+	note := " Read later "
+	star, err := s.StarItem(ctx, store.StoreStarItemParams{ItemID: starred, Note: &note, UserTags: []string{"go", " go", "", "later"}})
+	assert.NilError(t, err)
+	assert.Equal(t, star.IsStarred, int64(1))
+	assert.Equal(t, *star.Note, "Read later")
+	tags, err := store.DecodeUserTags(star.UserTags)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, tags, []string{"go", "later"})
+	starredAt := *star.StarredAt
+
+	t.Run("Omitted fields are kept", func(t *testing.T) {
+		updated, err := s.StarItem(ctx, store.StoreStarItemParams{ItemID: starred, UserTags: []string{}})
+		assert.NilError(t, err)
+		assert.Equal(t, *updated.Note, "Read later")
+		assert.Assert(t, updated.UserTags == nil)
+		assert.Equal(t, *updated.StarredAt, starredAt)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		rows, err := s.ListItems(ctx, store.StoreListItemsParams{IsStarred: int64(1), Limit: 10})
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Len(rows, 1))
+		assert.Equal(t, rows[0].ID, starred)
+		assert.Equal(t, rows[0].IsStarred, int64(1))
+		assert.Equal(t, *rows[0].Note, "Read later")
+
+		count, err := s.CountItems(ctx, store.StoreCountItemsParams{IsStarred: int64(0)})
+		assert.NilError(t, err)
+		assert.Equal(t, count, int64(1))
+
+		isStarred := int64(1)
+		saved, err := s.CreateSavedSearch(ctx, uuid.NewString(), store.SavedSearchParams{Name: "Starred", IsStarred: &isStarred})
+		assert.NilError(t, err)
+		rows, err = s.ListItems(ctx, store.StoreListItemsParams{SavedSearchID: saved.ID, Limit: 10})
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Len(rows, 1))
+		assert.Equal(t, rows[0].ID, starred)
+	})
+
+	t.Run("Starred items cannot be deleted", func(t *testing.T) {
+		_, err := s.DB.ExecContext(ctx, "DELETE FROM items WHERE id = ?", starred)
+		assert.ErrorContains(t, err, "starred items cannot be deleted")
+	})
+
+	t.Run("Unstar and list changes", func(t *testing.T) {
+		_, err := s.UnstarItem(ctx, starred)
+		assert.NilError(t, err)
+
+		changes, err := s.ListItemStars(ctx, store.ListItemStarsParams{Limit: 10})
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Len(changes, 1))
+		assert.Equal(t, changes[0].ItemID, starred)
+		assert.Equal(t, changes[0].IsStarred, int64(0))
+		assert.Assert(t, changes[0].StarredAt == nil)
+		assert.Assert(t, changes[0].Note == nil)
+
+		_, err = s.DB.ExecContext(ctx, "DELETE FROM items WHERE id = ?", starred)
+		assert.NilError(t, err)
+	})
+
+	t.Run("Unknown item", func(t *testing.T) {
+		_, err := s.StarItem(ctx, store.StoreStarItemParams{ItemID: "missing"})
+		assert.Assert(t, errors.Is(err, sql.ErrNoRows))
+		_, err = s.UnstarItem(ctx, "missing")
+		assert.Assert(t, errors.Is(err, sql.ErrNoRows))
+	})
+}
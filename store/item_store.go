@@ -2,47 +2,143 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 )
 
+// ItemOrder is the order ListItems returns items in. The published orders
+// use the publication date of items, or the time they were saved when they
+// have none.
+type ItemOrder string
+
+const (
+	ItemOrderCreatedAsc    ItemOrder = "created_asc"
+	ItemOrderCreatedDesc   ItemOrder = "created_desc"
+	ItemOrderPublishedAsc  ItemOrder = "published_asc"
+	ItemOrderPublishedDesc ItemOrder = "published_desc"
+)
+
+// ParseItemOrder returns the order named by value; empty means
+// ItemOrderCreatedAsc.
+func ParseItemOrder(value string) (ItemOrder, error) {
+	switch order := ItemOrder(value); order {
+	case "":
+		return ItemOrderCreatedAsc, nil
+	case ItemOrderCreatedAsc, ItemOrderCreatedDesc, ItemOrderPublishedAsc, ItemOrderPublishedDesc:
+		return order, nil
+	default:
+		return "", fmt.Errorf("order must be created_asc, created_desc, published_asc or published_desc, got %q", value)
+	}
+}
+
+// IsPublished reports whether items are ordered by their hybrid date, so that
+// pages continue from HybridDateCursor rather than CreatedAtCursor.
+func (o ItemOrder) IsPublished() bool {
+	return o == ItemOrderPublishedAsc || o == ItemOrderPublishedDesc
+}
+
 type StoreListItemsParams struct {
 	FeedID          interface{}
 	IsRead          interface{}
+	IsStarred       interface{}
 	TagID           interface{}
 	Since           interface{}
 	CreatedAtCursor interface{}
 	IDCursor        interface{}
 	Limit           int64
 	IsBlocked       interface{}
+	HasEnclosure    interface{}
+	MediaType       interface{}
+	SavedSearchID   interface{}
+	// Order defaults to ItemOrderCreatedAsc. The published orders continue
+	// from HybridDateCursor and IDCursor.
+	Order            ItemOrder
+	HybridDateCursor interface{}
+	// Until is exclusive, like PublishedUntil. PublishedSince and
+	// PublishedUntil apply to the hybrid date of the published orders.
+	Until          interface{}
+	PublishedSince interface{}
+	PublishedUntil interface{}
+	Author         interface{}
+	Category       interface{}
+	HasImage       interface{}
+	// FeedIDs and TagIDs match items in any of the feeds or in a feed with
+	// any of the tags.
+	FeedIDs []string
+	TagIDs  []string
 }
 
 func (s *Store) ListItems(ctx context.Context, params StoreListItemsParams) ([]ListItemsRow, error) {
-	if (params.CreatedAtCursor != nil && params.IDCursor == nil) || (params.CreatedAtCursor == nil && params.IDCursor != nil) {
-		return nil, errors.New("both created_at_cursor and id_cursor must be provided together for pagination")
+	dateCursor, dateCursorName := params.CreatedAtCursor, "created_at_cursor"
+	if params.Order.IsPublished() {
+		dateCursor, dateCursorName = params.HybridDateCursor, "hybrid_date_cursor"
+	}
+	if (dateCursor != nil && params.IDCursor == nil) || (dateCursor == nil && params.IDCursor != nil) {
+		return nil, fmt.Errorf("both %s and id_cursor must be provided together for pagination", dateCursorName)
 	}
-	arg := ListItemsParams{
-		FeedID:          params.FeedID,
-		IsRead:          params.IsRead,
-		TagID:           params.TagID,
-		Since:           params.Since,
-		CreatedAtCursor: params.CreatedAtCursor,
-		IDCursor:        params.IDCursor,
-		Limit:           params.Limit,
-		IsBlocked:       params.IsBlocked,
+	return s.listItems(ctx, params.Order, params.filter(), dateCursor, params.IDCursor, params.Limit)
+}
+
+// filter returns the filters of params.
+func (params StoreListItemsParams) filter() StoreCountItemsParams {
+	return StoreCountItemsParams{
+		FeedID:         params.FeedID,
+		IsRead:         params.IsRead,
+		IsStarred:      params.IsStarred,
+		TagID:          params.TagID,
+		Since:          params.Since,
+		Until:          params.Until,
+		PublishedSince: params.PublishedSince,
+		PublishedUntil: params.PublishedUntil,
+		Author:         params.Author,
+		Category:       params.Category,
+		HasImage:       params.HasImage,
+		FeedIDs:        params.FeedIDs,
+		TagIDs:         params.TagIDs,
+		IsBlocked:      params.IsBlocked,
+		HasEnclosure:   params.HasEnclosure,
+		MediaType:      params.MediaType,
+		SavedSearchID:  params.SavedSearchID,
 	}
-	return s.Queries.ListItems(ctx, arg)
 }
 
+// StoreCountItemsParams are the filters of StoreListItemsParams.
 type StoreCountItemsParams struct {
-	FeedID    interface{}
-	IsRead    interface{}
-	TagID     interface{}
-	Since     interface{}
-	IsBlocked interface{}
+	FeedID         interface{}
+	IsRead         interface{}
+	IsStarred      interface{}
+	TagID          interface{}
+	Since          interface{}
+	Until          interface{}
+	PublishedSince interface{}
+	PublishedUntil interface{}
+	Author         interface{}
+	Category       interface{}
+	HasImage       interface{}
+	FeedIDs        []string
+	TagIDs         []string
+	IsBlocked      interface{}
+	HasEnclosure   interface{}
+	MediaType      interface{}
+	SavedSearchID  interface{}
 }
 
 func (s *Store) CountItems(ctx context.Context, params StoreCountItemsParams) (int64, error) {
-	return s.Queries.CountItems(ctx, CountItemsParams(params))
+	var count int64
+	err := s.db.QueryRowContext(ctx, countItems, itemFilterArgs(params)...).Scan(&count)
+	return count, err
+}
+
+// jsonIDs encodes IDs as the JSON array the item queries expect, or nil
+// when there are none.
+func jsonIDs(ids []string) interface{} {
+	if len(ids) == 0 {
+		return nil
+	}
+	// Marshaling a []string cannot fail.
+	b, _ := json.Marshal(ids)
+	return string(b)
 }
 
 func (s *Store) GetItem(ctx context.Context, id string) (GetItemRow, error) {
@@ -4,29 +4,72 @@
 
 package store
 
+type Category struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+}
+
 type Feed struct {
-	ID          string  `json:"id"`
-	Url         string  `json:"url"`
-	Link        *string `json:"link"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	Lang        *string `json:"lang"`
-	ImageUrl    *string `json:"image_url"`
-	Copyright   *string `json:"copyright"`
-	FeedType    *string `json:"feed_type"`
-	FeedVersion *string `json:"feed_version"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	ID               string  `json:"id"`
+	Url              string  `json:"url"`
+	Link             *string `json:"link"`
+	Title            *string `json:"title"`
+	Description      *string `json:"description"`
+	Lang             *string `json:"lang"`
+	ImageUrl         *string `json:"image_url"`
+	Copyright        *string `json:"copyright"`
+	FeedType         *string `json:"feed_type"`
+	FeedVersion      *string `json:"feed_version"`
+	FetchFullContent int64   `json:"fetch_full_content"`
+	UnreadOnRevision int64   `json:"unread_on_revision"`
+	TitleOverridden  int64   `json:"title_overridden"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type FeedCredential struct {
+	FeedID        string `json:"feed_id"`
+	AuthType      string `json:"auth_type"`
+	EncryptedData string `json:"encrypted_data"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
 }
 
 type FeedFetcher struct {
-	FeedID        string  `json:"feed_id"`
-	Etag          *string `json:"etag"`
-	LastModified  *string `json:"last_modified"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
+	FeedID              string  `json:"feed_id"`
+	Etag                *string `json:"etag"`
+	LastModified        *string `json:"last_modified"`
+	LastFetchedAt       *string `json:"last_fetched_at"`
+	NextFetch           *string `json:"next_fetch"`
+	LastStatusCode      *int64  `json:"last_status_code"`
+	LastError           *string `json:"last_error"`
+	LastErrorKind       *string `json:"last_error_kind"`
+	ConsecutiveFailures int64   `json:"consecutive_failures"`
+	LastSuccessAt       *string `json:"last_success_at"`
+	RedirectUrl         *string `json:"redirect_url"`
+	RedirectCount       int64   `json:"redirect_count"`
+	DisabledAt          *string `json:"disabled_at"`
+	DisabledReason      *string `json:"disabled_reason"`
+	HintMaxAge          *int64  `json:"hint_max_age"`
+	HintTtl             *int64  `json:"hint_ttl"`
+	HintUpdatePeriod    *int64  `json:"hint_update_period"`
+	HintSkipHours       *string `json:"hint_skip_hours"`
+	HintSkipDays        *string `json:"hint_skip_days"`
+	ScheduleReason      *string `json:"schedule_reason"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+}
+
+type FeedIcon struct {
+	FeedID    string  `json:"feed_id"`
+	SourceUrl *string `json:"source_url"`
+	MimeType  *string `json:"mime_type"`
+	Data      []byte  `json:"data"`
+	Error     *string `json:"error"`
+	FetchedAt string  `json:"fetched_at"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
 }
 
 type FeedIgnoreWindow struct {
@@ -44,6 +87,17 @@ type FeedItem struct {
 	UpdatedAt   string  `json:"updated_at"`
 }
 
+type FeedScraper struct {
+	FeedID          string  `json:"feed_id"`
+	ItemSelector    string  `json:"item_selector"`
+	TitleSelector   *string `json:"title_selector"`
+	LinkSelector    *string `json:"link_selector"`
+	DateSelector    *string `json:"date_selector"`
+	ContentSelector *string `json:"content_selector"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
 type FeedTag struct {
 	FeedID    string `json:"feed_id"`
 	TagID     string `json:"tag_id"`
@@ -51,6 +105,13 @@ type FeedTag struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+type FeedTransportProfile struct {
+	FeedID             string `json:"feed_id"`
+	TransportProfileID string `json:"transport_profile_id"`
+	CreatedAt          string `json:"created_at"`
+	UpdatedAt          string `json:"updated_at"`
+}
+
 type IgnoreWindow struct {
 	ID         string `json:"id"`
 	Name       string `json:"name"`
@@ -63,18 +124,21 @@ type IgnoreWindow struct {
 }
 
 type Item struct {
-	ID          string  `json:"id"`
-	Url         string  `json:"url"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	PublishedAt *string `json:"published_at"`
-	Author      *string `json:"author"`
-	Guid        *string `json:"guid"`
-	Content     *string `json:"content"`
-	ImageUrl    *string `json:"image_url"`
-	Categories  *string `json:"categories"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	ID              string  `json:"id"`
+	Url             string  `json:"url"`
+	Title           *string `json:"title"`
+	Description     *string `json:"description"`
+	PublishedAt     *string `json:"published_at"`
+	Author          *string `json:"author"`
+	Guid            *string `json:"guid"`
+	Content         *string `json:"content"`
+	ImageUrl        *string `json:"image_url"`
+	Categories      *string `json:"categories"`
+	RevisedAt       *string `json:"revised_at"`
+	DescriptionHtml *string `json:"description_html"`
+	ContentHtml     *string `json:"content_html"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
 }
 
 type ItemBlock struct {
@@ -92,6 +156,45 @@ type ItemBlockRule struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+type ItemCategory struct {
+	ItemID     string `json:"item_id"`
+	CategoryID string `json:"category_id"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type ItemCountsVersion struct {
+	ID      int64 `json:"id"`
+	Version int64 `json:"version"`
+}
+
+type ItemEnclosure struct {
+	ItemID          string  `json:"item_id"`
+	Position        int64   `json:"position"`
+	Url             string  `json:"url"`
+	MimeType        *string `json:"mime_type"`
+	Length          *int64  `json:"length"`
+	DurationSeconds *int64  `json:"duration_seconds"`
+	ThumbnailUrl    *string `json:"thumbnail_url"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+type ItemFullContent struct {
+	ItemID      string  `json:"item_id"`
+	Content     *string `json:"content"`
+	ContentHtml *string `json:"content_html"`
+	Error       *string `json:"error"`
+	FetchedAt   string  `json:"fetched_at"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type ItemIdentity struct {
+	FeedID    string `json:"feed_id"`
+	Identity  string `json:"identity"`
+	ItemID    string `json:"item_id"`
+	CreatedAt string `json:"created_at"`
+}
+
 type ItemRead struct {
 	ItemID    string  `json:"item_id"`
 	IsRead    int64   `json:"is_read"`
@@ -100,6 +203,65 @@ type ItemRead struct {
 	UpdatedAt string  `json:"updated_at"`
 }
 
+type ItemRevision struct {
+	ItemID      string  `json:"item_id"`
+	Revision    int64   `json:"revision"`
+	ContentHash string  `json:"content_hash"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Content     *string `json:"content"`
+	CreatedAt   string  `json:"created_at"`
+	FeedID      *string `json:"feed_id"`
+}
+
+type ItemStar struct {
+	ItemID    string  `json:"item_id"`
+	IsStarred int64   `json:"is_starred"`
+	StarredAt *string `json:"starred_at"`
+	Note      *string `json:"note"`
+	UserTags  *string `json:"user_tags"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+type ItemsFt struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Content     string `json:"content"`
+}
+
+type SavedSearch struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Query      *string `json:"query"`
+	MatchQuery *string `json:"match_query"`
+	IsRead     *int64  `json:"is_read"`
+	IsStarred  *int64  `json:"is_starred"`
+	Author     *string `json:"author"`
+	Since      *string `json:"since"`
+	Until      *string `json:"until"`
+	MaxAgeDays *int64  `json:"max_age_days"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+type SavedSearchFeed struct {
+	SavedSearchID string `json:"saved_search_id"`
+	FeedID        string `json:"feed_id"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type SavedSearchItem struct {
+	SavedSearchID string `json:"saved_search_id"`
+	ItemID        string `json:"item_id"`
+}
+
+type SavedSearchTag struct {
+	SavedSearchID string `json:"saved_search_id"`
+	TagID         string `json:"tag_id"`
+	CreatedAt     string `json:"created_at"`
+}
+
 type Tag struct {
 	ID        string `json:"id"`
 	Name      string `json:"name"`
@@ -114,6 +276,27 @@ type TagIgnoreWindow struct {
 	UpdatedAt      string `json:"updated_at"`
 }
 
+type TagTransportProfile struct {
+	TagID              string `json:"tag_id"`
+	TransportProfileID string `json:"transport_profile_id"`
+	CreatedAt          string `json:"created_at"`
+	UpdatedAt          string `json:"updated_at"`
+}
+
+type TransportProfile struct {
+	ID                 string  `json:"id"`
+	Name               string  `json:"name"`
+	ProxyUrl           *string `json:"proxy_url"`
+	CaBundle           *string `json:"ca_bundle"`
+	ClientCert         *string `json:"client_cert"`
+	ClientKey          *string `json:"client_key"`
+	InsecureSkipVerify int64   `json:"insecure_skip_verify"`
+	TimeoutSeconds     *int64  `json:"timeout_seconds"`
+	RetryMax           *int64  `json:"retry_max"`
+	CreatedAt          string  `json:"created_at"`
+	UpdatedAt          string  `json:"updated_at"`
+}
+
 type UrlParsingRule struct {
 	ID        string `json:"id"`
 	Domain    string `json:"domain"`
@@ -122,3 +305,15 @@ type UrlParsingRule struct {
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
+
+type WebsubSubscription struct {
+	FeedID         string  `json:"feed_id"`
+	HubUrl         string  `json:"hub_url"`
+	TopicUrl       string  `json:"topic_url"`
+	Secret         string  `json:"secret"`
+	State          string  `json:"state"`
+	LeaseSeconds   *int64  `json:"lease_seconds"`
+	LeaseExpiresAt *string `json:"lease_expires_at"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
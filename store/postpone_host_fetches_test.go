@@ -0,0 +1,54 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestQueries_PostponeHostFetches(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t)
+
+	for id, url := range map[string]string{
+		"path":      "https://example.com/feed.xml",
+		"bare":      "https://example.com",
+		"query":     "http://EXAMPLE.com?format=rss",
+		"fragment":  "https://example.com#top",
+		"subdomain": "https://blog.example.com/feed.xml",
+		"suffix":    "https://example.com.evil.test/feed.xml",
+		"wildcard":  "https://exampleXcom/feed.xml",
+		"port":      "https://example.com:8443/feed.xml",
+	} {
+		_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: id, Url: url})
+		assert.NilError(t, err)
+	}
+
+	nextFetch := "2026-01-01T00:00:00Z"
+	assert.NilError(t, s.PostponeHostFetches(ctx, store.PostponeHostFetchesParams{Host: "example.com", NextFetch: nextFetch}))
+	// "_" is not a wildcard.
+	assert.NilError(t, s.PostponeHostFetches(ctx, store.PostponeHostFetchesParams{Host: "example_com", NextFetch: nextFetch}))
+
+	for id, want := range map[string]bool{
+		"path":      true,
+		"bare":      true,
+		"query":     true,
+		"fragment":  true,
+		"subdomain": false,
+		"suffix":    false,
+		"wildcard":  false,
+		"port":      false,
+	} {
+		fetcher, err := s.GetFeedFetcher(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			assert.Assert(t, !want, id)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.Equal(t, fetcher.NextFetch != nil && *fetcher.NextFetch == nextFetch, want, id)
+	}
+}
@@ -46,7 +46,7 @@ func mask(i any) {
 	switch v := i.(type) {
 	case map[string]any:
 		for k, val := range v {
-			if k == "created_at" || k == "updated_at" || k == "published_at" || k == "read_at" || k == "last_fetched_at" {
+			if k == "created_at" || k == "updated_at" || k == "published_at" || k == "read_at" || k == "last_fetched_at" || k == "hybrid_date" {
 				if val != nil {
 					v[k] = "MASKED"
 				}
@@ -94,8 +94,8 @@ func TestQueries_CreateItem(t *testing.T) {
 
 		// Upsert with new title
 		newParams := store.CreateItemParams{
-			ID:          "item-2-new-id-ignored",
-			Url:         "http://example.com/item2", // Same URL
+			ID:          "item-2", // Same ID
+			Url:         "http://example.com/item2",
 			Title:       new("Item 2 Updated"),
 			Description: new("Description 2"),
 		}
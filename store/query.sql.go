@@ -10,6 +10,102 @@ import (
 	"strings"
 )
 
+const activateWebSubSubscription = `-- name: ActivateWebSubSubscription :exec
+UPDATE websub_subscriptions
+SET
+  state = 'active',
+  lease_seconds = ?1,
+  lease_expires_at = ?2,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  feed_id = ?3
+`
+
+type ActivateWebSubSubscriptionParams struct {
+	LeaseSeconds   *int64  `json:"lease_seconds"`
+	LeaseExpiresAt *string `json:"lease_expires_at"`
+	FeedID         string  `json:"feed_id"`
+}
+
+func (q *Queries) ActivateWebSubSubscription(ctx context.Context, arg ActivateWebSubSubscriptionParams) error {
+	_, err := q.db.ExecContext(ctx, activateWebSubSubscription, arg.LeaseSeconds, arg.LeaseExpiresAt, arg.FeedID)
+	return err
+}
+
+const claimItemRevision = `-- name: ClaimItemRevision :exec
+UPDATE
+  item_revisions
+SET
+  feed_id = ?1,
+  content_hash = ?2
+WHERE
+  item_id = ?3 AND revision = ?4
+`
+
+type ClaimItemRevisionParams struct {
+	FeedID      *string `json:"feed_id"`
+	ContentHash string  `json:"content_hash"`
+	ItemID      string  `json:"item_id"`
+	Revision    int64   `json:"revision"`
+}
+
+// Makes a revision without a feed the baseline of the feed saving its item.
+func (q *Queries) ClaimItemRevision(ctx context.Context, arg ClaimItemRevisionParams) error {
+	_, err := q.db.ExecContext(ctx, claimItemRevision,
+		arg.FeedID,
+		arg.ContentHash,
+		arg.ItemID,
+		arg.Revision,
+	)
+	return err
+}
+
+const clearFeedRedirect = `-- name: ClearFeedRedirect :exec
+UPDATE feed_fetcher
+SET
+  redirect_url = NULL,
+  redirect_count = 0,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  feed_id = ? AND redirect_url IS NOT NULL
+`
+
+func (q *Queries) ClearFeedRedirect(ctx context.Context, feedID string) error {
+	_, err := q.db.ExecContext(ctx, clearFeedRedirect, feedID)
+	return err
+}
+
+const countAllItems = `-- name: CountAllItems :one
+SELECT
+  CAST(COUNT(*) AS INTEGER) AS total_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS unread_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN 1 ELSE 0 END), 0) AS INTEGER) AS starred_count
+FROM
+  items i
+LEFT JOIN
+  item_reads ir ON ir.item_id = i.id
+LEFT JOIN
+  item_stars st ON st.item_id = i.id
+WHERE
+  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id) AND
+  NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = i.id)
+`
+
+type CountAllItemsRow struct {
+	TotalCount   int64 `json:"total_count"`
+	UnreadCount  int64 `json:"unread_count"`
+	StarredCount int64 `json:"starred_count"`
+}
+
+// Counts are of the items in feeds, leaving out blocked items as unread
+// counts do.
+func (q *Queries) CountAllItems(ctx context.Context) (CountAllItemsRow, error) {
+	row := q.db.QueryRowContext(ctx, countAllItems)
+	var i CountAllItemsRow
+	err := row.Scan(&i.TotalCount, &i.UnreadCount, &i.StarredCount)
+	return i, err
+}
+
 const countFeedsPerTag = `-- name: CountFeedsPerTag :many
 SELECT
   ft.tag_id,
@@ -48,46 +144,173 @@ func (q *Queries) CountFeedsPerTag(ctx context.Context) ([]CountFeedsPerTagRow,
 	return items, nil
 }
 
-const countItems = `-- name: CountItems :one
+const countItemsPerFeed = `-- name: CountItemsPerFeed :many
 SELECT
-  COUNT(DISTINCT i.id)
+  f.id AS feed_id,
+  CAST(COUNT(fi.item_id) AS INTEGER) AS total_count,
+  CAST(COALESCE(SUM(CASE WHEN fi.item_id IS NOT NULL AND COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS unread_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN 1 ELSE 0 END), 0) AS INTEGER) AS starred_count
 FROM
-  items i
-JOIN
-  feed_items fi ON i.id = fi.item_id
+  feeds f
 LEFT JOIN
-  item_reads ir ON i.id = ir.item_id
+  feed_items fi ON fi.feed_id = f.id AND NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = fi.item_id)
+LEFT JOIN
+  item_reads ir ON ir.item_id = fi.item_id
+LEFT JOIN
+  item_stars st ON st.item_id = fi.item_id
+GROUP BY
+  f.id
+ORDER BY
+  f.id
+`
+
+type CountItemsPerFeedRow struct {
+	FeedID       string `json:"feed_id"`
+	TotalCount   int64  `json:"total_count"`
+	UnreadCount  int64  `json:"unread_count"`
+	StarredCount int64  `json:"starred_count"`
+}
+
+func (q *Queries) CountItemsPerFeed(ctx context.Context) ([]CountItemsPerFeedRow, error) {
+	rows, err := q.db.QueryContext(ctx, countItemsPerFeed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountItemsPerFeedRow
+	for rows.Next() {
+		var i CountItemsPerFeedRow
+		if err := rows.Scan(
+			&i.FeedID,
+			&i.TotalCount,
+			&i.UnreadCount,
+			&i.StarredCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countItemsPerSavedSearch = `-- name: CountItemsPerSavedSearch :many
+SELECT
+  ssi.saved_search_id,
+  CAST(COUNT(*) AS INTEGER) AS total_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS unread_count,
+  CAST(COALESCE(SUM(CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN 1 ELSE 0 END), 0) AS INTEGER) AS starred_count
+FROM
+  saved_search_items ssi
+LEFT JOIN
+  item_reads ir ON ir.item_id = ssi.item_id
 LEFT JOIN
-  item_blocks ib ON i.id = ib.item_id
+  item_stars st ON st.item_id = ssi.item_id
 WHERE
-  (?1 IS NULL OR fi.feed_id = ?1) AND
-  (?2 IS NULL OR COALESCE(ir.is_read, 0) = ?2) AND
-  (?3 IS NULL OR EXISTS (
-    SELECT 1 FROM feed_tags ft WHERE ft.feed_id = fi.feed_id AND ft.tag_id = ?3
-  )) AND
-  (?4 IS NULL OR i.created_at >= ?4) AND
-  (?5 IS NULL OR (CASE WHEN ib.item_id IS NOT NULL THEN 1 ELSE 0 END = ?5))
+  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = ssi.item_id) AND
+  NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = ssi.item_id)
+GROUP BY
+  ssi.saved_search_id
+ORDER BY
+  ssi.saved_search_id
 `
 
-type CountItemsParams struct {
-	FeedID    interface{} `json:"feed_id"`
-	IsRead    interface{} `json:"is_read"`
-	TagID     interface{} `json:"tag_id"`
-	Since     interface{} `json:"since"`
-	IsBlocked interface{} `json:"is_blocked"`
+type CountItemsPerSavedSearchRow struct {
+	SavedSearchID string `json:"saved_search_id"`
+	TotalCount    int64  `json:"total_count"`
+	UnreadCount   int64  `json:"unread_count"`
+	StarredCount  int64  `json:"starred_count"`
 }
 
-func (q *Queries) CountItems(ctx context.Context, arg CountItemsParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countItems,
-		arg.FeedID,
-		arg.IsRead,
-		arg.TagID,
-		arg.Since,
-		arg.IsBlocked,
-	)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+func (q *Queries) CountItemsPerSavedSearch(ctx context.Context) ([]CountItemsPerSavedSearchRow, error) {
+	rows, err := q.db.QueryContext(ctx, countItemsPerSavedSearch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountItemsPerSavedSearchRow
+	for rows.Next() {
+		var i CountItemsPerSavedSearchRow
+		if err := rows.Scan(
+			&i.SavedSearchID,
+			&i.TotalCount,
+			&i.UnreadCount,
+			&i.StarredCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countItemsPerTag = `-- name: CountItemsPerTag :many
+SELECT
+  t.id AS tag_id,
+  CAST(COUNT(DISTINCT fi.item_id) AS INTEGER) AS total_count,
+  CAST(COUNT(DISTINCT CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN fi.item_id END) AS INTEGER) AS unread_count,
+  CAST(COUNT(DISTINCT CASE WHEN COALESCE(st.is_starred, 0) = 1 THEN fi.item_id END) AS INTEGER) AS starred_count
+FROM
+  tags t
+LEFT JOIN
+  feed_tags ft ON ft.tag_id = t.id
+LEFT JOIN
+  feed_items fi ON fi.feed_id = ft.feed_id AND NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = fi.item_id)
+LEFT JOIN
+  item_reads ir ON ir.item_id = fi.item_id
+LEFT JOIN
+  item_stars st ON st.item_id = fi.item_id
+GROUP BY
+  t.id
+ORDER BY
+  t.id
+`
+
+type CountItemsPerTagRow struct {
+	TagID        string `json:"tag_id"`
+	TotalCount   int64  `json:"total_count"`
+	UnreadCount  int64  `json:"unread_count"`
+	StarredCount int64  `json:"starred_count"`
+}
+
+// An item in several feeds with the tag is counted once.
+func (q *Queries) CountItemsPerTag(ctx context.Context) ([]CountItemsPerTagRow, error) {
+	rows, err := q.db.QueryContext(ctx, countItemsPerTag)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountItemsPerTagRow
+	for rows.Next() {
+		var i CountItemsPerTagRow
+		if err := rows.Scan(
+			&i.TagID,
+			&i.TotalCount,
+			&i.UnreadCount,
+			&i.StarredCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const countTotalUnreadItems = `-- name: CountTotalUnreadItems :one
@@ -228,24 +451,26 @@ INSERT INTO feeds (
   image_url,
   copyright,
   feed_type,
-  feed_version
+  feed_version,
+  title_overridden
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
-RETURNING id, url, link, title, description, lang, image_url, copyright, feed_type, feed_version, created_at, updated_at
+RETURNING id, url, link, title, description, lang, image_url, copyright, feed_type, feed_version, fetch_full_content, unread_on_revision, title_overridden, created_at, updated_at
 `
 
 type CreateFeedParams struct {
-	ID          string  `json:"id"`
-	Url         string  `json:"url"`
-	Link        *string `json:"link"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	Lang        *string `json:"lang"`
-	ImageUrl    *string `json:"image_url"`
-	Copyright   *string `json:"copyright"`
-	FeedType    *string `json:"feed_type"`
-	FeedVersion *string `json:"feed_version"`
+	ID              string  `json:"id"`
+	Url             string  `json:"url"`
+	Link            *string `json:"link"`
+	Title           *string `json:"title"`
+	Description     *string `json:"description"`
+	Lang            *string `json:"lang"`
+	ImageUrl        *string `json:"image_url"`
+	Copyright       *string `json:"copyright"`
+	FeedType        *string `json:"feed_type"`
+	FeedVersion     *string `json:"feed_version"`
+	TitleOverridden int64   `json:"title_overridden"`
 }
 
 func (q *Queries) CreateFeed(ctx context.Context, arg CreateFeedParams) (Feed, error) {
@@ -260,6 +485,7 @@ func (q *Queries) CreateFeed(ctx context.Context, arg CreateFeedParams) (Feed, e
 		arg.Copyright,
 		arg.FeedType,
 		arg.FeedVersion,
+		arg.TitleOverridden,
 	)
 	var i Feed
 	err := row.Scan(
@@ -273,6 +499,9 @@ func (q *Queries) CreateFeed(ctx context.Context, arg CreateFeedParams) (Feed, e
 		&i.Copyright,
 		&i.FeedType,
 		&i.FeedVersion,
+		&i.FetchFullContent,
+		&i.UnreadOnRevision,
+		&i.TitleOverridden,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -396,11 +625,14 @@ INSERT INTO items (
   guid,
   content,
   image_url,
-  categories
+  categories,
+  description_html,
+  content_html
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
-ON CONFLICT(url) DO UPDATE SET
+ON CONFLICT(id) DO UPDATE SET
+  url = excluded.url,
   title = excluded.title,
   description = excluded.description,
   author = excluded.author,
@@ -408,21 +640,25 @@ ON CONFLICT(url) DO UPDATE SET
   content = excluded.content,
   image_url = excluded.image_url,
   categories = excluded.categories,
+  description_html = excluded.description_html,
+  content_html = excluded.content_html,
   updated_at = (strftime('%FT%TZ', 'now'))
-RETURNING id, url, title, description, published_at, author, guid, content, image_url, categories, created_at, updated_at
+RETURNING id, url, title, description, published_at, author, guid, content, image_url, categories, revised_at, description_html, content_html, created_at, updated_at
 `
 
 type CreateItemParams struct {
-	ID          string  `json:"id"`
-	Url         string  `json:"url"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	PublishedAt *string `json:"published_at"`
-	Author      *string `json:"author"`
-	Guid        *string `json:"guid"`
-	Content     *string `json:"content"`
-	ImageUrl    *string `json:"image_url"`
-	Categories  *string `json:"categories"`
+	ID              string  `json:"id"`
+	Url             string  `json:"url"`
+	Title           *string `json:"title"`
+	Description     *string `json:"description"`
+	PublishedAt     *string `json:"published_at"`
+	Author          *string `json:"author"`
+	Guid            *string `json:"guid"`
+	Content         *string `json:"content"`
+	ImageUrl        *string `json:"image_url"`
+	Categories      *string `json:"categories"`
+	DescriptionHtml *string `json:"description_html"`
+	ContentHtml     *string `json:"content_html"`
 }
 
 func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, error) {
@@ -437,6 +673,8 @@ func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, e
 		arg.Content,
 		arg.ImageUrl,
 		arg.Categories,
+		arg.DescriptionHtml,
+		arg.ContentHtml,
 	)
 	var i Item
 	err := row.Scan(
@@ -450,6 +688,9 @@ func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, e
 		&i.Content,
 		&i.ImageUrl,
 		&i.Categories,
+		&i.RevisedAt,
+		&i.DescriptionHtml,
+		&i.ContentHtml,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -514,6 +755,81 @@ func (q *Queries) CreateItemBlockRule(ctx context.Context, arg CreateItemBlockRu
 	return i, err
 }
 
+const createItemCategory = `-- name: CreateItemCategory :exec
+INSERT INTO item_categories (item_id, category_id)
+VALUES (?, ?)
+ON CONFLICT(item_id, category_id) DO NOTHING
+`
+
+type CreateItemCategoryParams struct {
+	ItemID     string `json:"item_id"`
+	CategoryID string `json:"category_id"`
+}
+
+func (q *Queries) CreateItemCategory(ctx context.Context, arg CreateItemCategoryParams) error {
+	_, err := q.db.ExecContext(ctx, createItemCategory, arg.ItemID, arg.CategoryID)
+	return err
+}
+
+const createItemEnclosure = `-- name: CreateItemEnclosure :exec
+INSERT INTO item_enclosures (
+  item_id,
+  position,
+  url,
+  mime_type,
+  length,
+  duration_seconds,
+  thumbnail_url
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+)
+`
+
+type CreateItemEnclosureParams struct {
+	ItemID          string  `json:"item_id"`
+	Position        int64   `json:"position"`
+	Url             string  `json:"url"`
+	MimeType        *string `json:"mime_type"`
+	Length          *int64  `json:"length"`
+	DurationSeconds *int64  `json:"duration_seconds"`
+	ThumbnailUrl    *string `json:"thumbnail_url"`
+}
+
+func (q *Queries) CreateItemEnclosure(ctx context.Context, arg CreateItemEnclosureParams) error {
+	_, err := q.db.ExecContext(ctx, createItemEnclosure,
+		arg.ItemID,
+		arg.Position,
+		arg.Url,
+		arg.MimeType,
+		arg.Length,
+		arg.DurationSeconds,
+		arg.ThumbnailUrl,
+	)
+	return err
+}
+
+const createItemIdentity = `-- name: CreateItemIdentity :exec
+INSERT INTO item_identities (
+  feed_id,
+  identity,
+  item_id
+) VALUES (
+  ?, ?, ?
+)
+ON CONFLICT(feed_id, identity) DO NOTHING
+`
+
+type CreateItemIdentityParams struct {
+	FeedID   string `json:"feed_id"`
+	Identity string `json:"identity"`
+	ItemID   string `json:"item_id"`
+}
+
+func (q *Queries) CreateItemIdentity(ctx context.Context, arg CreateItemIdentityParams) error {
+	_, err := q.db.ExecContext(ctx, createItemIdentity, arg.FeedID, arg.Identity, arg.ItemID)
+	return err
+}
+
 const createItemRead = `-- name: CreateItemRead :exec
 INSERT INTO item_reads (
   item_id
@@ -528,57 +844,251 @@ func (q *Queries) CreateItemRead(ctx context.Context, itemID string) error {
 	return err
 }
 
-const createTag = `-- name: CreateTag :one
-INSERT INTO tags (
+const createItemRevision = `-- name: CreateItemRevision :exec
+INSERT INTO item_revisions (
+  item_id,
+  revision,
+  content_hash,
+  title,
+  description,
+  content,
+  feed_id
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+)
+`
+
+type CreateItemRevisionParams struct {
+	ItemID      string  `json:"item_id"`
+	Revision    int64   `json:"revision"`
+	ContentHash string  `json:"content_hash"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Content     *string `json:"content"`
+	FeedID      *string `json:"feed_id"`
+}
+
+func (q *Queries) CreateItemRevision(ctx context.Context, arg CreateItemRevisionParams) error {
+	_, err := q.db.ExecContext(ctx, createItemRevision,
+		arg.ItemID,
+		arg.Revision,
+		arg.ContentHash,
+		arg.Title,
+		arg.Description,
+		arg.Content,
+		arg.FeedID,
+	)
+	return err
+}
+
+const createSavedSearch = `-- name: CreateSavedSearch :one
+INSERT INTO saved_searches (
   id,
-  name
+  name,
+  query,
+  match_query,
+  is_read,
+  is_starred,
+  author,
+  since,
+  until,
+  max_age_days
 ) VALUES (
-  ?, ?
+  ?1,
+  ?2,
+  ?3,
+  ?4,
+  ?5,
+  ?6,
+  ?7,
+  ?8,
+  ?9,
+  ?10
 )
-ON CONFLICT(name) DO UPDATE SET
-  name = excluded.name
-RETURNING id, name, created_at, updated_at
+RETURNING id, name, "query", match_query, is_read, is_starred, author, since, until, max_age_days, created_at, updated_at
 `
 
-type CreateTagParams struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+type CreateSavedSearchParams struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Query      *string `json:"query"`
+	MatchQuery *string `json:"match_query"`
+	IsRead     *int64  `json:"is_read"`
+	IsStarred  *int64  `json:"is_starred"`
+	Author     *string `json:"author"`
+	Since      *string `json:"since"`
+	Until      *string `json:"until"`
+	MaxAgeDays *int64  `json:"max_age_days"`
 }
 
-func (q *Queries) CreateTag(ctx context.Context, arg CreateTagParams) (Tag, error) {
-	row := q.db.QueryRowContext(ctx, createTag, arg.ID, arg.Name)
-	var i Tag
+func (q *Queries) CreateSavedSearch(ctx context.Context, arg CreateSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRowContext(ctx, createSavedSearch,
+		arg.ID,
+		arg.Name,
+		arg.Query,
+		arg.MatchQuery,
+		arg.IsRead,
+		arg.IsStarred,
+		arg.Author,
+		arg.Since,
+		arg.Until,
+		arg.MaxAgeDays,
+	)
+	var i SavedSearch
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
+		&i.Query,
+		&i.MatchQuery,
+		&i.IsRead,
+		&i.IsStarred,
+		&i.Author,
+		&i.Since,
+		&i.Until,
+		&i.MaxAgeDays,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const createTagIgnoreWindow = `-- name: CreateTagIgnoreWindow :exec
-INSERT INTO tag_ignore_windows (tag_id, ignore_window_id)
-VALUES (?, ?)
-ON CONFLICT(tag_id, ignore_window_id) DO NOTHING
+const createSavedSearchFeed = `-- name: CreateSavedSearchFeed :exec
+INSERT INTO saved_search_feeds (saved_search_id, feed_id) VALUES (?, ?)
 `
 
-type CreateTagIgnoreWindowParams struct {
-	TagID          string `json:"tag_id"`
-	IgnoreWindowID string `json:"ignore_window_id"`
+type CreateSavedSearchFeedParams struct {
+	SavedSearchID string `json:"saved_search_id"`
+	FeedID        string `json:"feed_id"`
 }
 
-func (q *Queries) CreateTagIgnoreWindow(ctx context.Context, arg CreateTagIgnoreWindowParams) error {
-	_, err := q.db.ExecContext(ctx, createTagIgnoreWindow, arg.TagID, arg.IgnoreWindowID)
+func (q *Queries) CreateSavedSearchFeed(ctx context.Context, arg CreateSavedSearchFeedParams) error {
+	_, err := q.db.ExecContext(ctx, createSavedSearchFeed, arg.SavedSearchID, arg.FeedID)
 	return err
 }
 
-const createURLParsingRule = `-- name: CreateURLParsingRule :one
-INSERT INTO url_parsing_rules (
-  id,
-  domain,
-  rule_type,
-  pattern
+const createSavedSearchTag = `-- name: CreateSavedSearchTag :exec
+INSERT INTO saved_search_tags (saved_search_id, tag_id) VALUES (?, ?)
+`
+
+type CreateSavedSearchTagParams struct {
+	SavedSearchID string `json:"saved_search_id"`
+	TagID         string `json:"tag_id"`
+}
+
+func (q *Queries) CreateSavedSearchTag(ctx context.Context, arg CreateSavedSearchTagParams) error {
+	_, err := q.db.ExecContext(ctx, createSavedSearchTag, arg.SavedSearchID, arg.TagID)
+	return err
+}
+
+const createTag = `-- name: CreateTag :one
+INSERT INTO tags (
+  id,
+  name
+) VALUES (
+  ?, ?
+)
+ON CONFLICT(name) DO UPDATE SET
+  name = excluded.name
+RETURNING id, name, created_at, updated_at
+`
+
+type CreateTagParams struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) CreateTag(ctx context.Context, arg CreateTagParams) (Tag, error) {
+	row := q.db.QueryRowContext(ctx, createTag, arg.ID, arg.Name)
+	var i Tag
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createTagIgnoreWindow = `-- name: CreateTagIgnoreWindow :exec
+INSERT INTO tag_ignore_windows (tag_id, ignore_window_id)
+VALUES (?, ?)
+ON CONFLICT(tag_id, ignore_window_id) DO NOTHING
+`
+
+type CreateTagIgnoreWindowParams struct {
+	TagID          string `json:"tag_id"`
+	IgnoreWindowID string `json:"ignore_window_id"`
+}
+
+func (q *Queries) CreateTagIgnoreWindow(ctx context.Context, arg CreateTagIgnoreWindowParams) error {
+	_, err := q.db.ExecContext(ctx, createTagIgnoreWindow, arg.TagID, arg.IgnoreWindowID)
+	return err
+}
+
+const createTransportProfile = `-- name: CreateTransportProfile :one
+INSERT INTO transport_profiles (
+  id,
+  name,
+  proxy_url,
+  ca_bundle,
+  client_cert,
+  client_key,
+  insecure_skip_verify,
+  timeout_seconds,
+  retry_max
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+RETURNING id, name, proxy_url, ca_bundle, client_cert, client_key, insecure_skip_verify, timeout_seconds, retry_max, created_at, updated_at
+`
+
+type CreateTransportProfileParams struct {
+	ID                 string  `json:"id"`
+	Name               string  `json:"name"`
+	ProxyUrl           *string `json:"proxy_url"`
+	CaBundle           *string `json:"ca_bundle"`
+	ClientCert         *string `json:"client_cert"`
+	ClientKey          *string `json:"client_key"`
+	InsecureSkipVerify int64   `json:"insecure_skip_verify"`
+	TimeoutSeconds     *int64  `json:"timeout_seconds"`
+	RetryMax           *int64  `json:"retry_max"`
+}
+
+func (q *Queries) CreateTransportProfile(ctx context.Context, arg CreateTransportProfileParams) (TransportProfile, error) {
+	row := q.db.QueryRowContext(ctx, createTransportProfile,
+		arg.ID,
+		arg.Name,
+		arg.ProxyUrl,
+		arg.CaBundle,
+		arg.ClientCert,
+		arg.ClientKey,
+		arg.InsecureSkipVerify,
+		arg.TimeoutSeconds,
+		arg.RetryMax,
+	)
+	var i TransportProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ProxyUrl,
+		&i.CaBundle,
+		&i.ClientCert,
+		&i.ClientKey,
+		&i.InsecureSkipVerify,
+		&i.TimeoutSeconds,
+		&i.RetryMax,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createURLParsingRule = `-- name: CreateURLParsingRule :one
+INSERT INTO url_parsing_rules (
+  id,
+  domain,
+  rule_type,
+  pattern
 ) VALUES (
   ?, ?, ?, ?
 )
@@ -623,6 +1133,18 @@ func (q *Queries) DeleteFeed(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteFeedCredential = `-- name: DeleteFeedCredential :exec
+DELETE FROM
+  feed_credentials
+WHERE
+  feed_id = ?
+`
+
+func (q *Queries) DeleteFeedCredential(ctx context.Context, feedID string) error {
+	_, err := q.db.ExecContext(ctx, deleteFeedCredential, feedID)
+	return err
+}
+
 const deleteFeedFetcher = `-- name: DeleteFeedFetcher :exec
 DELETE FROM
   feed_fetcher
@@ -688,6 +1210,15 @@ func (q *Queries) DeleteFeedTags(ctx context.Context, feedID string) error {
 	return err
 }
 
+const deleteFeedTransportProfile = `-- name: DeleteFeedTransportProfile :exec
+DELETE FROM feed_transport_profiles WHERE feed_id = ?
+`
+
+func (q *Queries) DeleteFeedTransportProfile(ctx context.Context, feedID string) error {
+	_, err := q.db.ExecContext(ctx, deleteFeedTransportProfile, feedID)
+	return err
+}
+
 const deleteIgnoreWindow = `-- name: DeleteIgnoreWindow :exec
 DELETE FROM ignore_windows WHERE id = ?
 `
@@ -721,6 +1252,55 @@ func (q *Queries) DeleteItemBlocksByRuleID(ctx context.Context, ruleID string) e
 	return err
 }
 
+const deleteItemCategories = `-- name: DeleteItemCategories :exec
+DELETE FROM item_categories
+WHERE item_id = ?
+`
+
+func (q *Queries) DeleteItemCategories(ctx context.Context, itemID string) error {
+	_, err := q.db.ExecContext(ctx, deleteItemCategories, itemID)
+	return err
+}
+
+const deleteItemEnclosures = `-- name: DeleteItemEnclosures :exec
+DELETE FROM
+  item_enclosures
+WHERE
+  item_id = ?
+`
+
+func (q *Queries) DeleteItemEnclosures(ctx context.Context, itemID string) error {
+	_, err := q.db.ExecContext(ctx, deleteItemEnclosures, itemID)
+	return err
+}
+
+const deleteSavedSearch = `-- name: DeleteSavedSearch :exec
+DELETE FROM saved_searches WHERE id = ?
+`
+
+func (q *Queries) DeleteSavedSearch(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteSavedSearch, id)
+	return err
+}
+
+const deleteSavedSearchFeeds = `-- name: DeleteSavedSearchFeeds :exec
+DELETE FROM saved_search_feeds WHERE saved_search_id = ?
+`
+
+func (q *Queries) DeleteSavedSearchFeeds(ctx context.Context, savedSearchID string) error {
+	_, err := q.db.ExecContext(ctx, deleteSavedSearchFeeds, savedSearchID)
+	return err
+}
+
+const deleteSavedSearchTags = `-- name: DeleteSavedSearchTags :exec
+DELETE FROM saved_search_tags WHERE saved_search_id = ?
+`
+
+func (q *Queries) DeleteSavedSearchTags(ctx context.Context, savedSearchID string) error {
+	_, err := q.db.ExecContext(ctx, deleteSavedSearchTags, savedSearchID)
+	return err
+}
+
 const deleteTag = `-- name: DeleteTag :exec
 DELETE FROM
   tags
@@ -757,6 +1337,24 @@ func (q *Queries) DeleteTagIgnoreWindowsByTagID(ctx context.Context, tagID strin
 	return err
 }
 
+const deleteTagTransportProfile = `-- name: DeleteTagTransportProfile :exec
+DELETE FROM tag_transport_profiles WHERE tag_id = ?
+`
+
+func (q *Queries) DeleteTagTransportProfile(ctx context.Context, tagID string) error {
+	_, err := q.db.ExecContext(ctx, deleteTagTransportProfile, tagID)
+	return err
+}
+
+const deleteTransportProfile = `-- name: DeleteTransportProfile :exec
+DELETE FROM transport_profiles WHERE id = ?
+`
+
+func (q *Queries) DeleteTransportProfile(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteTransportProfile, id)
+	return err
+}
+
 const deleteURLParsingRule = `-- name: DeleteURLParsingRule :exec
 DELETE FROM
   url_parsing_rules
@@ -769,11 +1367,83 @@ func (q *Queries) DeleteURLParsingRule(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteWebSubSubscription = `-- name: DeleteWebSubSubscription :exec
+DELETE FROM
+  websub_subscriptions
+WHERE
+  feed_id = ?
+`
+
+func (q *Queries) DeleteWebSubSubscription(ctx context.Context, feedID string) error {
+	_, err := q.db.ExecContext(ctx, deleteWebSubSubscription, feedID)
+	return err
+}
+
+const disableFeed = `-- name: DisableFeed :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  disabled_at,
+  disabled_reason
+) VALUES (
+  ?1, (strftime('%FT%TZ', 'now')), ?2
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  disabled_at = excluded.disabled_at,
+  disabled_reason = excluded.disabled_reason,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type DisableFeedParams struct {
+	FeedID         string  `json:"feed_id"`
+	DisabledReason *string `json:"disabled_reason"`
+}
+
+func (q *Queries) DisableFeed(ctx context.Context, arg DisableFeedParams) error {
+	_, err := q.db.ExecContext(ctx, disableFeed, arg.FeedID, arg.DisabledReason)
+	return err
+}
+
+const findSharedItemByURL = `-- name: FindSharedItemByURL :one
+SELECT
+  i.id
+FROM
+  items i
+WHERE
+  i.url = ?1
+  AND NOT EXISTS (
+    SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id AND fi.feed_id = ?2
+  )
+ORDER BY
+  i.created_at ASC, i.id ASC
+LIMIT 1
+`
+
+type FindSharedItemByURLParams struct {
+	Url    string `json:"url"`
+	FeedID string `json:"feed_id"`
+}
+
+func (q *Queries) FindSharedItemByURL(ctx context.Context, arg FindSharedItemByURLParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, findSharedItemByURL, arg.Url, arg.FeedID)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getFeed = `-- name: GetFeed :one
 SELECT
-  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.created_at, f.updated_at,
+  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.fetch_full_content, f.unread_on_revision, f.title_overridden, f.created_at, f.updated_at,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -783,20 +1453,32 @@ WHERE
 `
 
 type GetFeedRow struct {
-	ID            string  `json:"id"`
-	Url           string  `json:"url"`
-	Link          *string `json:"link"`
-	Title         *string `json:"title"`
-	Description   *string `json:"description"`
-	Lang          *string `json:"lang"`
-	ImageUrl      *string `json:"image_url"`
-	Copyright     *string `json:"copyright"`
-	FeedType      *string `json:"feed_type"`
-	FeedVersion   *string `json:"feed_version"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
+	ID                  string  `json:"id"`
+	Url                 string  `json:"url"`
+	Link                *string `json:"link"`
+	Title               *string `json:"title"`
+	Description         *string `json:"description"`
+	Lang                *string `json:"lang"`
+	ImageUrl            *string `json:"image_url"`
+	Copyright           *string `json:"copyright"`
+	FeedType            *string `json:"feed_type"`
+	FeedVersion         *string `json:"feed_version"`
+	FetchFullContent    int64   `json:"fetch_full_content"`
+	UnreadOnRevision    int64   `json:"unread_on_revision"`
+	TitleOverridden     int64   `json:"title_overridden"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+	LastFetchedAt       *string `json:"last_fetched_at"`
+	NextFetch           *string `json:"next_fetch"`
+	LastStatusCode      *int64  `json:"last_status_code"`
+	LastError           *string `json:"last_error"`
+	LastErrorKind       *string `json:"last_error_kind"`
+	ConsecutiveFailures *int64  `json:"consecutive_failures"`
+	LastSuccessAt       *string `json:"last_success_at"`
+	RedirectUrl         *string `json:"redirect_url"`
+	DisabledAt          *string `json:"disabled_at"`
+	DisabledReason      *string `json:"disabled_reason"`
+	ScheduleReason      *string `json:"schedule_reason"`
 }
 
 func (q *Queries) GetFeed(ctx context.Context, id string) (GetFeedRow, error) {
@@ -813,19 +1495,40 @@ func (q *Queries) GetFeed(ctx context.Context, id string) (GetFeedRow, error) {
 		&i.Copyright,
 		&i.FeedType,
 		&i.FeedVersion,
+		&i.FetchFullContent,
+		&i.UnreadOnRevision,
+		&i.TitleOverridden,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LastFetchedAt,
 		&i.NextFetch,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.LastErrorKind,
+		&i.ConsecutiveFailures,
+		&i.LastSuccessAt,
+		&i.RedirectUrl,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.ScheduleReason,
 	)
 	return i, err
 }
 
 const getFeedByURL = `-- name: GetFeedByURL :one
 SELECT
-  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.created_at, f.updated_at,
+  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.fetch_full_content, f.unread_on_revision, f.title_overridden, f.created_at, f.updated_at,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -835,20 +1538,32 @@ WHERE
 `
 
 type GetFeedByURLRow struct {
-	ID            string  `json:"id"`
-	Url           string  `json:"url"`
-	Link          *string `json:"link"`
-	Title         *string `json:"title"`
-	Description   *string `json:"description"`
-	Lang          *string `json:"lang"`
-	ImageUrl      *string `json:"image_url"`
-	Copyright     *string `json:"copyright"`
-	FeedType      *string `json:"feed_type"`
-	FeedVersion   *string `json:"feed_version"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
+	ID                  string  `json:"id"`
+	Url                 string  `json:"url"`
+	Link                *string `json:"link"`
+	Title               *string `json:"title"`
+	Description         *string `json:"description"`
+	Lang                *string `json:"lang"`
+	ImageUrl            *string `json:"image_url"`
+	Copyright           *string `json:"copyright"`
+	FeedType            *string `json:"feed_type"`
+	FeedVersion         *string `json:"feed_version"`
+	FetchFullContent    int64   `json:"fetch_full_content"`
+	UnreadOnRevision    int64   `json:"unread_on_revision"`
+	TitleOverridden     int64   `json:"title_overridden"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+	LastFetchedAt       *string `json:"last_fetched_at"`
+	NextFetch           *string `json:"next_fetch"`
+	LastStatusCode      *int64  `json:"last_status_code"`
+	LastError           *string `json:"last_error"`
+	LastErrorKind       *string `json:"last_error_kind"`
+	ConsecutiveFailures *int64  `json:"consecutive_failures"`
+	LastSuccessAt       *string `json:"last_success_at"`
+	RedirectUrl         *string `json:"redirect_url"`
+	DisabledAt          *string `json:"disabled_at"`
+	DisabledReason      *string `json:"disabled_reason"`
+	ScheduleReason      *string `json:"schedule_reason"`
 }
 
 func (q *Queries) GetFeedByURL(ctx context.Context, url string) (GetFeedByURLRow, error) {
@@ -865,17 +1580,51 @@ func (q *Queries) GetFeedByURL(ctx context.Context, url string) (GetFeedByURLRow
 		&i.Copyright,
 		&i.FeedType,
 		&i.FeedVersion,
+		&i.FetchFullContent,
+		&i.UnreadOnRevision,
+		&i.TitleOverridden,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LastFetchedAt,
 		&i.NextFetch,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.LastErrorKind,
+		&i.ConsecutiveFailures,
+		&i.LastSuccessAt,
+		&i.RedirectUrl,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.ScheduleReason,
+	)
+	return i, err
+}
+
+const getFeedCredential = `-- name: GetFeedCredential :one
+SELECT
+  feed_id, auth_type, encrypted_data, created_at, updated_at
+FROM
+  feed_credentials
+WHERE
+  feed_id = ?
+`
+
+func (q *Queries) GetFeedCredential(ctx context.Context, feedID string) (FeedCredential, error) {
+	row := q.db.QueryRowContext(ctx, getFeedCredential, feedID)
+	var i FeedCredential
+	err := row.Scan(
+		&i.FeedID,
+		&i.AuthType,
+		&i.EncryptedData,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const getFeedFetcher = `-- name: GetFeedFetcher :one
 SELECT
-  feed_id, etag, last_modified, last_fetched_at, next_fetch, created_at, updated_at
+  feed_id, etag, last_modified, last_fetched_at, next_fetch, last_status_code, last_error, last_error_kind, consecutive_failures, last_success_at, redirect_url, redirect_count, disabled_at, disabled_reason, hint_max_age, hint_ttl, hint_update_period, hint_skip_hours, hint_skip_days, schedule_reason, created_at, updated_at
 FROM
   feed_fetcher
 WHERE
@@ -891,6 +1640,71 @@ func (q *Queries) GetFeedFetcher(ctx context.Context, feedID string) (FeedFetche
 		&i.LastModified,
 		&i.LastFetchedAt,
 		&i.NextFetch,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.LastErrorKind,
+		&i.ConsecutiveFailures,
+		&i.LastSuccessAt,
+		&i.RedirectUrl,
+		&i.RedirectCount,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.HintMaxAge,
+		&i.HintTtl,
+		&i.HintUpdatePeriod,
+		&i.HintSkipHours,
+		&i.HintSkipDays,
+		&i.ScheduleReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getFeedIcon = `-- name: GetFeedIcon :one
+SELECT
+  feed_id, source_url, mime_type, data, error, fetched_at, created_at, updated_at
+FROM
+  feed_icons
+WHERE
+  feed_id = ?
+`
+
+func (q *Queries) GetFeedIcon(ctx context.Context, feedID string) (FeedIcon, error) {
+	row := q.db.QueryRowContext(ctx, getFeedIcon, feedID)
+	var i FeedIcon
+	err := row.Scan(
+		&i.FeedID,
+		&i.SourceUrl,
+		&i.MimeType,
+		&i.Data,
+		&i.Error,
+		&i.FetchedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getFeedScraper = `-- name: GetFeedScraper :one
+SELECT
+  feed_id, item_selector, title_selector, link_selector, date_selector, content_selector, created_at, updated_at
+FROM
+  feed_scrapers
+WHERE
+  feed_id = ?
+`
+
+func (q *Queries) GetFeedScraper(ctx context.Context, feedID string) (FeedScraper, error) {
+	row := q.db.QueryRowContext(ctx, getFeedScraper, feedID)
+	var i FeedScraper
+	err := row.Scan(
+		&i.FeedID,
+		&i.ItemSelector,
+		&i.TitleSelector,
+		&i.LinkSelector,
+		&i.DateSelector,
+		&i.ContentSelector,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -973,32 +1787,58 @@ SELECT
   i.image_url,
   i.categories,
   i.created_at,
+  i.revised_at,
+  i.description_html,
+  i.content_html,
   fi.feed_id,
-  CAST(COALESCE(ir.is_read, 0) AS INTEGER) AS is_read
+  CAST(COALESCE(ir.is_read, 0) AS INTEGER) AS is_read,
+  CAST(COALESCE(st.is_starred, 0) AS INTEGER) AS is_starred,
+  st.starred_at,
+  st.note,
+  st.user_tags,
+  fc.content AS full_content,
+  fc.content_html AS full_content_html,
+  fc.error AS full_content_error,
+  fc.fetched_at AS full_content_fetched_at
 FROM
   items i
 JOIN
   feed_items fi ON i.id = fi.item_id
 LEFT JOIN
   item_reads ir ON i.id = ir.item_id
+LEFT JOIN
+  item_stars st ON i.id = st.item_id
+LEFT JOIN
+  item_full_contents fc ON i.id = fc.item_id
 WHERE
   i.id = ?
 `
 
 type GetItemRow struct {
-	ID          string  `json:"id"`
-	Url         string  `json:"url"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	PublishedAt *string `json:"published_at"`
-	Author      *string `json:"author"`
-	Guid        *string `json:"guid"`
-	Content     *string `json:"content"`
-	ImageUrl    *string `json:"image_url"`
-	Categories  *string `json:"categories"`
-	CreatedAt   string  `json:"created_at"`
-	FeedID      string  `json:"feed_id"`
-	IsRead      int64   `json:"is_read"`
+	ID                   string  `json:"id"`
+	Url                  string  `json:"url"`
+	Title                *string `json:"title"`
+	Description          *string `json:"description"`
+	PublishedAt          *string `json:"published_at"`
+	Author               *string `json:"author"`
+	Guid                 *string `json:"guid"`
+	Content              *string `json:"content"`
+	ImageUrl             *string `json:"image_url"`
+	Categories           *string `json:"categories"`
+	CreatedAt            string  `json:"created_at"`
+	RevisedAt            *string `json:"revised_at"`
+	DescriptionHtml      *string `json:"description_html"`
+	ContentHtml          *string `json:"content_html"`
+	FeedID               string  `json:"feed_id"`
+	IsRead               int64   `json:"is_read"`
+	IsStarred            int64   `json:"is_starred"`
+	StarredAt            *string `json:"starred_at"`
+	Note                 *string `json:"note"`
+	UserTags             *string `json:"user_tags"`
+	FullContent          *string `json:"full_content"`
+	FullContentHtml      *string `json:"full_content_html"`
+	FullContentError     *string `json:"full_content_error"`
+	FullContentFetchedAt *string `json:"full_content_fetched_at"`
 }
 
 func (q *Queries) GetItem(ctx context.Context, id string) (GetItemRow, error) {
@@ -1016,8 +1856,19 @@ func (q *Queries) GetItem(ctx context.Context, id string) (GetItemRow, error) {
 		&i.ImageUrl,
 		&i.Categories,
 		&i.CreatedAt,
+		&i.RevisedAt,
+		&i.DescriptionHtml,
+		&i.ContentHtml,
 		&i.FeedID,
 		&i.IsRead,
+		&i.IsStarred,
+		&i.StarredAt,
+		&i.Note,
+		&i.UserTags,
+		&i.FullContent,
+		&i.FullContentHtml,
+		&i.FullContentError,
+		&i.FullContentFetchedAt,
 	)
 	return i, err
 }
@@ -1053,51 +1904,327 @@ func (q *Queries) GetItemBlockRuleByValue(ctx context.Context, arg GetItemBlockR
 	return i, err
 }
 
-const getTagByName = `-- name: GetTagByName :one
+const getItemCountsVersion = `-- name: GetItemCountsVersion :one
 SELECT
-  id, name, created_at, updated_at
+  version
 FROM
-  tags
+  item_counts_version
 WHERE
-  name = ?
+  id = 1
 `
 
-func (q *Queries) GetTagByName(ctx context.Context, name string) (Tag, error) {
-	row := q.db.QueryRowContext(ctx, getTagByName, name)
-	var i Tag
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+func (q *Queries) GetItemCountsVersion(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getItemCountsVersion)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
 }
 
-const getURLParsingRule = `-- name: GetURLParsingRule :one
+const getItemFullContent = `-- name: GetItemFullContent :one
 SELECT
-  id, domain, rule_type, pattern, created_at, updated_at
+  item_id, content, content_html, error, fetched_at, created_at, updated_at
 FROM
-  url_parsing_rules
+  item_full_contents
 WHERE
-  id = ?
+  item_id = ?
 `
 
-func (q *Queries) GetURLParsingRule(ctx context.Context, id string) (UrlParsingRule, error) {
-	row := q.db.QueryRowContext(ctx, getURLParsingRule, id)
-	var i UrlParsingRule
+func (q *Queries) GetItemFullContent(ctx context.Context, itemID string) (ItemFullContent, error) {
+	row := q.db.QueryRowContext(ctx, getItemFullContent, itemID)
+	var i ItemFullContent
 	err := row.Scan(
-		&i.ID,
-		&i.Domain,
-		&i.RuleType,
-		&i.Pattern,
+		&i.ItemID,
+		&i.Content,
+		&i.ContentHtml,
+		&i.Error,
+		&i.FetchedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const getURLParsingRuleByDomain = `-- name: GetURLParsingRuleByDomain :one
+const getItemIDByIdentity = `-- name: GetItemIDByIdentity :one
+SELECT
+  item_id
+FROM
+  item_identities
+WHERE
+  feed_id = ? AND identity = ?
+`
+
+type GetItemIDByIdentityParams struct {
+	FeedID   string `json:"feed_id"`
+	Identity string `json:"identity"`
+}
+
+func (q *Queries) GetItemIDByIdentity(ctx context.Context, arg GetItemIDByIdentityParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getItemIDByIdentity, arg.FeedID, arg.Identity)
+	var item_id string
+	err := row.Scan(&item_id)
+	return item_id, err
+}
+
+const getItemRevision = `-- name: GetItemRevision :one
+SELECT
+  item_id, revision, content_hash, title, description, content, created_at, feed_id
+FROM
+  item_revisions
+WHERE
+  item_id = ? AND revision = ?
+`
+
+type GetItemRevisionParams struct {
+	ItemID   string `json:"item_id"`
+	Revision int64  `json:"revision"`
+}
+
+func (q *Queries) GetItemRevision(ctx context.Context, arg GetItemRevisionParams) (ItemRevision, error) {
+	row := q.db.QueryRowContext(ctx, getItemRevision, arg.ItemID, arg.Revision)
+	var i ItemRevision
+	err := row.Scan(
+		&i.ItemID,
+		&i.Revision,
+		&i.ContentHash,
+		&i.Title,
+		&i.Description,
+		&i.Content,
+		&i.CreatedAt,
+		&i.FeedID,
+	)
+	return i, err
+}
+
+const getItemStar = `-- name: GetItemStar :one
+SELECT item_id, is_starred, starred_at, note, user_tags, created_at, updated_at FROM item_stars WHERE item_id = ?
+`
+
+func (q *Queries) GetItemStar(ctx context.Context, itemID string) (ItemStar, error) {
+	row := q.db.QueryRowContext(ctx, getItemStar, itemID)
+	var i ItemStar
+	err := row.Scan(
+		&i.ItemID,
+		&i.IsStarred,
+		&i.StarredAt,
+		&i.Note,
+		&i.UserTags,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLatestItemRevision = `-- name: GetLatestItemRevision :one
+SELECT
+  item_id, revision, content_hash, title, description, content, created_at, feed_id
+FROM
+  item_revisions
+WHERE
+  item_id = ?
+ORDER BY
+  revision DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestItemRevision(ctx context.Context, itemID string) (ItemRevision, error) {
+	row := q.db.QueryRowContext(ctx, getLatestItemRevision, itemID)
+	var i ItemRevision
+	err := row.Scan(
+		&i.ItemID,
+		&i.Revision,
+		&i.ContentHash,
+		&i.Title,
+		&i.Description,
+		&i.Content,
+		&i.CreatedAt,
+		&i.FeedID,
+	)
+	return i, err
+}
+
+const getSavedItem = `-- name: GetSavedItem :one
+SELECT
+  id, url, title, description, published_at, author, guid, content, image_url, categories, revised_at, description_html, content_html, created_at, updated_at
+FROM
+  items
+WHERE
+  id = ?
+`
+
+func (q *Queries) GetSavedItem(ctx context.Context, id string) (Item, error) {
+	row := q.db.QueryRowContext(ctx, getSavedItem, id)
+	var i Item
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Title,
+		&i.Description,
+		&i.PublishedAt,
+		&i.Author,
+		&i.Guid,
+		&i.Content,
+		&i.ImageUrl,
+		&i.Categories,
+		&i.RevisedAt,
+		&i.DescriptionHtml,
+		&i.ContentHtml,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSavedSearch = `-- name: GetSavedSearch :one
+SELECT id, name, "query", match_query, is_read, is_starred, author, since, until, max_age_days, created_at, updated_at FROM saved_searches WHERE id = ?
+`
+
+func (q *Queries) GetSavedSearch(ctx context.Context, id string) (SavedSearch, error) {
+	row := q.db.QueryRowContext(ctx, getSavedSearch, id)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Query,
+		&i.MatchQuery,
+		&i.IsRead,
+		&i.IsStarred,
+		&i.Author,
+		&i.Since,
+		&i.Until,
+		&i.MaxAgeDays,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSavedSearchCountsExpiry = `-- name: GetSavedSearchCountsExpiry :one
+SELECT
+  CAST(COALESCE(MIN(strftime('%FT%TZ', i.created_at, '+' || ss.max_age_days || ' days')), '') AS TEXT) AS expires_at
+FROM
+  saved_searches ss
+JOIN
+  items i ON i.created_at >= strftime('%FT%TZ', 'now', '-' || ss.max_age_days || ' days')
+WHERE
+  ss.max_age_days IS NOT NULL
+`
+
+// The earliest time an item leaves a saved search with max_age_days by
+// getting too old for it, which changes its counts without any write. It is
+// empty when no item will.
+func (q *Queries) GetSavedSearchCountsExpiry(ctx context.Context) (string, error) {
+	row := q.db.QueryRowContext(ctx, getSavedSearchCountsExpiry)
+	var expires_at string
+	err := row.Scan(&expires_at)
+	return expires_at, err
+}
+
+const getTagByName = `-- name: GetTagByName :one
+SELECT
+  id, name, created_at, updated_at
+FROM
+  tags
+WHERE
+  name = ?
+`
+
+func (q *Queries) GetTagByName(ctx context.Context, name string) (Tag, error) {
+	row := q.db.QueryRowContext(ctx, getTagByName, name)
+	var i Tag
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTransportProfile = `-- name: GetTransportProfile :one
+SELECT id, name, proxy_url, ca_bundle, client_cert, client_key, insecure_skip_verify, timeout_seconds, retry_max, created_at, updated_at FROM transport_profiles WHERE id = ?
+`
+
+func (q *Queries) GetTransportProfile(ctx context.Context, id string) (TransportProfile, error) {
+	row := q.db.QueryRowContext(ctx, getTransportProfile, id)
+	var i TransportProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ProxyUrl,
+		&i.CaBundle,
+		&i.ClientCert,
+		&i.ClientKey,
+		&i.InsecureSkipVerify,
+		&i.TimeoutSeconds,
+		&i.RetryMax,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTransportProfileForFeed = `-- name: GetTransportProfileForFeed :one
+SELECT tp.id, tp.name, tp.proxy_url, tp.ca_bundle, tp.client_cert, tp.client_key, tp.insecure_skip_verify, tp.timeout_seconds, tp.retry_max, tp.created_at, tp.updated_at
+FROM transport_profiles tp
+WHERE tp.id = COALESCE(
+  (SELECT ftp.transport_profile_id FROM feed_transport_profiles ftp WHERE ftp.feed_id = ?1),
+  (
+    SELECT ttp.transport_profile_id
+    FROM feed_tags ft
+    JOIN tag_transport_profiles ttp ON ft.tag_id = ttp.tag_id
+    JOIN tags t ON t.id = ft.tag_id
+    WHERE ft.feed_id = ?1
+    ORDER BY t.name ASC
+    LIMIT 1
+  )
+)
+`
+
+// A profile assigned to the feed wins over profiles assigned to its tags;
+// among tags, the first by name wins.
+func (q *Queries) GetTransportProfileForFeed(ctx context.Context, feedID string) (TransportProfile, error) {
+	row := q.db.QueryRowContext(ctx, getTransportProfileForFeed, feedID)
+	var i TransportProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ProxyUrl,
+		&i.CaBundle,
+		&i.ClientCert,
+		&i.ClientKey,
+		&i.InsecureSkipVerify,
+		&i.TimeoutSeconds,
+		&i.RetryMax,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getURLParsingRule = `-- name: GetURLParsingRule :one
+SELECT
+  id, domain, rule_type, pattern, created_at, updated_at
+FROM
+  url_parsing_rules
+WHERE
+  id = ?
+`
+
+func (q *Queries) GetURLParsingRule(ctx context.Context, id string) (UrlParsingRule, error) {
+	row := q.db.QueryRowContext(ctx, getURLParsingRule, id)
+	var i UrlParsingRule
+	err := row.Scan(
+		&i.ID,
+		&i.Domain,
+		&i.RuleType,
+		&i.Pattern,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getURLParsingRuleByDomain = `-- name: GetURLParsingRuleByDomain :one
 SELECT
   id, domain, rule_type, pattern, created_at, updated_at
 FROM
@@ -1125,6 +2252,32 @@ func (q *Queries) GetURLParsingRuleByDomain(ctx context.Context, arg GetURLParsi
 	return i, err
 }
 
+const getWebSubSubscription = `-- name: GetWebSubSubscription :one
+SELECT
+  feed_id, hub_url, topic_url, secret, state, lease_seconds, lease_expires_at, created_at, updated_at
+FROM
+  websub_subscriptions
+WHERE
+  feed_id = ?
+`
+
+func (q *Queries) GetWebSubSubscription(ctx context.Context, feedID string) (WebsubSubscription, error) {
+	row := q.db.QueryRowContext(ctx, getWebSubSubscription, feedID)
+	var i WebsubSubscription
+	err := row.Scan(
+		&i.FeedID,
+		&i.HubUrl,
+		&i.TopicUrl,
+		&i.Secret,
+		&i.State,
+		&i.LeaseSeconds,
+		&i.LeaseExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const listActiveIgnoreWindowsForFeed = `-- name: ListActiveIgnoreWindowsForFeed :many
 SELECT DISTINCT iw.id, iw.name, iw.start_time, iw.end_time, iw.days_of_week, iw.timezone, iw.created_at, iw.updated_at
 FROM ignore_windows iw
@@ -1167,6 +2320,71 @@ func (q *Queries) ListActiveIgnoreWindowsForFeed(ctx context.Context, feedID str
 	return items, nil
 }
 
+const listCategoryFacets = `-- name: ListCategoryFacets :many
+SELECT
+  c.name,
+  CAST(COUNT(*) AS INTEGER) AS item_count,
+  CAST(SUM(CASE WHEN COALESCE(ir.is_read, 0) = 0 THEN 1 ELSE 0 END) AS INTEGER) AS unread_count
+FROM
+  categories c
+  JOIN item_categories ic ON ic.category_id = c.id
+  LEFT JOIN item_reads ir ON ir.item_id = ic.item_id
+WHERE
+  EXISTS (
+    SELECT 1 FROM feed_items fi
+    WHERE fi.item_id = ic.item_id AND
+    (?1 IS NULL OR fi.feed_id = ?1) AND
+    (?2 IS NULL OR EXISTS (
+      SELECT 1 FROM feed_tags ft WHERE ft.feed_id = fi.feed_id AND ft.tag_id = ?2
+    ))
+  ) AND
+  NOT EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = ic.item_id)
+GROUP BY
+  c.id
+ORDER BY
+  item_count DESC,
+  c.name ASC
+LIMIT ?3
+`
+
+type ListCategoryFacetsParams struct {
+	FeedID interface{} `json:"feed_id"`
+	TagID  interface{} `json:"tag_id"`
+	Limit  int64       `json:"limit"`
+}
+
+type ListCategoryFacetsRow struct {
+	Name        string `json:"name"`
+	ItemCount   int64  `json:"item_count"`
+	UnreadCount int64  `json:"unread_count"`
+}
+
+// Categories with the number of items and unread items in each, optionally
+// restricted to a feed or to the feeds with a tag. Blocked items are not
+// counted.
+func (q *Queries) ListCategoryFacets(ctx context.Context, arg ListCategoryFacetsParams) ([]ListCategoryFacetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCategoryFacets, arg.FeedID, arg.TagID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryFacetsRow
+	for rows.Next() {
+		var i ListCategoryFacetsRow
+		if err := rows.Scan(&i.Name, &i.ItemCount, &i.UnreadCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFeedIgnoreWindows = `-- name: ListFeedIgnoreWindows :many
 SELECT feed_id, ignore_window_id FROM feed_ignore_windows
 WHERE
@@ -1207,6 +2425,75 @@ func (q *Queries) ListFeedIgnoreWindows(ctx context.Context, arg ListFeedIgnoreW
 	return items, nil
 }
 
+const listFeedItemsWithoutIdentity = `-- name: ListFeedItemsWithoutIdentity :many
+SELECT
+  fi.feed_id,
+  i.id,
+  i.url,
+  i.title,
+  i.description,
+  i.guid,
+  i.content
+FROM
+  feed_items fi
+  JOIN items i ON i.id = fi.item_id
+WHERE
+  NOT EXISTS (
+    SELECT 1 FROM item_identities ii WHERE ii.feed_id = fi.feed_id AND ii.item_id = fi.item_id
+  )
+  AND (fi.feed_id > ?1 OR (fi.feed_id = ?1 AND fi.item_id > ?2))
+ORDER BY
+  fi.feed_id ASC, fi.item_id ASC
+LIMIT ?3
+`
+
+type ListFeedItemsWithoutIdentityParams struct {
+	AfterFeedID string `json:"after_feed_id"`
+	AfterItemID string `json:"after_item_id"`
+	Limit       int64  `json:"limit"`
+}
+
+type ListFeedItemsWithoutIdentityRow struct {
+	FeedID      string  `json:"feed_id"`
+	ID          string  `json:"id"`
+	Url         string  `json:"url"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Guid        *string `json:"guid"`
+	Content     *string `json:"content"`
+}
+
+func (q *Queries) ListFeedItemsWithoutIdentity(ctx context.Context, arg ListFeedItemsWithoutIdentityParams) ([]ListFeedItemsWithoutIdentityRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFeedItemsWithoutIdentity, arg.AfterFeedID, arg.AfterItemID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFeedItemsWithoutIdentityRow
+	for rows.Next() {
+		var i ListFeedItemsWithoutIdentityRow
+		if err := rows.Scan(
+			&i.FeedID,
+			&i.ID,
+			&i.Url,
+			&i.Title,
+			&i.Description,
+			&i.Guid,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFeedTags = `-- name: ListFeedTags :many
 SELECT
   feed_id,
@@ -1251,11 +2538,60 @@ func (q *Queries) ListFeedTags(ctx context.Context, arg ListFeedTagsParams) ([]L
 	return items, nil
 }
 
+const listFeedTransportProfiles = `-- name: ListFeedTransportProfiles :many
+SELECT feed_id, transport_profile_id FROM feed_transport_profiles
+WHERE
+  (?1 IS NULL OR feed_id = ?1)
+  AND (?2 IS NULL OR transport_profile_id = ?2)
+`
+
+type ListFeedTransportProfilesParams struct {
+	FeedID             interface{} `json:"feed_id"`
+	TransportProfileID interface{} `json:"transport_profile_id"`
+}
+
+type ListFeedTransportProfilesRow struct {
+	FeedID             string `json:"feed_id"`
+	TransportProfileID string `json:"transport_profile_id"`
+}
+
+func (q *Queries) ListFeedTransportProfiles(ctx context.Context, arg ListFeedTransportProfilesParams) ([]ListFeedTransportProfilesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFeedTransportProfiles, arg.FeedID, arg.TransportProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFeedTransportProfilesRow
+	for rows.Next() {
+		var i ListFeedTransportProfilesRow
+		if err := rows.Scan(&i.FeedID, &i.TransportProfileID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFeeds = `-- name: ListFeeds :many
 SELECT
-  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.created_at, f.updated_at,
+  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.fetch_full_content, f.unread_on_revision, f.title_overridden, f.created_at, f.updated_at,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -1269,20 +2605,32 @@ ORDER BY
 `
 
 type ListFeedsRow struct {
-	ID            string  `json:"id"`
-	Url           string  `json:"url"`
-	Link          *string `json:"link"`
-	Title         *string `json:"title"`
-	Description   *string `json:"description"`
-	Lang          *string `json:"lang"`
-	ImageUrl      *string `json:"image_url"`
-	Copyright     *string `json:"copyright"`
-	FeedType      *string `json:"feed_type"`
-	FeedVersion   *string `json:"feed_version"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
+	ID                  string  `json:"id"`
+	Url                 string  `json:"url"`
+	Link                *string `json:"link"`
+	Title               *string `json:"title"`
+	Description         *string `json:"description"`
+	Lang                *string `json:"lang"`
+	ImageUrl            *string `json:"image_url"`
+	Copyright           *string `json:"copyright"`
+	FeedType            *string `json:"feed_type"`
+	FeedVersion         *string `json:"feed_version"`
+	FetchFullContent    int64   `json:"fetch_full_content"`
+	UnreadOnRevision    int64   `json:"unread_on_revision"`
+	TitleOverridden     int64   `json:"title_overridden"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+	LastFetchedAt       *string `json:"last_fetched_at"`
+	NextFetch           *string `json:"next_fetch"`
+	LastStatusCode      *int64  `json:"last_status_code"`
+	LastError           *string `json:"last_error"`
+	LastErrorKind       *string `json:"last_error_kind"`
+	ConsecutiveFailures *int64  `json:"consecutive_failures"`
+	LastSuccessAt       *string `json:"last_success_at"`
+	RedirectUrl         *string `json:"redirect_url"`
+	DisabledAt          *string `json:"disabled_at"`
+	DisabledReason      *string `json:"disabled_reason"`
+	ScheduleReason      *string `json:"schedule_reason"`
 }
 
 func (q *Queries) ListFeeds(ctx context.Context, tagID interface{}) ([]ListFeedsRow, error) {
@@ -1305,10 +2653,22 @@ func (q *Queries) ListFeeds(ctx context.Context, tagID interface{}) ([]ListFeeds
 			&i.Copyright,
 			&i.FeedType,
 			&i.FeedVersion,
+			&i.FetchFullContent,
+			&i.UnreadOnRevision,
+			&i.TitleOverridden,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LastFetchedAt,
 			&i.NextFetch,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.LastErrorKind,
+			&i.ConsecutiveFailures,
+			&i.LastSuccessAt,
+			&i.RedirectUrl,
+			&i.DisabledAt,
+			&i.DisabledReason,
+			&i.ScheduleReason,
 		); err != nil {
 			return nil, err
 		}
@@ -1325,9 +2685,18 @@ func (q *Queries) ListFeeds(ctx context.Context, tagID interface{}) ([]ListFeeds
 
 const listFeedsByIDs = `-- name: ListFeedsByIDs :many
 SELECT
-  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.created_at, f.updated_at,
+  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.fetch_full_content, f.unread_on_revision, f.title_overridden, f.created_at, f.updated_at,
   ff.last_fetched_at,
-  ff.next_fetch
+  ff.next_fetch,
+  ff.last_status_code,
+  ff.last_error,
+  ff.last_error_kind,
+  ff.consecutive_failures,
+  ff.last_success_at,
+  ff.redirect_url,
+  ff.disabled_at,
+  ff.disabled_reason,
+  ff.schedule_reason
 FROM
   feeds f
 LEFT JOIN
@@ -1337,20 +2706,32 @@ WHERE
 `
 
 type ListFeedsByIDsRow struct {
-	ID            string  `json:"id"`
-	Url           string  `json:"url"`
-	Link          *string `json:"link"`
-	Title         *string `json:"title"`
-	Description   *string `json:"description"`
-	Lang          *string `json:"lang"`
-	ImageUrl      *string `json:"image_url"`
-	Copyright     *string `json:"copyright"`
-	FeedType      *string `json:"feed_type"`
-	FeedVersion   *string `json:"feed_version"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
+	ID                  string  `json:"id"`
+	Url                 string  `json:"url"`
+	Link                *string `json:"link"`
+	Title               *string `json:"title"`
+	Description         *string `json:"description"`
+	Lang                *string `json:"lang"`
+	ImageUrl            *string `json:"image_url"`
+	Copyright           *string `json:"copyright"`
+	FeedType            *string `json:"feed_type"`
+	FeedVersion         *string `json:"feed_version"`
+	FetchFullContent    int64   `json:"fetch_full_content"`
+	UnreadOnRevision    int64   `json:"unread_on_revision"`
+	TitleOverridden     int64   `json:"title_overridden"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+	LastFetchedAt       *string `json:"last_fetched_at"`
+	NextFetch           *string `json:"next_fetch"`
+	LastStatusCode      *int64  `json:"last_status_code"`
+	LastError           *string `json:"last_error"`
+	LastErrorKind       *string `json:"last_error_kind"`
+	ConsecutiveFailures *int64  `json:"consecutive_failures"`
+	LastSuccessAt       *string `json:"last_success_at"`
+	RedirectUrl         *string `json:"redirect_url"`
+	DisabledAt          *string `json:"disabled_at"`
+	DisabledReason      *string `json:"disabled_reason"`
+	ScheduleReason      *string `json:"schedule_reason"`
 }
 
 func (q *Queries) ListFeedsByIDs(ctx context.Context, ids []string) ([]ListFeedsByIDsRow, error) {
@@ -1383,10 +2764,22 @@ func (q *Queries) ListFeedsByIDs(ctx context.Context, ids []string) ([]ListFeeds
 			&i.Copyright,
 			&i.FeedType,
 			&i.FeedVersion,
+			&i.FetchFullContent,
+			&i.UnreadOnRevision,
+			&i.TitleOverridden,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LastFetchedAt,
 			&i.NextFetch,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.LastErrorKind,
+			&i.ConsecutiveFailures,
+			&i.LastSuccessAt,
+			&i.RedirectUrl,
+			&i.DisabledAt,
+			&i.DisabledReason,
+			&i.ScheduleReason,
 		); err != nil {
 			return nil, err
 		}
@@ -1403,7 +2796,7 @@ func (q *Queries) ListFeedsByIDs(ctx context.Context, ids []string) ([]ListFeeds
 
 const listFeedsToFetch = `-- name: ListFeedsToFetch :many
 SELECT
-  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.created_at, f.updated_at,
+  f.id, f.url, f.link, f.title, f.description, f.lang, f.image_url, f.copyright, f.feed_type, f.feed_version, f.fetch_full_content, f.unread_on_revision, f.title_overridden, f.created_at, f.updated_at,
   ff.last_fetched_at,
   ff.next_fetch,
   ff.etag,
@@ -1413,28 +2806,32 @@ FROM
 LEFT JOIN
   feed_fetcher ff ON f.id = ff.feed_id
 WHERE
-  ff.next_fetch IS NULL OR ff.next_fetch <= (strftime('%Y-%m-%dT%H:%M:%SZ', 'now'))
+  (ff.next_fetch IS NULL OR ff.next_fetch <= (strftime('%Y-%m-%dT%H:%M:%SZ', 'now')))
+  AND ff.disabled_at IS NULL
 ORDER BY
   ff.next_fetch ASC
 `
 
 type ListFeedsToFetchRow struct {
-	ID            string  `json:"id"`
-	Url           string  `json:"url"`
-	Link          *string `json:"link"`
-	Title         *string `json:"title"`
-	Description   *string `json:"description"`
-	Lang          *string `json:"lang"`
-	ImageUrl      *string `json:"image_url"`
-	Copyright     *string `json:"copyright"`
-	FeedType      *string `json:"feed_type"`
-	FeedVersion   *string `json:"feed_version"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
-	Etag          *string `json:"etag"`
-	LastModified  *string `json:"last_modified"`
+	ID               string  `json:"id"`
+	Url              string  `json:"url"`
+	Link             *string `json:"link"`
+	Title            *string `json:"title"`
+	Description      *string `json:"description"`
+	Lang             *string `json:"lang"`
+	ImageUrl         *string `json:"image_url"`
+	Copyright        *string `json:"copyright"`
+	FeedType         *string `json:"feed_type"`
+	FeedVersion      *string `json:"feed_version"`
+	FetchFullContent int64   `json:"fetch_full_content"`
+	UnreadOnRevision int64   `json:"unread_on_revision"`
+	TitleOverridden  int64   `json:"title_overridden"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+	LastFetchedAt    *string `json:"last_fetched_at"`
+	NextFetch        *string `json:"next_fetch"`
+	Etag             *string `json:"etag"`
+	LastModified     *string `json:"last_modified"`
 }
 
 func (q *Queries) ListFeedsToFetch(ctx context.Context) ([]ListFeedsToFetchRow, error) {
@@ -1457,6 +2854,9 @@ func (q *Queries) ListFeedsToFetch(ctx context.Context) ([]ListFeedsToFetchRow,
 			&i.Copyright,
 			&i.FeedType,
 			&i.FeedVersion,
+			&i.FetchFullContent,
+			&i.UnreadOnRevision,
+			&i.TitleOverridden,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LastFetchedAt,
@@ -1662,6 +3062,103 @@ func (q *Queries) ListItemBlocks(ctx context.Context, itemID string) ([]ItemBloc
 	return items, nil
 }
 
+const listItemEnclosures = `-- name: ListItemEnclosures :many
+SELECT
+  item_id, position, url, mime_type, length, duration_seconds, thumbnail_url, created_at
+FROM
+  item_enclosures
+WHERE
+  item_id = ?
+ORDER BY
+  position ASC
+`
+
+func (q *Queries) ListItemEnclosures(ctx context.Context, itemID string) ([]ItemEnclosure, error) {
+	rows, err := q.db.QueryContext(ctx, listItemEnclosures, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemEnclosure
+	for rows.Next() {
+		var i ItemEnclosure
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.Position,
+			&i.Url,
+			&i.MimeType,
+			&i.Length,
+			&i.DurationSeconds,
+			&i.ThumbnailUrl,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listItemEnclosuresByItemIDs = `-- name: ListItemEnclosuresByItemIDs :many
+SELECT
+  item_id, position, url, mime_type, length, duration_seconds, thumbnail_url, created_at
+FROM
+  item_enclosures
+WHERE
+  item_id IN (/*SLICE:item_ids*/?)
+ORDER BY
+  item_id ASC,
+  position ASC
+`
+
+func (q *Queries) ListItemEnclosuresByItemIDs(ctx context.Context, itemIds []string) ([]ItemEnclosure, error) {
+	query := listItemEnclosuresByItemIDs
+	var queryParams []interface{}
+	if len(itemIds) > 0 {
+		for _, v := range itemIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:item_ids*/?", strings.Repeat(",?", len(itemIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:item_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemEnclosure
+	for rows.Next() {
+		var i ItemEnclosure
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.Position,
+			&i.Url,
+			&i.MimeType,
+			&i.Length,
+			&i.DurationSeconds,
+			&i.ThumbnailUrl,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listItemFeeds = `-- name: ListItemFeeds :many
 SELECT
   fi.feed_id,
@@ -1714,28 +3211,190 @@ func (q *Queries) ListItemFeeds(ctx context.Context, itemID string) ([]ListItemF
 	return items, nil
 }
 
-const listItemRead = `-- name: ListItemRead :many
+const listItemFullContentsWithHTML = `-- name: ListItemFullContentsWithHTML :many
+SELECT
+  item_id,
+  content_html
+FROM
+  item_full_contents
+WHERE
+  content_html IS NOT NULL AND
+  item_id > ?1
+ORDER BY
+  item_id ASC
+LIMIT ?2
+`
+
+type ListItemFullContentsWithHTMLParams struct {
+	AfterItemID string `json:"after_item_id"`
+	Limit       int64  `json:"limit"`
+}
+
+type ListItemFullContentsWithHTMLRow struct {
+	ItemID      string  `json:"item_id"`
+	ContentHtml *string `json:"content_html"`
+}
+
+func (q *Queries) ListItemFullContentsWithHTML(ctx context.Context, arg ListItemFullContentsWithHTMLParams) ([]ListItemFullContentsWithHTMLRow, error) {
+	rows, err := q.db.QueryContext(ctx, listItemFullContentsWithHTML, arg.AfterItemID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListItemFullContentsWithHTMLRow
+	for rows.Next() {
+		var i ListItemFullContentsWithHTMLRow
+		if err := rows.Scan(&i.ItemID, &i.ContentHtml); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listItemRead = `-- name: ListItemRead :many
+SELECT
+  item_id,
+  is_read,
+  updated_at
+FROM
+  item_reads
+WHERE
+  (
+    -- Cursor-based pagination: both cursor params set
+    ?1 IS NOT NULL
+    AND ?2 IS NOT NULL
+    AND (updated_at, item_id) > (?1, ?2)
+  )
+  OR (
+    -- Fallback to updated_after when cursor is not fully set
+    (?1 IS NULL OR ?2 IS NULL)
+    AND ?3 IS NOT NULL
+    AND (updated_at, item_id) > (?3, '')
+  )
+  OR (
+    -- No cursor and no updated_after: no filtering (match all)
+    (?1 IS NULL OR ?2 IS NULL)
+    AND ?3 IS NULL
+  )
+ORDER BY
+  updated_at ASC,
+  item_id ASC
+LIMIT ?4
+`
+
+type ListItemReadParams struct {
+	UpdatedAtCursor interface{} `json:"updated_at_cursor"`
+	ItemIDCursor    interface{} `json:"item_id_cursor"`
+	UpdatedAfter    interface{} `json:"updated_after"`
+	Limit           int64       `json:"limit"`
+}
+
+type ListItemReadRow struct {
+	ItemID    string `json:"item_id"`
+	IsRead    int64  `json:"is_read"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func (q *Queries) ListItemRead(ctx context.Context, arg ListItemReadParams) ([]ListItemReadRow, error) {
+	rows, err := q.db.QueryContext(ctx, listItemRead,
+		arg.UpdatedAtCursor,
+		arg.ItemIDCursor,
+		arg.UpdatedAfter,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListItemReadRow
+	for rows.Next() {
+		var i ListItemReadRow
+		if err := rows.Scan(&i.ItemID, &i.IsRead, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listItemRevisions = `-- name: ListItemRevisions :many
+SELECT
+  item_id, revision, content_hash, title, description, content, created_at, feed_id
+FROM
+  item_revisions
+WHERE
+  item_id = ?
+ORDER BY
+  revision DESC
+`
+
+func (q *Queries) ListItemRevisions(ctx context.Context, itemID string) ([]ItemRevision, error) {
+	rows, err := q.db.QueryContext(ctx, listItemRevisions, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemRevision
+	for rows.Next() {
+		var i ItemRevision
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.Revision,
+			&i.ContentHash,
+			&i.Title,
+			&i.Description,
+			&i.Content,
+			&i.CreatedAt,
+			&i.FeedID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listItemStars = `-- name: ListItemStars :many
 SELECT
   item_id,
-  is_read,
+  is_starred,
+  starred_at,
+  note,
+  user_tags,
   updated_at
 FROM
-  item_reads
+  item_stars
 WHERE
   (
-    -- Cursor-based pagination: both cursor params set
     ?1 IS NOT NULL
     AND ?2 IS NOT NULL
     AND (updated_at, item_id) > (?1, ?2)
   )
   OR (
-    -- Fallback to updated_after when cursor is not fully set
     (?1 IS NULL OR ?2 IS NULL)
     AND ?3 IS NOT NULL
     AND (updated_at, item_id) > (?3, '')
   )
   OR (
-    -- No cursor and no updated_after: no filtering (match all)
     (?1 IS NULL OR ?2 IS NULL)
     AND ?3 IS NULL
   )
@@ -1745,21 +3404,24 @@ ORDER BY
 LIMIT ?4
 `
 
-type ListItemReadParams struct {
+type ListItemStarsParams struct {
 	UpdatedAtCursor interface{} `json:"updated_at_cursor"`
 	ItemIDCursor    interface{} `json:"item_id_cursor"`
 	UpdatedAfter    interface{} `json:"updated_after"`
 	Limit           int64       `json:"limit"`
 }
 
-type ListItemReadRow struct {
-	ItemID    string `json:"item_id"`
-	IsRead    int64  `json:"is_read"`
-	UpdatedAt string `json:"updated_at"`
+type ListItemStarsRow struct {
+	ItemID    string  `json:"item_id"`
+	IsStarred int64   `json:"is_starred"`
+	StarredAt *string `json:"starred_at"`
+	Note      *string `json:"note"`
+	UserTags  *string `json:"user_tags"`
+	UpdatedAt string  `json:"updated_at"`
 }
 
-func (q *Queries) ListItemRead(ctx context.Context, arg ListItemReadParams) ([]ListItemReadRow, error) {
-	rows, err := q.db.QueryContext(ctx, listItemRead,
+func (q *Queries) ListItemStars(ctx context.Context, arg ListItemStarsParams) ([]ListItemStarsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listItemStars,
 		arg.UpdatedAtCursor,
 		arg.ItemIDCursor,
 		arg.UpdatedAfter,
@@ -1769,10 +3431,17 @@ func (q *Queries) ListItemRead(ctx context.Context, arg ListItemReadParams) ([]L
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListItemReadRow
+	var items []ListItemStarsRow
 	for rows.Next() {
-		var i ListItemReadRow
-		if err := rows.Scan(&i.ItemID, &i.IsRead, &i.UpdatedAt); err != nil {
+		var i ListItemStarsRow
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.IsStarred,
+			&i.StarredAt,
+			&i.Note,
+			&i.UserTags,
+			&i.UpdatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1786,60 +3455,21 @@ func (q *Queries) ListItemRead(ctx context.Context, arg ListItemReadParams) ([]L
 	return items, nil
 }
 
-const listItems = `-- name: ListItems :many
-SELECT
-  i.id,
-  i.url,
-  i.title,
-  CAST(COALESCE(SUBSTR(i.description, 1, 140), '') AS TEXT) AS description,
-  i.published_at,
-  i.author,
-  i.guid,
-  i.content,
-  i.image_url,
-  i.categories,
-  i.created_at,
-  CAST((SELECT fi.feed_id FROM feed_items fi WHERE fi.item_id = i.id LIMIT 1) AS TEXT) AS feed_id,
-  CAST(COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) AS INTEGER) AS is_read
+const listItemsForBlocking = `-- name: ListItemsForBlocking :many
+SELECT DISTINCT
+  i.id, i.url, i.title, i.description, i.published_at, i.author, i.guid, i.content, i.image_url, i.categories, i.created_at, i.updated_at,
+  CAST(COALESCE(ir.is_read, 0) AS INTEGER) AS is_read
 FROM
   items i
-WHERE
-  EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id) AND
-  (?1 IS NULL OR EXISTS (SELECT 1 FROM feed_items fi WHERE fi.item_id = i.id AND fi.feed_id = ?1)) AND
-  (?2 IS NULL OR COALESCE((SELECT ir.is_read FROM item_reads ir WHERE ir.item_id = i.id), 0) = ?2) AND
-  (?3 IS NULL OR EXISTS (
-    SELECT 1 FROM feed_items fi 
-    JOIN feed_tags ft ON fi.feed_id = ft.feed_id 
-    WHERE fi.item_id = i.id AND ft.tag_id = ?3
-  )) AND
-  (?4 IS NULL OR i.created_at >= ?4) AND
-  (?5 IS NULL OR (CASE WHEN EXISTS (SELECT 1 FROM item_blocks ib WHERE ib.item_id = i.id) THEN 1 ELSE 0 END = ?5)) AND
-  (
-    (?6 IS NULL AND ?7 IS NULL) OR
-    (i.created_at, i.id) > (?6, ?7)
-  )
-ORDER BY
-  i.created_at ASC,
-  i.id ASC
-LIMIT ?8
+LEFT JOIN
+  item_reads ir ON i.id = ir.item_id
 `
 
-type ListItemsParams struct {
-	FeedID          interface{} `json:"feed_id"`
-	IsRead          interface{} `json:"is_read"`
-	TagID           interface{} `json:"tag_id"`
-	Since           interface{} `json:"since"`
-	IsBlocked       interface{} `json:"is_blocked"`
-	CreatedAtCursor interface{} `json:"created_at_cursor"`
-	IDCursor        interface{} `json:"id_cursor"`
-	Limit           int64       `json:"limit"`
-}
-
-type ListItemsRow struct {
+type ListItemsForBlockingRow struct {
 	ID          string  `json:"id"`
 	Url         string  `json:"url"`
 	Title       *string `json:"title"`
-	Description string  `json:"description"`
+	Description *string `json:"description"`
 	PublishedAt *string `json:"published_at"`
 	Author      *string `json:"author"`
 	Guid        *string `json:"guid"`
@@ -1847,28 +3477,19 @@ type ListItemsRow struct {
 	ImageUrl    *string `json:"image_url"`
 	Categories  *string `json:"categories"`
 	CreatedAt   string  `json:"created_at"`
-	FeedID      string  `json:"feed_id"`
+	UpdatedAt   string  `json:"updated_at"`
 	IsRead      int64   `json:"is_read"`
 }
 
-func (q *Queries) ListItems(ctx context.Context, arg ListItemsParams) ([]ListItemsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listItems,
-		arg.FeedID,
-		arg.IsRead,
-		arg.TagID,
-		arg.Since,
-		arg.IsBlocked,
-		arg.CreatedAtCursor,
-		arg.IDCursor,
-		arg.Limit,
-	)
+func (q *Queries) ListItemsForBlocking(ctx context.Context) ([]ListItemsForBlockingRow, error) {
+	rows, err := q.db.QueryContext(ctx, listItemsForBlocking)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListItemsRow
+	var items []ListItemsForBlockingRow
 	for rows.Next() {
-		var i ListItemsRow
+		var i ListItemsForBlockingRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Url,
@@ -1881,7 +3502,7 @@ func (q *Queries) ListItems(ctx context.Context, arg ListItemsParams) ([]ListIte
 			&i.ImageUrl,
 			&i.Categories,
 			&i.CreatedAt,
-			&i.FeedID,
+			&i.UpdatedAt,
 			&i.IsRead,
 		); err != nil {
 			return nil, err
@@ -1897,55 +3518,54 @@ func (q *Queries) ListItems(ctx context.Context, arg ListItemsParams) ([]ListIte
 	return items, nil
 }
 
-const listItemsForBlocking = `-- name: ListItemsForBlocking :many
-SELECT DISTINCT
-  i.id, i.url, i.title, i.description, i.published_at, i.author, i.guid, i.content, i.image_url, i.categories, i.created_at, i.updated_at,
-  CAST(COALESCE(ir.is_read, 0) AS INTEGER) AS is_read
+const listItemsWithHTML = `-- name: ListItemsWithHTML :many
+SELECT
+  id,
+  title,
+  description,
+  content,
+  description_html,
+  content_html
 FROM
-  items i
-LEFT JOIN
-  item_reads ir ON i.id = ir.item_id
+  items
+WHERE
+  (description_html IS NOT NULL OR content_html IS NOT NULL) AND
+  id > ?1
+ORDER BY
+  id ASC
+LIMIT ?2
 `
 
-type ListItemsForBlockingRow struct {
-	ID          string  `json:"id"`
-	Url         string  `json:"url"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	PublishedAt *string `json:"published_at"`
-	Author      *string `json:"author"`
-	Guid        *string `json:"guid"`
-	Content     *string `json:"content"`
-	ImageUrl    *string `json:"image_url"`
-	Categories  *string `json:"categories"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
-	IsRead      int64   `json:"is_read"`
+type ListItemsWithHTMLParams struct {
+	AfterID string `json:"after_id"`
+	Limit   int64  `json:"limit"`
 }
 
-func (q *Queries) ListItemsForBlocking(ctx context.Context) ([]ListItemsForBlockingRow, error) {
-	rows, err := q.db.QueryContext(ctx, listItemsForBlocking)
+type ListItemsWithHTMLRow struct {
+	ID              string  `json:"id"`
+	Title           *string `json:"title"`
+	Description     *string `json:"description"`
+	Content         *string `json:"content"`
+	DescriptionHtml *string `json:"description_html"`
+	ContentHtml     *string `json:"content_html"`
+}
+
+func (q *Queries) ListItemsWithHTML(ctx context.Context, arg ListItemsWithHTMLParams) ([]ListItemsWithHTMLRow, error) {
+	rows, err := q.db.QueryContext(ctx, listItemsWithHTML, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListItemsForBlockingRow
+	var items []ListItemsWithHTMLRow
 	for rows.Next() {
-		var i ListItemsForBlockingRow
+		var i ListItemsWithHTMLRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Url,
 			&i.Title,
 			&i.Description,
-			&i.PublishedAt,
-			&i.Author,
-			&i.Guid,
 			&i.Content,
-			&i.ImageUrl,
-			&i.Categories,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.IsRead,
+			&i.DescriptionHtml,
+			&i.ContentHtml,
 		); err != nil {
 			return nil, err
 		}
@@ -1960,6 +3580,105 @@ func (q *Queries) ListItemsForBlocking(ctx context.Context) ([]ListItemsForBlock
 	return items, nil
 }
 
+const listItemsWithoutCategories = `-- name: ListItemsWithoutCategories :many
+SELECT
+  i.id,
+  i.categories
+FROM
+  items i
+WHERE
+  (CASE WHEN json_valid(i.categories) THEN json_type(i.categories) END) = 'array' AND
+  EXISTS (
+    SELECT 1 FROM json_each(CASE WHEN json_valid(i.categories) THEN i.categories END) ic
+    WHERE ic.type = 'text' AND trim(ic.value) != ''
+  ) AND
+  NOT EXISTS (SELECT 1 FROM item_categories ic WHERE ic.item_id = i.id) AND
+  i.id > ?1
+ORDER BY
+  i.id ASC
+LIMIT ?2
+`
+
+type ListItemsWithoutCategoriesParams struct {
+	AfterID string `json:"after_id"`
+	Limit   int64  `json:"limit"`
+}
+
+type ListItemsWithoutCategoriesRow struct {
+	ID         string  `json:"id"`
+	Categories *string `json:"categories"`
+}
+
+// Items whose categories were saved as JSON only, before they were
+// normalized into item_categories.
+func (q *Queries) ListItemsWithoutCategories(ctx context.Context, arg ListItemsWithoutCategoriesParams) ([]ListItemsWithoutCategoriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listItemsWithoutCategories, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListItemsWithoutCategoriesRow
+	for rows.Next() {
+		var i ListItemsWithoutCategoriesRow
+		if err := rows.Scan(&i.ID, &i.Categories); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPodcastFeedIDs = `-- name: ListPodcastFeedIDs :many
+SELECT DISTINCT
+  fi.feed_id
+FROM
+  feed_items fi
+JOIN
+  item_enclosures ie ON ie.item_id = fi.item_id
+WHERE
+  fi.feed_id IN (/*SLICE:feed_ids*/?) AND
+  ie.mime_type LIKE 'audio/%'
+`
+
+func (q *Queries) ListPodcastFeedIDs(ctx context.Context, feedIds []string) ([]string, error) {
+	query := listPodcastFeedIDs
+	var queryParams []interface{}
+	if len(feedIds) > 0 {
+		for _, v := range feedIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:feed_ids*/?", strings.Repeat(",?", len(feedIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:feed_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var feed_id string
+		if err := rows.Scan(&feed_id); err != nil {
+			return nil, err
+		}
+		items = append(items, feed_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRecentItemHybridDates = `-- name: ListRecentItemHybridDates :many
 SELECT
   strftime('%FT%TZ', datetime(COALESCE(published_at, created_at))) AS timestamp
@@ -2000,36 +3719,140 @@ func (q *Queries) ListRecentItemHybridDates(ctx context.Context, arg ListRecentI
 	return items, nil
 }
 
-const listRecentItemPublishedDates = `-- name: ListRecentItemPublishedDates :many
-SELECT
-  published_at
-FROM
-  feed_items
-WHERE
-  feed_id = ? AND published_at IS NOT NULL
-ORDER BY
-  published_at DESC
-LIMIT ?
+const listRecentItemPublishedDates = `-- name: ListRecentItemPublishedDates :many
+SELECT
+  published_at
+FROM
+  feed_items
+WHERE
+  feed_id = ? AND published_at IS NOT NULL
+ORDER BY
+  published_at DESC
+LIMIT ?
+`
+
+type ListRecentItemPublishedDatesParams struct {
+	FeedID string `json:"feed_id"`
+	Limit  int64  `json:"limit"`
+}
+
+func (q *Queries) ListRecentItemPublishedDates(ctx context.Context, arg ListRecentItemPublishedDatesParams) ([]*string, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentItemPublishedDates, arg.FeedID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*string
+	for rows.Next() {
+		var published_at *string
+		if err := rows.Scan(&published_at); err != nil {
+			return nil, err
+		}
+		items = append(items, published_at)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSavedSearchFeeds = `-- name: ListSavedSearchFeeds :many
+SELECT saved_search_id, feed_id FROM saved_search_feeds ORDER BY saved_search_id, feed_id
+`
+
+type ListSavedSearchFeedsRow struct {
+	SavedSearchID string `json:"saved_search_id"`
+	FeedID        string `json:"feed_id"`
+}
+
+func (q *Queries) ListSavedSearchFeeds(ctx context.Context) ([]ListSavedSearchFeedsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSavedSearchFeeds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSavedSearchFeedsRow
+	for rows.Next() {
+		var i ListSavedSearchFeedsRow
+		if err := rows.Scan(&i.SavedSearchID, &i.FeedID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSavedSearchTags = `-- name: ListSavedSearchTags :many
+SELECT saved_search_id, tag_id FROM saved_search_tags ORDER BY saved_search_id, tag_id
+`
+
+type ListSavedSearchTagsRow struct {
+	SavedSearchID string `json:"saved_search_id"`
+	TagID         string `json:"tag_id"`
+}
+
+func (q *Queries) ListSavedSearchTags(ctx context.Context) ([]ListSavedSearchTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSavedSearchTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSavedSearchTagsRow
+	for rows.Next() {
+		var i ListSavedSearchTagsRow
+		if err := rows.Scan(&i.SavedSearchID, &i.TagID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSavedSearches = `-- name: ListSavedSearches :many
+SELECT id, name, "query", match_query, is_read, is_starred, author, since, until, max_age_days, created_at, updated_at FROM saved_searches ORDER BY name ASC
 `
 
-type ListRecentItemPublishedDatesParams struct {
-	FeedID string `json:"feed_id"`
-	Limit  int64  `json:"limit"`
-}
-
-func (q *Queries) ListRecentItemPublishedDates(ctx context.Context, arg ListRecentItemPublishedDatesParams) ([]*string, error) {
-	rows, err := q.db.QueryContext(ctx, listRecentItemPublishedDates, arg.FeedID, arg.Limit)
+func (q *Queries) ListSavedSearches(ctx context.Context) ([]SavedSearch, error) {
+	rows, err := q.db.QueryContext(ctx, listSavedSearches)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*string
+	var items []SavedSearch
 	for rows.Next() {
-		var published_at *string
-		if err := rows.Scan(&published_at); err != nil {
+		var i SavedSearch
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Query,
+			&i.MatchQuery,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.Author,
+			&i.Since,
+			&i.Until,
+			&i.MaxAgeDays,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, published_at)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -2080,6 +3903,46 @@ func (q *Queries) ListTagIgnoreWindows(ctx context.Context, arg ListTagIgnoreWin
 	return items, nil
 }
 
+const listTagTransportProfiles = `-- name: ListTagTransportProfiles :many
+SELECT tag_id, transport_profile_id FROM tag_transport_profiles
+WHERE
+  (?1 IS NULL OR tag_id = ?1)
+  AND (?2 IS NULL OR transport_profile_id = ?2)
+`
+
+type ListTagTransportProfilesParams struct {
+	TagID              interface{} `json:"tag_id"`
+	TransportProfileID interface{} `json:"transport_profile_id"`
+}
+
+type ListTagTransportProfilesRow struct {
+	TagID              string `json:"tag_id"`
+	TransportProfileID string `json:"transport_profile_id"`
+}
+
+func (q *Queries) ListTagTransportProfiles(ctx context.Context, arg ListTagTransportProfilesParams) ([]ListTagTransportProfilesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTagTransportProfiles, arg.TagID, arg.TransportProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagTransportProfilesRow
+	for rows.Next() {
+		var i ListTagTransportProfilesRow
+		if err := rows.Scan(&i.TagID, &i.TransportProfileID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTags = `-- name: ListTags :many
 SELECT
   id, name, created_at, updated_at
@@ -2219,6 +4082,45 @@ func (q *Queries) ListTagsByFeedId(ctx context.Context, feedID string) ([]Tag, e
 	return items, nil
 }
 
+const listTransportProfiles = `-- name: ListTransportProfiles :many
+SELECT id, name, proxy_url, ca_bundle, client_cert, client_key, insecure_skip_verify, timeout_seconds, retry_max, created_at, updated_at FROM transport_profiles ORDER BY name ASC
+`
+
+func (q *Queries) ListTransportProfiles(ctx context.Context) ([]TransportProfile, error) {
+	rows, err := q.db.QueryContext(ctx, listTransportProfiles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TransportProfile
+	for rows.Next() {
+		var i TransportProfile
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ProxyUrl,
+			&i.CaBundle,
+			&i.ClientCert,
+			&i.ClientKey,
+			&i.InsecureSkipVerify,
+			&i.TimeoutSeconds,
+			&i.RetryMax,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listURLParsingRules = `-- name: ListURLParsingRules :many
 SELECT
   id, domain, rule_type, pattern, created_at, updated_at
@@ -2258,28 +4160,376 @@ func (q *Queries) ListURLParsingRules(ctx context.Context) ([]UrlParsingRule, er
 	return items, nil
 }
 
+const listWebSubSubscriptionsToRenew = `-- name: ListWebSubSubscriptionsToRenew :many
+SELECT
+  feed_id, hub_url, topic_url, secret, state, lease_seconds, lease_expires_at, created_at, updated_at
+FROM
+  websub_subscriptions
+WHERE
+  state = 'active'
+  AND lease_expires_at IS NOT NULL
+  AND lease_expires_at <= ?1
+ORDER BY
+  lease_expires_at ASC
+`
+
+func (q *Queries) ListWebSubSubscriptionsToRenew(ctx context.Context, renewBefore *string) ([]WebsubSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listWebSubSubscriptionsToRenew, renewBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebsubSubscription
+	for rows.Next() {
+		var i WebsubSubscription
+		if err := rows.Scan(
+			&i.FeedID,
+			&i.HubUrl,
+			&i.TopicUrl,
+			&i.Secret,
+			&i.State,
+			&i.LeaseSeconds,
+			&i.LeaseExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markFeedFetched = `-- name: MarkFeedFetched :exec
 INSERT INTO feed_fetcher (
   feed_id,
   last_fetched_at,
-  next_fetch
+  next_fetch,
+  schedule_reason
 ) VALUES (
-  ?, ?, ?
+  ?, ?, ?, ?
 )
 ON CONFLICT(feed_id) DO UPDATE SET
   last_fetched_at = COALESCE(excluded.last_fetched_at, feed_fetcher.last_fetched_at),
   next_fetch = COALESCE(excluded.next_fetch, feed_fetcher.next_fetch),
+  schedule_reason = excluded.schedule_reason,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type MarkFeedFetchedParams struct {
+	FeedID         string  `json:"feed_id"`
+	LastFetchedAt  *string `json:"last_fetched_at"`
+	NextFetch      *string `json:"next_fetch"`
+	ScheduleReason *string `json:"schedule_reason"`
+}
+
+func (q *Queries) MarkFeedFetched(ctx context.Context, arg MarkFeedFetchedParams) error {
+	_, err := q.db.ExecContext(ctx, markFeedFetched,
+		arg.FeedID,
+		arg.LastFetchedAt,
+		arg.NextFetch,
+		arg.ScheduleReason,
+	)
+	return err
+}
+
+const markItemRevised = `-- name: MarkItemRevised :exec
+UPDATE
+  items
+SET
+  revised_at = ?
+WHERE
+  id = ?
+`
+
+type MarkItemRevisedParams struct {
+	RevisedAt *string `json:"revised_at"`
+	ID        string  `json:"id"`
+}
+
+func (q *Queries) MarkItemRevised(ctx context.Context, arg MarkItemRevisedParams) error {
+	_, err := q.db.ExecContext(ctx, markItemRevised, arg.RevisedAt, arg.ID)
+	return err
+}
+
+const markItemUnread = `-- name: MarkItemUnread :exec
+UPDATE
+  item_reads
+SET
+  is_read = 0,
+  read_at = NULL,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  item_id = ? AND is_read = 1
+`
+
+func (q *Queries) MarkItemUnread(ctx context.Context, itemID string) error {
+	_, err := q.db.ExecContext(ctx, markItemUnread, itemID)
+	return err
+}
+
+const postponeHostFetches = `-- name: PostponeHostFetches :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  next_fetch
+)
+SELECT f.id, CAST(?1 AS TEXT)
+FROM feeds f
+WHERE
+  lower(substr(f.url, 1, instr(f.url, '://') - 1)) IN ('http', 'https') AND
+  lower(substr(
+    replace(replace(substr(f.url, instr(f.url, '://') + 3), char(63), '/'), '#', '/') || '/',
+    1,
+    instr(replace(replace(substr(f.url, instr(f.url, '://') + 3), char(63), '/'), '#', '/') || '/', '/') - 1
+  )) = ?2
+ON CONFLICT(feed_id) DO UPDATE SET
+  next_fetch = MAX(COALESCE(feed_fetcher.next_fetch, ''), excluded.next_fetch),
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type PostponeHostFetchesParams struct {
+	NextFetch string `json:"next_fetch"`
+	Host      string `json:"host"`
+}
+
+// Pushes next_fetch of every feed served from host to at least next_fetch.
+// host is lower-cased and compared with the part of the URL between the
+// scheme and the first '/', '#' or question mark (char(63), as sqlc would
+// take a literal one for a parameter), which are all turned into '/' first.
+func (q *Queries) PostponeHostFetches(ctx context.Context, arg PostponeHostFetchesParams) error {
+	_, err := q.db.ExecContext(ctx, postponeHostFetches, arg.NextFetch, arg.Host)
+	return err
+}
+
+const recordFeedCacheHint = `-- name: RecordFeedCacheHint :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  hint_max_age
+) VALUES (
+  ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  hint_max_age = excluded.hint_max_age,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type RecordFeedCacheHintParams struct {
+	FeedID     string `json:"feed_id"`
+	HintMaxAge *int64 `json:"hint_max_age"`
+}
+
+func (q *Queries) RecordFeedCacheHint(ctx context.Context, arg RecordFeedCacheHintParams) error {
+	_, err := q.db.ExecContext(ctx, recordFeedCacheHint, arg.FeedID, arg.HintMaxAge)
+	return err
+}
+
+const recordFeedFetchFailure = `-- name: RecordFeedFetchFailure :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  last_status_code,
+  last_error,
+  last_error_kind,
+  consecutive_failures,
+  next_fetch,
+  schedule_reason
+) VALUES (
+  ?1, ?2, ?3, ?4, 1, ?5, ?6
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  last_status_code = excluded.last_status_code,
+  last_error = excluded.last_error,
+  last_error_kind = excluded.last_error_kind,
+  consecutive_failures = feed_fetcher.consecutive_failures + 1,
+  next_fetch = COALESCE(excluded.next_fetch, feed_fetcher.next_fetch),
+  schedule_reason = CASE
+    WHEN excluded.next_fetch IS NULL THEN feed_fetcher.schedule_reason
+    ELSE excluded.schedule_reason
+  END,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type RecordFeedFetchFailureParams struct {
+	FeedID         string  `json:"feed_id"`
+	LastStatusCode *int64  `json:"last_status_code"`
+	LastError      *string `json:"last_error"`
+	LastErrorKind  *string `json:"last_error_kind"`
+	NextFetch      *string `json:"next_fetch"`
+	ScheduleReason *string `json:"schedule_reason"`
+}
+
+func (q *Queries) RecordFeedFetchFailure(ctx context.Context, arg RecordFeedFetchFailureParams) error {
+	_, err := q.db.ExecContext(ctx, recordFeedFetchFailure,
+		arg.FeedID,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.LastErrorKind,
+		arg.NextFetch,
+		arg.ScheduleReason,
+	)
+	return err
+}
+
+const recordFeedFetchSuccess = `-- name: RecordFeedFetchSuccess :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  last_status_code,
+  last_success_at
+) VALUES (
+  ?1, ?2, (strftime('%FT%TZ', 'now'))
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  last_status_code = excluded.last_status_code,
+  last_error = NULL,
+  last_error_kind = NULL,
+  consecutive_failures = 0,
+  last_success_at = excluded.last_success_at,
+  disabled_at = NULL,
+  disabled_reason = NULL,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type RecordFeedFetchSuccessParams struct {
+	FeedID         string `json:"feed_id"`
+	LastStatusCode *int64 `json:"last_status_code"`
+}
+
+func (q *Queries) RecordFeedFetchSuccess(ctx context.Context, arg RecordFeedFetchSuccessParams) error {
+	_, err := q.db.ExecContext(ctx, recordFeedFetchSuccess, arg.FeedID, arg.LastStatusCode)
+	return err
+}
+
+const recordFeedRedirect = `-- name: RecordFeedRedirect :one
+INSERT INTO feed_fetcher (
+  feed_id,
+  redirect_url,
+  redirect_count
+) VALUES (
+  ?1, ?2, 1
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  redirect_count = CASE
+    WHEN feed_fetcher.redirect_url = excluded.redirect_url THEN feed_fetcher.redirect_count + 1
+    ELSE 1
+  END,
+  redirect_url = excluded.redirect_url,
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING redirect_count
+`
+
+type RecordFeedRedirectParams struct {
+	FeedID      string  `json:"feed_id"`
+	RedirectUrl *string `json:"redirect_url"`
+}
+
+func (q *Queries) RecordFeedRedirect(ctx context.Context, arg RecordFeedRedirectParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, recordFeedRedirect, arg.FeedID, arg.RedirectUrl)
+	var redirect_count int64
+	err := row.Scan(&redirect_count)
+	return redirect_count, err
+}
+
+const recordFeedScheduleHints = `-- name: RecordFeedScheduleHints :exec
+INSERT INTO feed_fetcher (
+  feed_id,
+  hint_max_age,
+  hint_ttl,
+  hint_update_period,
+  hint_skip_hours,
+  hint_skip_days
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  hint_max_age = excluded.hint_max_age,
+  hint_ttl = excluded.hint_ttl,
+  hint_update_period = excluded.hint_update_period,
+  hint_skip_hours = excluded.hint_skip_hours,
+  hint_skip_days = excluded.hint_skip_days,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type RecordFeedScheduleHintsParams struct {
+	FeedID           string  `json:"feed_id"`
+	HintMaxAge       *int64  `json:"hint_max_age"`
+	HintTtl          *int64  `json:"hint_ttl"`
+	HintUpdatePeriod *int64  `json:"hint_update_period"`
+	HintSkipHours    *string `json:"hint_skip_hours"`
+	HintSkipDays     *string `json:"hint_skip_days"`
+}
+
+func (q *Queries) RecordFeedScheduleHints(ctx context.Context, arg RecordFeedScheduleHintsParams) error {
+	_, err := q.db.ExecContext(ctx, recordFeedScheduleHints,
+		arg.FeedID,
+		arg.HintMaxAge,
+		arg.HintTtl,
+		arg.HintUpdatePeriod,
+		arg.HintSkipHours,
+		arg.HintSkipDays,
+	)
+	return err
+}
+
+const setFeedFetchFullContent = `-- name: SetFeedFetchFullContent :exec
+UPDATE
+  feeds
+SET
+  fetch_full_content = ?,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = ?
+`
+
+type SetFeedFetchFullContentParams struct {
+	FetchFullContent int64  `json:"fetch_full_content"`
+	ID               string `json:"id"`
+}
+
+func (q *Queries) SetFeedFetchFullContent(ctx context.Context, arg SetFeedFetchFullContentParams) error {
+	_, err := q.db.ExecContext(ctx, setFeedFetchFullContent, arg.FetchFullContent, arg.ID)
+	return err
+}
+
+const setFeedTransportProfile = `-- name: SetFeedTransportProfile :exec
+INSERT INTO feed_transport_profiles (feed_id, transport_profile_id)
+VALUES (?, ?)
+ON CONFLICT(feed_id) DO UPDATE SET
+  transport_profile_id = excluded.transport_profile_id,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type SetFeedTransportProfileParams struct {
+	FeedID             string `json:"feed_id"`
+	TransportProfileID string `json:"transport_profile_id"`
+}
+
+func (q *Queries) SetFeedTransportProfile(ctx context.Context, arg SetFeedTransportProfileParams) error {
+	_, err := q.db.ExecContext(ctx, setFeedTransportProfile, arg.FeedID, arg.TransportProfileID)
+	return err
+}
+
+const setFeedUnreadOnRevision = `-- name: SetFeedUnreadOnRevision :exec
+UPDATE
+  feeds
+SET
+  unread_on_revision = ?,
   updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = ?
 `
 
-type MarkFeedFetchedParams struct {
-	FeedID        string  `json:"feed_id"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
+type SetFeedUnreadOnRevisionParams struct {
+	UnreadOnRevision int64  `json:"unread_on_revision"`
+	ID               string `json:"id"`
 }
 
-func (q *Queries) MarkFeedFetched(ctx context.Context, arg MarkFeedFetchedParams) error {
-	_, err := q.db.ExecContext(ctx, markFeedFetched, arg.FeedID, arg.LastFetchedAt, arg.NextFetch)
+func (q *Queries) SetFeedUnreadOnRevision(ctx context.Context, arg SetFeedUnreadOnRevisionParams) error {
+	_, err := q.db.ExecContext(ctx, setFeedUnreadOnRevision, arg.UnreadOnRevision, arg.ID)
 	return err
 }
 
@@ -2317,22 +4567,131 @@ func (q *Queries) SetItemRead(ctx context.Context, arg SetItemReadParams) (ItemR
 	return i, err
 }
 
+const setTagTransportProfile = `-- name: SetTagTransportProfile :exec
+INSERT INTO tag_transport_profiles (tag_id, transport_profile_id)
+VALUES (?, ?)
+ON CONFLICT(tag_id) DO UPDATE SET
+  transport_profile_id = excluded.transport_profile_id,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type SetTagTransportProfileParams struct {
+	TagID              string `json:"tag_id"`
+	TransportProfileID string `json:"transport_profile_id"`
+}
+
+func (q *Queries) SetTagTransportProfile(ctx context.Context, arg SetTagTransportProfileParams) error {
+	_, err := q.db.ExecContext(ctx, setTagTransportProfile, arg.TagID, arg.TransportProfileID)
+	return err
+}
+
+const starItem = `-- name: StarItem :one
+INSERT INTO item_stars (
+  item_id,
+  is_starred,
+  starred_at,
+  note,
+  user_tags
+)
+SELECT
+  ?1,
+  1,
+  ?2,
+  ?3,
+  ?4
+WHERE
+  EXISTS (SELECT 1 FROM items WHERE id = ?1)
+ON CONFLICT(item_id) DO UPDATE SET
+  is_starred = 1,
+  starred_at = CASE WHEN item_stars.is_starred = 1 THEN item_stars.starred_at ELSE excluded.starred_at END,
+  note = excluded.note,
+  user_tags = excluded.user_tags,
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING item_id, is_starred, starred_at, note, user_tags, created_at, updated_at
+`
+
+type StarItemParams struct {
+	ItemID    string  `json:"item_id"`
+	StarredAt *string `json:"starred_at"`
+	Note      *string `json:"note"`
+	UserTags  *string `json:"user_tags"`
+}
+
+// starred_at is kept when the item is already starred, so that editing the
+// note or tags does not move it.
+func (q *Queries) StarItem(ctx context.Context, arg StarItemParams) (ItemStar, error) {
+	row := q.db.QueryRowContext(ctx, starItem,
+		arg.ItemID,
+		arg.StarredAt,
+		arg.Note,
+		arg.UserTags,
+	)
+	var i ItemStar
+	err := row.Scan(
+		&i.ItemID,
+		&i.IsStarred,
+		&i.StarredAt,
+		&i.Note,
+		&i.UserTags,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const unstarItem = `-- name: UnstarItem :one
+INSERT INTO item_stars (
+  item_id,
+  is_starred
+)
+SELECT
+  ?1,
+  0
+WHERE
+  EXISTS (SELECT 1 FROM items WHERE id = ?1)
+ON CONFLICT(item_id) DO UPDATE SET
+  is_starred = 0,
+  starred_at = NULL,
+  note = NULL,
+  user_tags = NULL,
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING item_id, is_starred, starred_at, note, user_tags, created_at, updated_at
+`
+
+func (q *Queries) UnstarItem(ctx context.Context, itemID string) (ItemStar, error) {
+	row := q.db.QueryRowContext(ctx, unstarItem, itemID)
+	var i ItemStar
+	err := row.Scan(
+		&i.ItemID,
+		&i.IsStarred,
+		&i.StarredAt,
+		&i.Note,
+		&i.UserTags,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateFeed = `-- name: UpdateFeed :one
 UPDATE
   feeds
 SET
-  link = COALESCE(?, link),
-  title = COALESCE(?, title),
-  description = COALESCE(?, description),
-  lang = COALESCE(?, lang),
-  image_url = COALESCE(?, image_url),
-  copyright = COALESCE(?, copyright),
-  feed_type = COALESCE(?, feed_type),
-  feed_version = COALESCE(?, feed_version),
+  link = COALESCE(?1, link),
+  title = CASE
+    WHEN title_overridden = 1 THEN title
+    ELSE COALESCE(?2, title)
+  END,
+  description = COALESCE(?3, description),
+  lang = COALESCE(?4, lang),
+  image_url = COALESCE(?5, image_url),
+  copyright = COALESCE(?6, copyright),
+  feed_type = COALESCE(?7, feed_type),
+  feed_version = COALESCE(?8, feed_version),
   updated_at = (strftime('%FT%TZ', 'now'))
 WHERE
-  id = ?
-RETURNING id, url, link, title, description, lang, image_url, copyright, feed_type, feed_version, created_at, updated_at
+  id = ?9
+RETURNING id, url, link, title, description, lang, image_url, copyright, feed_type, feed_version, fetch_full_content, unread_on_revision, title_overridden, created_at, updated_at
 `
 
 type UpdateFeedParams struct {
@@ -2371,12 +4730,35 @@ func (q *Queries) UpdateFeed(ctx context.Context, arg UpdateFeedParams) (Feed, e
 		&i.Copyright,
 		&i.FeedType,
 		&i.FeedVersion,
+		&i.FetchFullContent,
+		&i.UnreadOnRevision,
+		&i.TitleOverridden,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const updateFeedURL = `-- name: UpdateFeedURL :exec
+UPDATE
+  feeds
+SET
+  url = ?1,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = ?2
+`
+
+type UpdateFeedURLParams struct {
+	Url string `json:"url"`
+	ID  string `json:"id"`
+}
+
+func (q *Queries) UpdateFeedURL(ctx context.Context, arg UpdateFeedURLParams) error {
+	_, err := q.db.ExecContext(ctx, updateFeedURL, arg.Url, arg.ID)
+	return err
+}
+
 const updateIgnoreWindow = `-- name: UpdateIgnoreWindow :one
 UPDATE ignore_windows
 SET
@@ -2422,6 +4804,262 @@ func (q *Queries) UpdateIgnoreWindow(ctx context.Context, arg UpdateIgnoreWindow
 	return i, err
 }
 
+const updateItemFullContentMarkdown = `-- name: UpdateItemFullContentMarkdown :exec
+UPDATE
+  item_full_contents
+SET
+  content = ?,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  item_id = ?
+`
+
+type UpdateItemFullContentMarkdownParams struct {
+	Content *string `json:"content"`
+	ItemID  string  `json:"item_id"`
+}
+
+func (q *Queries) UpdateItemFullContentMarkdown(ctx context.Context, arg UpdateItemFullContentMarkdownParams) error {
+	_, err := q.db.ExecContext(ctx, updateItemFullContentMarkdown, arg.Content, arg.ItemID)
+	return err
+}
+
+const updateItemMarkdown = `-- name: UpdateItemMarkdown :exec
+UPDATE
+  items
+SET
+  description = CASE WHEN description_html IS NULL THEN description ELSE ?1 END,
+  content = CASE WHEN content_html IS NULL THEN content ELSE ?2 END,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE
+  id = ?3
+`
+
+type UpdateItemMarkdownParams struct {
+	Description *string `json:"description"`
+	Content     *string `json:"content"`
+	ID          string  `json:"id"`
+}
+
+// Only the stored HTML columns are converted; the Markdown of items saved
+// without HTML is kept.
+func (q *Queries) UpdateItemMarkdown(ctx context.Context, arg UpdateItemMarkdownParams) error {
+	_, err := q.db.ExecContext(ctx, updateItemMarkdown, arg.Description, arg.Content, arg.ID)
+	return err
+}
+
+const updateItemRevisionHash = `-- name: UpdateItemRevisionHash :exec
+UPDATE
+  item_revisions
+SET
+  content_hash = ?1
+WHERE
+  item_id = ?2 AND revision = ?3
+`
+
+type UpdateItemRevisionHashParams struct {
+	ContentHash string `json:"content_hash"`
+	ItemID      string `json:"item_id"`
+	Revision    int64  `json:"revision"`
+}
+
+func (q *Queries) UpdateItemRevisionHash(ctx context.Context, arg UpdateItemRevisionHashParams) error {
+	_, err := q.db.ExecContext(ctx, updateItemRevisionHash, arg.ContentHash, arg.ItemID, arg.Revision)
+	return err
+}
+
+const updateItemRevisionText = `-- name: UpdateItemRevisionText :exec
+UPDATE
+  item_revisions
+SET
+  title = ?1,
+  description = ?2,
+  content = ?3
+WHERE
+  item_id = ?4 AND revision = ?5
+`
+
+type UpdateItemRevisionTextParams struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Content     *string `json:"content"`
+	ItemID      string  `json:"item_id"`
+	Revision    int64   `json:"revision"`
+}
+
+func (q *Queries) UpdateItemRevisionText(ctx context.Context, arg UpdateItemRevisionTextParams) error {
+	_, err := q.db.ExecContext(ctx, updateItemRevisionText,
+		arg.Title,
+		arg.Description,
+		arg.Content,
+		arg.ItemID,
+		arg.Revision,
+	)
+	return err
+}
+
+const updateSavedSearch = `-- name: UpdateSavedSearch :one
+UPDATE saved_searches
+SET
+  name = ?1,
+  query = ?2,
+  match_query = ?3,
+  is_read = ?4,
+  is_starred = ?5,
+  author = ?6,
+  since = ?7,
+  until = ?8,
+  max_age_days = ?9,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE id = ?10
+RETURNING id, name, "query", match_query, is_read, is_starred, author, since, until, max_age_days, created_at, updated_at
+`
+
+type UpdateSavedSearchParams struct {
+	Name       string  `json:"name"`
+	Query      *string `json:"query"`
+	MatchQuery *string `json:"match_query"`
+	IsRead     *int64  `json:"is_read"`
+	IsStarred  *int64  `json:"is_starred"`
+	Author     *string `json:"author"`
+	Since      *string `json:"since"`
+	Until      *string `json:"until"`
+	MaxAgeDays *int64  `json:"max_age_days"`
+	ID         string  `json:"id"`
+}
+
+func (q *Queries) UpdateSavedSearch(ctx context.Context, arg UpdateSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRowContext(ctx, updateSavedSearch,
+		arg.Name,
+		arg.Query,
+		arg.MatchQuery,
+		arg.IsRead,
+		arg.IsStarred,
+		arg.Author,
+		arg.Since,
+		arg.Until,
+		arg.MaxAgeDays,
+		arg.ID,
+	)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Query,
+		&i.MatchQuery,
+		&i.IsRead,
+		&i.IsStarred,
+		&i.Author,
+		&i.Since,
+		&i.Until,
+		&i.MaxAgeDays,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateTransportProfile = `-- name: UpdateTransportProfile :one
+UPDATE transport_profiles
+SET
+  name = ?1,
+  proxy_url = ?2,
+  ca_bundle = ?3,
+  client_cert = ?4,
+  client_key = ?5,
+  insecure_skip_verify = ?6,
+  timeout_seconds = ?7,
+  retry_max = ?8,
+  updated_at = (strftime('%FT%TZ', 'now'))
+WHERE id = ?9
+RETURNING id, name, proxy_url, ca_bundle, client_cert, client_key, insecure_skip_verify, timeout_seconds, retry_max, created_at, updated_at
+`
+
+type UpdateTransportProfileParams struct {
+	Name               string  `json:"name"`
+	ProxyUrl           *string `json:"proxy_url"`
+	CaBundle           *string `json:"ca_bundle"`
+	ClientCert         *string `json:"client_cert"`
+	ClientKey          *string `json:"client_key"`
+	InsecureSkipVerify int64   `json:"insecure_skip_verify"`
+	TimeoutSeconds     *int64  `json:"timeout_seconds"`
+	RetryMax           *int64  `json:"retry_max"`
+	ID                 string  `json:"id"`
+}
+
+func (q *Queries) UpdateTransportProfile(ctx context.Context, arg UpdateTransportProfileParams) (TransportProfile, error) {
+	row := q.db.QueryRowContext(ctx, updateTransportProfile,
+		arg.Name,
+		arg.ProxyUrl,
+		arg.CaBundle,
+		arg.ClientCert,
+		arg.ClientKey,
+		arg.InsecureSkipVerify,
+		arg.TimeoutSeconds,
+		arg.RetryMax,
+		arg.ID,
+	)
+	var i TransportProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ProxyUrl,
+		&i.CaBundle,
+		&i.ClientCert,
+		&i.ClientKey,
+		&i.InsecureSkipVerify,
+		&i.TimeoutSeconds,
+		&i.RetryMax,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertCategory = `-- name: UpsertCategory :one
+INSERT INTO categories (id, name)
+VALUES (?, ?)
+ON CONFLICT(name) DO UPDATE SET name = categories.name
+RETURNING id
+`
+
+type UpsertCategoryParams struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) UpsertCategory(ctx context.Context, arg UpsertCategoryParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, upsertCategory, arg.ID, arg.Name)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
+const upsertFeedCredential = `-- name: UpsertFeedCredential :exec
+INSERT INTO feed_credentials (
+  feed_id,
+  auth_type,
+  encrypted_data
+) VALUES (
+  ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  auth_type = excluded.auth_type,
+  encrypted_data = excluded.encrypted_data,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type UpsertFeedCredentialParams struct {
+	FeedID        string `json:"feed_id"`
+	AuthType      string `json:"auth_type"`
+	EncryptedData string `json:"encrypted_data"`
+}
+
+func (q *Queries) UpsertFeedCredential(ctx context.Context, arg UpsertFeedCredentialParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFeedCredential, arg.FeedID, arg.AuthType, arg.EncryptedData)
+	return err
+}
+
 const upsertFeedFetcher = `-- name: UpsertFeedFetcher :one
 INSERT INTO feed_fetcher (
   feed_id,
@@ -2438,7 +5076,7 @@ ON CONFLICT(feed_id) DO UPDATE SET
   last_fetched_at = COALESCE(excluded.last_fetched_at, feed_fetcher.last_fetched_at),
   next_fetch = COALESCE(excluded.next_fetch, feed_fetcher.next_fetch),
   updated_at = (strftime('%FT%TZ', 'now'))
-RETURNING feed_id, etag, last_modified, last_fetched_at, next_fetch, created_at, updated_at
+RETURNING feed_id, etag, last_modified, last_fetched_at, next_fetch, last_status_code, last_error, last_error_kind, consecutive_failures, last_success_at, redirect_url, redirect_count, disabled_at, disabled_reason, hint_max_age, hint_ttl, hint_update_period, hint_skip_hours, hint_skip_days, schedule_reason, created_at, updated_at
 `
 
 type UpsertFeedFetcherParams struct {
@@ -2464,6 +5102,195 @@ func (q *Queries) UpsertFeedFetcher(ctx context.Context, arg UpsertFeedFetcherPa
 		&i.LastModified,
 		&i.LastFetchedAt,
 		&i.NextFetch,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.LastErrorKind,
+		&i.ConsecutiveFailures,
+		&i.LastSuccessAt,
+		&i.RedirectUrl,
+		&i.RedirectCount,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.HintMaxAge,
+		&i.HintTtl,
+		&i.HintUpdatePeriod,
+		&i.HintSkipHours,
+		&i.HintSkipDays,
+		&i.ScheduleReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertFeedIcon = `-- name: UpsertFeedIcon :exec
+INSERT INTO feed_icons (
+  feed_id,
+  source_url,
+  mime_type,
+  data,
+  error,
+  fetched_at
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  source_url = COALESCE(excluded.source_url, feed_icons.source_url),
+  mime_type = COALESCE(excluded.mime_type, feed_icons.mime_type),
+  data = COALESCE(excluded.data, feed_icons.data),
+  error = excluded.error,
+  fetched_at = excluded.fetched_at,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type UpsertFeedIconParams struct {
+	FeedID    string  `json:"feed_id"`
+	SourceUrl *string `json:"source_url"`
+	MimeType  *string `json:"mime_type"`
+	Data      []byte  `json:"data"`
+	Error     *string `json:"error"`
+	FetchedAt string  `json:"fetched_at"`
+}
+
+func (q *Queries) UpsertFeedIcon(ctx context.Context, arg UpsertFeedIconParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFeedIcon,
+		arg.FeedID,
+		arg.SourceUrl,
+		arg.MimeType,
+		arg.Data,
+		arg.Error,
+		arg.FetchedAt,
+	)
+	return err
+}
+
+const upsertFeedScraper = `-- name: UpsertFeedScraper :exec
+INSERT INTO feed_scrapers (
+  feed_id,
+  item_selector,
+  title_selector,
+  link_selector,
+  date_selector,
+  content_selector
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  item_selector = excluded.item_selector,
+  title_selector = excluded.title_selector,
+  link_selector = excluded.link_selector,
+  date_selector = excluded.date_selector,
+  content_selector = excluded.content_selector,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type UpsertFeedScraperParams struct {
+	FeedID          string  `json:"feed_id"`
+	ItemSelector    string  `json:"item_selector"`
+	TitleSelector   *string `json:"title_selector"`
+	LinkSelector    *string `json:"link_selector"`
+	DateSelector    *string `json:"date_selector"`
+	ContentSelector *string `json:"content_selector"`
+}
+
+func (q *Queries) UpsertFeedScraper(ctx context.Context, arg UpsertFeedScraperParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFeedScraper,
+		arg.FeedID,
+		arg.ItemSelector,
+		arg.TitleSelector,
+		arg.LinkSelector,
+		arg.DateSelector,
+		arg.ContentSelector,
+	)
+	return err
+}
+
+const upsertItemFullContent = `-- name: UpsertItemFullContent :exec
+INSERT INTO item_full_contents (
+  item_id,
+  content,
+  content_html,
+  error,
+  fetched_at
+)
+SELECT
+  ?1,
+  ?2,
+  ?3,
+  ?4,
+  ?5
+WHERE
+  EXISTS (SELECT 1 FROM items WHERE id = ?1)
+ON CONFLICT(item_id) DO UPDATE SET
+  content = COALESCE(excluded.content, item_full_contents.content),
+  content_html = CASE WHEN excluded.content IS NULL THEN item_full_contents.content_html ELSE excluded.content_html END,
+  error = excluded.error,
+  fetched_at = excluded.fetched_at,
+  updated_at = (strftime('%FT%TZ', 'now'))
+`
+
+type UpsertItemFullContentParams struct {
+	ItemID      string  `json:"item_id"`
+	Content     *string `json:"content"`
+	ContentHtml *string `json:"content_html"`
+	Error       *string `json:"error"`
+	FetchedAt   string  `json:"fetched_at"`
+}
+
+// Content is kept when a later extraction fails. Items deleted in the
+// meantime are skipped rather than failing the write batch.
+func (q *Queries) UpsertItemFullContent(ctx context.Context, arg UpsertItemFullContentParams) error {
+	_, err := q.db.ExecContext(ctx, upsertItemFullContent,
+		arg.ItemID,
+		arg.Content,
+		arg.ContentHtml,
+		arg.Error,
+		arg.FetchedAt,
+	)
+	return err
+}
+
+const upsertWebSubSubscription = `-- name: UpsertWebSubSubscription :one
+INSERT INTO websub_subscriptions (
+  feed_id,
+  hub_url,
+  topic_url,
+  secret
+) VALUES (
+  ?, ?, ?, ?
+)
+ON CONFLICT(feed_id) DO UPDATE SET
+  hub_url = excluded.hub_url,
+  topic_url = excluded.topic_url,
+  secret = excluded.secret,
+  state = 'pending',
+  updated_at = (strftime('%FT%TZ', 'now'))
+RETURNING feed_id, hub_url, topic_url, secret, state, lease_seconds, lease_expires_at, created_at, updated_at
+`
+
+type UpsertWebSubSubscriptionParams struct {
+	FeedID   string `json:"feed_id"`
+	HubUrl   string `json:"hub_url"`
+	TopicUrl string `json:"topic_url"`
+	Secret   string `json:"secret"`
+}
+
+func (q *Queries) UpsertWebSubSubscription(ctx context.Context, arg UpsertWebSubSubscriptionParams) (WebsubSubscription, error) {
+	row := q.db.QueryRowContext(ctx, upsertWebSubSubscription,
+		arg.FeedID,
+		arg.HubUrl,
+		arg.TopicUrl,
+		arg.Secret,
+	)
+	var i WebsubSubscription
+	err := row.Scan(
+		&i.FeedID,
+		&i.HubUrl,
+		&i.TopicUrl,
+		&i.Secret,
+		&i.State,
+		&i.LeaseSeconds,
+		&i.LeaseExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
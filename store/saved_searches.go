@@ -0,0 +1,174 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// SavedSearchParams are the filters of a saved search. Query is a search
+// query as accepted by ParseSearchQuery; the rest are stored as given.
+type SavedSearchParams struct {
+	Name       string
+	Query      *string
+	IsRead     *int64
+	IsStarred  *int64
+	Author     *string
+	Since      *string
+	Until      *string
+	MaxAgeDays *int64
+	FeedIDs    []string
+	TagIDs     []string
+}
+
+// FullSavedSearch is a saved search with the feeds and tags it is limited to.
+type FullSavedSearch struct {
+	SavedSearch
+	FeedIDs []string
+	TagIDs  []string
+}
+
+// CreateSavedSearch saves a search under id. It returns an error wrapping
+// ErrInvalidSearchQuery when the query cannot be parsed.
+func (s *Store) CreateSavedSearch(ctx context.Context, id string, params SavedSearchParams) (FullSavedSearch, error) {
+	matchQuery, err := savedSearchMatchQuery(params.Query)
+	if err != nil {
+		return FullSavedSearch{}, err
+	}
+	var saved SavedSearch
+	err = s.WithTransaction(ctx, func(qtx *Queries) error {
+		saved, err = qtx.CreateSavedSearch(ctx, CreateSavedSearchParams{
+			ID:         id,
+			Name:       params.Name,
+			Query:      params.Query,
+			MatchQuery: matchQuery,
+			IsRead:     params.IsRead,
+			IsStarred:  params.IsStarred,
+			Author:     params.Author,
+			Since:      params.Since,
+			Until:      params.Until,
+			MaxAgeDays: params.MaxAgeDays,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create saved search: %w", err)
+		}
+		return setSavedSearchSources(ctx, qtx, id, params)
+	})
+	if err != nil {
+		return FullSavedSearch{}, err
+	}
+	return FullSavedSearch{SavedSearch: saved, FeedIDs: params.FeedIDs, TagIDs: params.TagIDs}, nil
+}
+
+// UpdateSavedSearch replaces every filter of a saved search.
+func (s *Store) UpdateSavedSearch(ctx context.Context, id string, params SavedSearchParams) (FullSavedSearch, error) {
+	matchQuery, err := savedSearchMatchQuery(params.Query)
+	if err != nil {
+		return FullSavedSearch{}, err
+	}
+	var saved SavedSearch
+	err = s.WithTransaction(ctx, func(qtx *Queries) error {
+		saved, err = qtx.UpdateSavedSearch(ctx, UpdateSavedSearchParams{
+			ID:         id,
+			Name:       params.Name,
+			Query:      params.Query,
+			MatchQuery: matchQuery,
+			IsRead:     params.IsRead,
+			IsStarred:  params.IsStarred,
+			Author:     params.Author,
+			Since:      params.Since,
+			Until:      params.Until,
+			MaxAgeDays: params.MaxAgeDays,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update saved search: %w", err)
+		}
+		if err := qtx.DeleteSavedSearchFeeds(ctx, id); err != nil {
+			return fmt.Errorf("failed to clear saved search feeds: %w", err)
+		}
+		if err := qtx.DeleteSavedSearchTags(ctx, id); err != nil {
+			return fmt.Errorf("failed to clear saved search tags: %w", err)
+		}
+		return setSavedSearchSources(ctx, qtx, id, params)
+	})
+	if err != nil {
+		return FullSavedSearch{}, err
+	}
+	return FullSavedSearch{SavedSearch: saved, FeedIDs: params.FeedIDs, TagIDs: params.TagIDs}, nil
+}
+
+// GetSavedSearch returns a saved search with its feeds and tags.
+func (s *Store) GetSavedSearch(ctx context.Context, id string) (FullSavedSearch, error) {
+	saved, err := s.Queries.GetSavedSearch(ctx, id)
+	if err != nil {
+		return FullSavedSearch{}, err
+	}
+	searches, err := s.withSavedSearchSources(ctx, []SavedSearch{saved})
+	if err != nil {
+		return FullSavedSearch{}, err
+	}
+	return searches[0], nil
+}
+
+// ListSavedSearches returns every saved search by name, with its feeds and
+// tags.
+func (s *Store) ListSavedSearches(ctx context.Context) ([]FullSavedSearch, error) {
+	rows, err := s.Queries.ListSavedSearches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.withSavedSearchSources(ctx, rows)
+}
+
+func (s *Store) withSavedSearchSources(ctx context.Context, rows []SavedSearch) ([]FullSavedSearch, error) {
+	feeds, err := s.ListSavedSearchFeeds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved search feeds: %w", err)
+	}
+	tags, err := s.ListSavedSearchTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved search tags: %w", err)
+	}
+	feedIDs := make(map[string][]string)
+	for _, feed := range feeds {
+		feedIDs[feed.SavedSearchID] = append(feedIDs[feed.SavedSearchID], feed.FeedID)
+	}
+	tagIDs := make(map[string][]string)
+	for _, tag := range tags {
+		tagIDs[tag.SavedSearchID] = append(tagIDs[tag.SavedSearchID], tag.TagID)
+	}
+
+	searches := make([]FullSavedSearch, 0, len(rows))
+	for _, row := range rows {
+		searches = append(searches, FullSavedSearch{
+			SavedSearch: row,
+			FeedIDs:     feedIDs[row.ID],
+			TagIDs:      tagIDs[row.ID],
+		})
+	}
+	return searches, nil
+}
+
+func setSavedSearchSources(ctx context.Context, qtx *Queries, id string, params SavedSearchParams) error {
+	for _, feedID := range params.FeedIDs {
+		if err := qtx.CreateSavedSearchFeed(ctx, CreateSavedSearchFeedParams{SavedSearchID: id, FeedID: feedID}); err != nil {
+			return fmt.Errorf("failed to add feed %s to saved search: %w", feedID, err)
+		}
+	}
+	for _, tagID := range params.TagIDs {
+		if err := qtx.CreateSavedSearchTag(ctx, CreateSavedSearchTagParams{SavedSearchID: id, TagID: tagID}); err != nil {
+			return fmt.Errorf("failed to add tag %s to saved search: %w", tagID, err)
+		}
+	}
+	return nil
+}
+
+func savedSearchMatchQuery(query *string) (*string, error) {
+	if query == nil {
+		return nil, nil
+	}
+	matchQuery, err := ParseSearchQuery(*query)
+	if err != nil {
+		return nil, err
+	}
+	return &matchQuery, nil
+}
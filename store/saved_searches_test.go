@@ -0,0 +1,139 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestStore_SavedSearches(t *testing.T) {
+	s := setupStore(t)
+	ctx := context.Background()
+
+	feedA := uuid.NewString()
+	_, err := s.CreateFeed(ctx, store.CreateFeedParams{ID: feedA, Url: "http://example.com/a.xml"})
+	assert.NilError(t, err)
+	feedB := uuid.NewString()
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: feedB, Url: "http://example.com/b.xml"})
+	assert.NilError(t, err)
+	feedC := uuid.NewString()
+	_, err = s.CreateFeed(ctx, store.CreateFeedParams{ID: feedC, Url: "http://example.com/c.xml"})
+	assert.NilError(t, err)
+	tagID := uuid.NewString()
+	_, err = s.CreateTag(ctx, store.CreateTagParams{ID: tagID, Name: "go"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.CreateFeedTag(ctx, store.CreateFeedTagParams{FeedID: feedB, TagID: tagID}))
+
+	genericsA := createSearchTestItem(t, s, ctx, feedA, "http://example.com/1", "Go generics in practice", "", "")
+	genericsB := createSearchTestItem(t, s, ctx, feedB, "http://example.com/2", "More on generics", "", "")
+	_ = createSearchTestItem(t, s, ctx, feedC, "http://example.com/3", "Generics elsewhere", "", "")
+	_ = createSearchTestItem(t, s, ctx, feedB, "http://example.com/4", "Error handling", "", "")
+	old := createSearchTestItem(t, s, ctx, feedA, "http://example.com/5", "Old generics", "", "")
+	_, err = s.DB.ExecContext(ctx, "UPDATE items SET created_at = ?, author = ? WHERE id = ?", time.Now().UTC().AddDate(0, 0, -30).Format(time.RFC3339), "Rob", old)
+	assert.NilError(t, err)
+
+	// listTitles returns sorted titles, as the items are created within the
+	// same second and ordered by their random IDs.
+	listTitles := func(t *testing.T, savedSearchID string) []string {
+		t.Helper()
+		rows, err := s.ListItems(ctx, store.StoreListItemsParams{SavedSearchID: savedSearchID, Limit: 10})
+		assert.NilError(t, err)
+		titles := make([]string, 0, len(rows))
+		for _, row := range rows {
+			titles = append(titles, *row.Title)
+		}
+		count, err := s.CountItems(ctx, store.StoreCountItemsParams{SavedSearchID: savedSearchID})
+		assert.NilError(t, err)
+		assert.Equal(t, count, int64(len(rows)))
+		slices.Sort(titles)
+		return titles
+	}
+
+	query := "generics"
+	maxAgeDays := int64(7)
+	recent, err := s.CreateSavedSearch(ctx, uuid.NewString(), store.SavedSearchParams{
+		Name:       "Recent generics",
+		Query:      &query,
+		MaxAgeDays: &maxAgeDays,
+		FeedIDs:    []string{feedA},
+		TagIDs:     []string{tagID},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, *recent.MatchQuery, `"generics"`)
+
+	t.Run("Feeds, tags, query and age", func(t *testing.T) {
+		assert.DeepEqual(t, listTitles(t, recent.ID), []string{"Go generics in practice", "More on generics"})
+	})
+
+	t.Run("Read state and author", func(t *testing.T) {
+		_, err := s.SetItemRead(ctx, store.SetItemReadParams{ItemID: genericsA, IsRead: 1})
+		assert.NilError(t, err)
+		unread := int64(0)
+		author := "rob"
+		updated, err := s.UpdateSavedSearch(ctx, recent.ID, store.SavedSearchParams{Name: "Unread", IsRead: &unread, FeedIDs: []string{feedA}})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, updated.FeedIDs, []string{feedA})
+		assert.DeepEqual(t, listTitles(t, recent.ID), []string{"Old generics"})
+
+		_, err = s.UpdateSavedSearch(ctx, recent.ID, store.SavedSearchParams{Name: "Rob", Author: &author})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, listTitles(t, recent.ID), []string{"Old generics"})
+
+		got, err := s.GetSavedSearch(ctx, recent.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, got.Name, "Rob")
+		assert.Assert(t, cmp.Len(got.FeedIDs, 0))
+		assert.Assert(t, got.MatchQuery == nil)
+	})
+
+	t.Run("Unread counts", func(t *testing.T) {
+		all, err := s.CreateSavedSearch(ctx, uuid.NewString(), store.SavedSearchParams{Name: "All generics", Query: &query})
+		assert.NilError(t, err)
+		counts, err := s.CountItemsPerSavedSearch(ctx)
+		assert.NilError(t, err)
+		byID := make(map[string]int64)
+		for _, count := range counts {
+			byID[count.SavedSearchID] = count.UnreadCount
+		}
+		// genericsA is read.
+		assert.Equal(t, byID[all.ID], int64(3))
+		assert.Equal(t, byID[recent.ID], int64(1))
+
+		_, err = s.SetItemRead(ctx, store.SetItemReadParams{ItemID: genericsB, IsRead: 1})
+		assert.NilError(t, err)
+		counts, err = s.CountItemsPerSavedSearch(ctx)
+		assert.NilError(t, err)
+		for _, count := range counts {
+			if count.SavedSearchID == all.ID {
+				assert.Equal(t, count.UnreadCount, int64(2))
+			}
+		}
+	})
+
+	t.Run("Invalid query", func(t *testing.T) {
+		invalid := "generics AND"
+		_, err := s.CreateSavedSearch(ctx, uuid.NewString(), store.SavedSearchParams{Name: "Invalid", Query: &invalid})
+		assert.Assert(t, errors.Is(err, store.ErrInvalidSearchQuery))
+	})
+
+	t.Run("Deleting a feed removes it from saved searches", func(t *testing.T) {
+		saved, err := s.CreateSavedSearch(ctx, uuid.NewString(), store.SavedSearchParams{Name: "Feed C", FeedIDs: []string{feedC}})
+		assert.NilError(t, err)
+		assert.NilError(t, s.DeleteFeed(ctx, feedC))
+		got, err := s.GetSavedSearch(ctx, saved.ID)
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Len(got.FeedIDs, 0))
+
+		assert.NilError(t, s.DeleteSavedSearch(ctx, saved.ID))
+		searches, err := s.ListSavedSearches(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Len(searches, 2))
+	})
+}
@@ -0,0 +1,167 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidSearchQuery is returned for search queries that cannot be parsed.
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+type searchToken struct {
+	kind    searchTokenKind
+	text    string
+	prefix  bool
+	negated bool
+}
+
+type searchTokenKind int
+
+const (
+	searchTerm searchTokenKind = iota
+	searchPhrase
+	searchOperator
+	searchOpen
+	searchClose
+)
+
+// ParseSearchQuery translates a user search query into an FTS5 query.
+// Queries are made of terms, "quoted phrases" and prefixes such as feed*,
+// combined with AND (the default), OR and NOT, grouped with parentheses.
+// A term, phrase or group preceded by - is excluded. Terms are always
+// quoted, so that FTS5 syntax such as column filters cannot be injected.
+func ParseSearchQuery(query string) (string, error) {
+	tokens, err := tokenizeSearchQuery(query)
+	if err != nil {
+		return "", err
+	}
+
+	var out []string
+	needOperand := true
+	depth := 0
+	for _, token := range tokens {
+		switch token.kind {
+		case searchTerm, searchPhrase, searchOpen:
+			switch {
+			case !needOperand && token.negated:
+				out = append(out, "NOT")
+			case !needOperand:
+				out = append(out, "AND")
+			case token.negated && len(out) > 0 && out[len(out)-1] == "AND":
+				out[len(out)-1] = "NOT"
+			case token.negated:
+				return "", fmt.Errorf("%w: excluded terms must follow another term", ErrInvalidSearchQuery)
+			}
+			if token.kind == searchOpen {
+				out = append(out, "(")
+				depth++
+				needOperand = true
+				continue
+			}
+			phrase := `"` + strings.ReplaceAll(token.text, `"`, `""`) + `"`
+			if token.prefix {
+				phrase += "*"
+			}
+			out = append(out, phrase)
+			needOperand = false
+		case searchOperator:
+			if needOperand {
+				return "", fmt.Errorf("%w: %s must follow a term", ErrInvalidSearchQuery, token.text)
+			}
+			out = append(out, token.text)
+			needOperand = true
+		case searchClose:
+			if depth == 0 {
+				return "", fmt.Errorf("%w: unbalanced parentheses", ErrInvalidSearchQuery)
+			}
+			if needOperand {
+				return "", fmt.Errorf("%w: empty group or missing term", ErrInvalidSearchQuery)
+			}
+			out = append(out, ")")
+			depth--
+		}
+	}
+	switch {
+	case len(out) == 0:
+		return "", fmt.Errorf("%w: query has no terms", ErrInvalidSearchQuery)
+	case depth != 0:
+		return "", fmt.Errorf("%w: unbalanced parentheses", ErrInvalidSearchQuery)
+	case needOperand:
+		return "", fmt.Errorf("%w: query ends with an operator", ErrInvalidSearchQuery)
+	}
+	return strings.Join(out, " "), nil
+}
+
+func tokenizeSearchQuery(query string) ([]searchToken, error) {
+	var tokens []searchToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if unicode.IsSpace(r) {
+			i++
+			continue
+		}
+		negated := false
+		if r == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			negated = true
+			i++
+			r = runes[i]
+		}
+
+		switch r {
+		case '(':
+			tokens = append(tokens, searchToken{kind: searchOpen, negated: negated})
+			i++
+		case ')':
+			if negated {
+				return nil, fmt.Errorf("%w: - must precede a term", ErrInvalidSearchQuery)
+			}
+			tokens = append(tokens, searchToken{kind: searchClose})
+			i++
+		case '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("%w: unterminated phrase", ErrInvalidSearchQuery)
+			}
+			text := string(runes[i+1 : end])
+			i = end + 1
+			prefix := i < len(runes) && runes[i] == '*'
+			if prefix {
+				i++
+			}
+			if !hasSearchableText(text) {
+				continue
+			}
+			tokens = append(tokens, searchToken{kind: searchPhrase, text: text, prefix: prefix, negated: negated})
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune(`()"`, runes[end]) {
+				end++
+			}
+			word := string(runes[i:end])
+			i = end
+			if !negated && (word == "AND" || word == "OR" || word == "NOT") {
+				tokens = append(tokens, searchToken{kind: searchOperator, text: word})
+				continue
+			}
+			text := strings.TrimRight(word, "*")
+			if !hasSearchableText(text) {
+				continue
+			}
+			tokens = append(tokens, searchToken{kind: searchTerm, text: text, prefix: text != word, negated: negated})
+		}
+	}
+	return tokens, nil
+}
+
+// hasSearchableText reports whether text holds a letter or digit. Other
+// characters are separators for the tokenizer, so text without any would
+// be an empty phrase.
+func hasSearchableText(text string) bool {
+	return strings.IndexFunc(text, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) }) >= 0
+}
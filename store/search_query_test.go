@@ -0,0 +1,60 @@
+package store_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nakatanakatana/feed-reader/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "go", want: `"go"`},
+		{query: "go generics", want: `"go" AND "generics"`},
+		{query: "go OR rust", want: `"go" OR "rust"`},
+		{query: "go NOT rust", want: `"go" NOT "rust"`},
+		{query: "go -rust", want: `"go" NOT "rust"`},
+		{query: "go and rust", want: `"go" AND "and" AND "rust"`},
+		{query: `"type parameters" go`, want: `"type parameters" AND "go"`},
+		{query: "gener*", want: `"gener"*`},
+		{query: `"type param"*`, want: `"type param"*`},
+		{query: "(go OR rust) -(java OR kotlin)", want: `( "go" OR "rust" ) NOT ( "java" OR "kotlin" )`},
+		{query: "go (rust)", want: `"go" AND ( "rust" )`},
+		{query: "title:go", want: `"title:go"`},
+		{query: `say"hi"`, want: `"say" AND "hi"`},
+		{query: "go - rust", want: `"go" AND "rust"`},
+		{query: "  go  ***  ", want: `"go"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := store.ParseSearchQuery(tt.query)
+			assert.NilError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestParseSearchQuery_Invalid(t *testing.T) {
+	for _, query := range []string{
+		"",
+		"   ",
+		"OR go",
+		"go AND",
+		"go OR -rust",
+		"-rust",
+		`"unterminated`,
+		"(go",
+		"go)",
+		"()",
+		"go -)",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := store.ParseSearchQuery(query)
+			assert.Assert(t, errors.Is(err, store.ErrInvalidSearchQuery), "got %v", err)
+		})
+	}
+}
@@ -12,20 +12,32 @@ type TagWithCount struct {
 }
 
 type FullFeed struct {
-	ID            string  `json:"id"`
-	Url           string  `json:"url"`
-	Link          *string `json:"link"`
-	Title         *string `json:"title"`
-	Description   *string `json:"description"`
-	Lang          *string `json:"lang"`
-	ImageUrl      *string `json:"image_url"`
-	Copyright     *string `json:"copyright"`
-	FeedType      *string `json:"feed_type"`
-	FeedVersion   *string `json:"feed_version"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	LastFetchedAt *string `json:"last_fetched_at"`
-	NextFetch     *string `json:"next_fetch"`
+	ID                  string  `json:"id"`
+	Url                 string  `json:"url"`
+	Link                *string `json:"link"`
+	Title               *string `json:"title"`
+	Description         *string `json:"description"`
+	Lang                *string `json:"lang"`
+	ImageUrl            *string `json:"image_url"`
+	Copyright           *string `json:"copyright"`
+	FeedType            *string `json:"feed_type"`
+	FeedVersion         *string `json:"feed_version"`
+	FetchFullContent    int64   `json:"fetch_full_content"`
+	UnreadOnRevision    int64   `json:"unread_on_revision"`
+	TitleOverridden     int64   `json:"title_overridden"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+	LastFetchedAt       *string `json:"last_fetched_at"`
+	NextFetch           *string `json:"next_fetch"`
+	LastStatusCode      *int64  `json:"last_status_code"`
+	LastError           *string `json:"last_error"`
+	LastErrorKind       *string `json:"last_error_kind"`
+	ConsecutiveFailures *int64  `json:"consecutive_failures"`
+	LastSuccessAt       *string `json:"last_success_at"`
+	RedirectUrl         *string `json:"redirect_url"`
+	DisabledAt          *string `json:"disabled_at"`
+	DisabledReason      *string `json:"disabled_reason"`
+	ScheduleReason      *string `json:"schedule_reason"`
 }
 
 // UUIDGenerator generates UUIDs.